@@ -0,0 +1,565 @@
+package business
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitishm/engarde/pkg/parser"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+)
+
+const (
+	// proxyContainerName is the name of the Istio sidecar container, the one writing the access logs
+	proxyContainerName = "istio-proxy"
+
+	defaultAccessLogMaxEntries = 1000
+	defaultAccessLogTopPaths   = 10
+
+	// defaultAccessLogSince is how far back the logs are read when the search has no sinceTime
+	defaultAccessLogSince = time.Hour
+	// accessLogLimitBytes is the maximum number of bytes of logs read per pod
+	accessLogLimitBytes int64 = 16 * 1024 * 1024
+	// maxAccessLogReaders is the maximum number of pods whose logs are read concurrently by a search
+	maxAccessLogReaders = 8
+)
+
+// AccessLogCriteria holds the filters of a search in the access logs of all the pods of a workload or an app
+type AccessLogCriteria struct {
+	SinceTime *time.Time
+	// Duration bounds the search to [SinceTime, SinceTime+Duration], or to the Duration after the first entry
+	Duration *time.Duration
+	// MaxEntries is the maximum number of entries returned. The logs of each pod are read until MaxEntries entries
+	// match, the summary includes all the matching entries read.
+	MaxEntries int
+	// StatusCodes are the ranges of the accepted response codes, all codes if empty
+	StatusCodes []StatusCodeRange
+	// ResponseFlags are the accepted Envoy response flags, an entry matches if it has any of them
+	ResponseFlags   []string
+	UpstreamCluster string
+	PathRegex       *regexp.Regexp
+	MinDuration     *time.Duration
+	MaxDuration     *time.Duration
+	// TopPaths is the number of paths of the summary with the most 5xx responses
+	TopPaths int
+}
+
+// StatusCodeRange is an inclusive range of response codes
+type StatusCodeRange struct {
+	From int
+	To   int
+}
+
+// AccessLogs reports the access log entries of all the pods of a workload or an app, merged by timestamp
+type AccessLogs struct {
+	Entries          []AccessLogsEntry `json:"entries"`
+	EntriesTruncated bool              `json:"entriesTruncated,omitempty"`
+	Summary          AccessLogsSummary `json:"summary"`
+	// PodErrors holds the errors of the pods whose logs could not be read, by pod name
+	PodErrors map[string]string `json:"podErrors,omitempty"`
+}
+
+// AccessLogsEntry is an access log entry of one of the pods
+type AccessLogsEntry struct {
+	LogEntry
+	Pod      string `json:"pod"`
+	Workload string `json:"workload"`
+}
+
+// AccessLogsSummary aggregates all the access log entries matching the search, among the entries read
+type AccessLogsSummary struct {
+	Requests int `json:"requests"`
+	// StatusCodes counts the requests by response code class (2xx, 3xx, 4xx, 5xx) or code if not in a class
+	StatusCodes   map[string]int `json:"statusCodes"`
+	ResponseFlags map[string]int `json:"responseFlags"`
+	// Duration are the percentiles of the request durations, in milliseconds
+	Duration LatencyPercentiles `json:"duration"`
+	// TopErrorPaths are the paths with the most 5xx responses
+	TopErrorPaths []AccessLogsPathSummary `json:"topErrorPaths"`
+}
+
+// LatencyPercentiles holds percentiles of durations in milliseconds
+type LatencyPercentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// AccessLogsPathSummary aggregates the access log entries of a path
+type AccessLogsPathSummary struct {
+	Path         string             `json:"path"`
+	Requests     int                `json:"requests"`
+	ServerErrors int                `json:"serverErrors"`
+	Duration     LatencyPercentiles `json:"duration"`
+}
+
+// BuildAccessLogCriteria parses the filters of an access log search from the sinceTime, duration, maxEntries,
+// statusCodes, responseFlags, upstreamCluster, pathRegex, minDuration, maxDuration and topPaths query params.
+// Status codes are a comma separated list of codes, classes (5xx) or ranges (400-404) and response flags a comma
+// separated list of Envoy flags.
+func BuildAccessLogCriteria(params url.Values) (*AccessLogCriteria, error) {
+	criteria := &AccessLogCriteria{
+		MaxEntries:      defaultAccessLogMaxEntries,
+		TopPaths:        defaultAccessLogTopPaths,
+		UpstreamCluster: params.Get("upstreamCluster"),
+	}
+
+	if sinceTime := params.Get("sinceTime"); sinceTime != "" {
+		numTime, err := strconv.ParseInt(sinceTime, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid sinceTime [%s]: %v", sinceTime, err)
+		}
+		t := time.Unix(numTime, 0)
+		criteria.SinceTime = &t
+	}
+
+	var err error
+	if criteria.Duration, err = parseOptionalDuration("duration", params.Get("duration")); err != nil {
+		return nil, err
+	}
+	if criteria.MinDuration, err = parseOptionalDuration("minDuration", params.Get("minDuration")); err != nil {
+		return nil, err
+	}
+	if criteria.MaxDuration, err = parseOptionalDuration("maxDuration", params.Get("maxDuration")); err != nil {
+		return nil, err
+	}
+
+	if maxEntries := params.Get("maxEntries"); maxEntries != "" {
+		if criteria.MaxEntries, err = strconv.Atoi(maxEntries); err != nil || criteria.MaxEntries <= 0 {
+			return nil, fmt.Errorf("Invalid maxEntries [%s]", maxEntries)
+		}
+	}
+	if topPaths := params.Get("topPaths"); topPaths != "" {
+		if criteria.TopPaths, err = strconv.Atoi(topPaths); err != nil || criteria.TopPaths < 0 {
+			return nil, fmt.Errorf("Invalid topPaths [%s]", topPaths)
+		}
+	}
+
+	for _, s := range strings.Split(params.Get("statusCodes"), ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		r, err := parseStatusCodeRange(s)
+		if err != nil {
+			return nil, err
+		}
+		criteria.StatusCodes = append(criteria.StatusCodes, r)
+	}
+
+	for _, flag := range strings.Split(params.Get("responseFlags"), ",") {
+		if flag = strings.TrimSpace(flag); flag != "" {
+			criteria.ResponseFlags = append(criteria.ResponseFlags, flag)
+		}
+	}
+
+	if pathRegex := params.Get("pathRegex"); pathRegex != "" {
+		if criteria.PathRegex, err = regexp.Compile(pathRegex); err != nil {
+			return nil, fmt.Errorf("Invalid pathRegex [%s]: %v", pathRegex, err)
+		}
+	}
+
+	return criteria, nil
+}
+
+func parseOptionalDuration(name, value string) (*time.Duration, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid %s [%s]: %v", name, value, err)
+	}
+	return &d, nil
+}
+
+// parseStatusCodeRange parses a response code (404), class (5xx) or range (400-404)
+func parseStatusCodeRange(s string) (StatusCodeRange, error) {
+	lower := strings.ToLower(s)
+	if len(lower) == 3 && strings.HasSuffix(lower, "xx") && lower[0] >= '1' && lower[0] <= '5' {
+		from := int(lower[0]-'0') * 100
+		return StatusCodeRange{From: from, To: from + 99}, nil
+	}
+	bounds := strings.SplitN(lower, "-", 2)
+	from, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return StatusCodeRange{}, fmt.Errorf("Invalid status code [%s]", s)
+	}
+	to := from
+	if len(bounds) == 2 {
+		if to, err = strconv.Atoi(bounds[1]); err != nil || to < from {
+			return StatusCodeRange{}, fmt.Errorf("Invalid status code range [%s]", s)
+		}
+	}
+	return StatusCodeRange{From: from, To: to}, nil
+}
+
+// matches returns true if the access log passes all the filters of the criteria, except the time window
+func (criteria AccessLogCriteria) matches(al *parser.AccessLog) bool {
+	if len(criteria.StatusCodes) > 0 {
+		code, err := strconv.Atoi(al.StatusCode)
+		if err != nil {
+			return false
+		}
+		inRange := false
+		for _, r := range criteria.StatusCodes {
+			if code >= r.From && code <= r.To {
+				inRange = true
+				break
+			}
+		}
+		if !inRange {
+			return false
+		}
+	}
+
+	if len(criteria.ResponseFlags) > 0 {
+		hasFlag := false
+		for _, flag := range accessLogResponseFlags(al) {
+			for _, accepted := range criteria.ResponseFlags {
+				if flag == accepted {
+					hasFlag = true
+				}
+			}
+		}
+		if !hasFlag {
+			return false
+		}
+	}
+
+	if criteria.UpstreamCluster != "" && !strings.Contains(al.UpstreamCluster, criteria.UpstreamCluster) {
+		return false
+	}
+
+	if criteria.PathRegex != nil && !criteria.PathRegex.MatchString(al.UriPath) {
+		return false
+	}
+
+	if criteria.MinDuration != nil || criteria.MaxDuration != nil {
+		duration, err := accessLogDuration(al)
+		if err != nil {
+			return false
+		}
+		if criteria.MinDuration != nil && duration < *criteria.MinDuration {
+			return false
+		}
+		if criteria.MaxDuration != nil && duration > *criteria.MaxDuration {
+			return false
+		}
+	}
+
+	return true
+}
+
+// accessLogResponseFlags returns the response flags of the access log. The parser pattern predates the upstream
+// transport failure reason of the newer Istio formats, which ends up after the flags, so only the first field is used.
+func accessLogResponseFlags(al *parser.AccessLog) []string {
+	fields := strings.Fields(al.ResponseFlags)
+	if len(fields) == 0 || fields[0] == "-" {
+		return []string{}
+	}
+	return strings.Split(fields[0], ",")
+}
+
+// accessLogDuration returns the duration of the request, logged in milliseconds
+func accessLogDuration(al *parser.AccessLog) (time.Duration, error) {
+	ms, err := strconv.ParseInt(al.Duration, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// GetWorkloadAccessLogs searches the access logs of all the pods of a workload
+func (in *WorkloadService) GetWorkloadAccessLogs(ctx context.Context, namespace, workload string, criteria AccessLogCriteria) (*AccessLogs, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetWorkloadAccessLogs",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("workload", workload),
+	)
+	defer end()
+
+	wkd, err := fetchWorkload(ctx, in.businessLayer, WorkloadCriteria{Namespace: namespace, WorkloadName: workload, WorkloadType: ""})
+	if err != nil {
+		return nil, err
+	}
+	return in.searchAccessLogs(ctx, namespace, models.Workloads{wkd}, criteria), nil
+}
+
+// GetAppAccessLogs searches the access logs of all the pods of all the workloads of an app
+func (in *WorkloadService) GetAppAccessLogs(ctx context.Context, namespace, app string, criteria AccessLogCriteria) (*AccessLogs, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetAppAccessLogs",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("app", app),
+	)
+	defer end()
+
+	namespaceApps, err := fetchNamespaceApps(ctx, in.businessLayer, namespace, app)
+	if err != nil {
+		return nil, err
+	}
+	appDetails, ok := namespaceApps[app]
+	if !ok {
+		return nil, kubernetes.NewNotFound(app, "Kiali", "App")
+	}
+	return in.searchAccessLogs(ctx, namespace, appDetails.Workloads, criteria), nil
+}
+
+// searchAccessLogs reads the proxy logs of the pods of the workloads concurrently, up to maxAccessLogReaders pods at a
+// time, and merges the matching entries by timestamp. The pods whose logs can't be read are reported, they don't fail
+// the search.
+func (in *WorkloadService) searchAccessLogs(ctx context.Context, namespace string, workloads models.Workloads, criteria AccessLogCriteria) *AccessLogs {
+	type podEntries struct {
+		pod     string
+		entries []AccessLogsEntry
+		err     error
+	}
+
+	results := make(chan podEntries)
+	readers := make(chan struct{}, maxAccessLogReaders)
+	wg := sync.WaitGroup{}
+	for _, w := range workloads {
+		for _, p := range w.Pods {
+			if !hasProxyContainer(p) {
+				continue
+			}
+			wg.Add(1)
+			go func(workload, pod string) {
+				defer wg.Done()
+				select {
+				case readers <- struct{}{}:
+					defer func() { <-readers }()
+				case <-ctx.Done():
+					results <- podEntries{pod: pod, err: ctx.Err()}
+					return
+				}
+				entries, err := in.readPodAccessLogs(ctx, namespace, workload, pod, criteria)
+				results <- podEntries{pod: pod, entries: entries, err: err}
+			}(w.Name, p.Name)
+		}
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	accessLogs := &AccessLogs{Entries: []AccessLogsEntry{}}
+	for r := range results {
+		if r.err != nil {
+			log.Debugf("Access logs of pod [%s] in namespace [%s] could not be read: %v", r.pod, namespace, r.err)
+			if accessLogs.PodErrors == nil {
+				accessLogs.PodErrors = map[string]string{}
+			}
+			accessLogs.PodErrors[r.pod] = r.err.Error()
+			continue
+		}
+		accessLogs.Entries = append(accessLogs.Entries, r.entries...)
+		if criteria.MaxEntries > 0 && len(r.entries) >= criteria.MaxEntries {
+			// the pod may have more matching entries, they were not read
+			accessLogs.EntriesTruncated = true
+		}
+	}
+
+	sort.SliceStable(accessLogs.Entries, func(i, j int) bool {
+		if accessLogs.Entries[i].OriginalTime.Equal(accessLogs.Entries[j].OriginalTime) {
+			return accessLogs.Entries[i].Pod < accessLogs.Entries[j].Pod
+		}
+		return accessLogs.Entries[i].OriginalTime.Before(accessLogs.Entries[j].OriginalTime)
+	})
+
+	accessLogs.Summary = summarizeAccessLogs(accessLogs.Entries, criteria.TopPaths)
+	if criteria.MaxEntries > 0 && len(accessLogs.Entries) > criteria.MaxEntries {
+		accessLogs.Entries = accessLogs.Entries[:criteria.MaxEntries]
+		accessLogs.EntriesTruncated = true
+	}
+	return accessLogs
+}
+
+func hasProxyContainer(pod *models.Pod) bool {
+	for _, c := range pod.IstioContainers {
+		if c.Name == proxyContainerName {
+			return true
+		}
+	}
+	return false
+}
+
+// readPodAccessLogs returns the access log entries of the proxy of a pod matching the criteria, up to MaxEntries. At
+// most accessLogLimitBytes bytes of logs are read, and the reading stops once the context is done.
+func (in *WorkloadService) readPodAccessLogs(ctx context.Context, namespace, workload, pod string, criteria AccessLogCriteria) ([]AccessLogsEntry, error) {
+	limitBytes := accessLogLimitBytes
+	opts := core_v1.PodLogOptions{Container: proxyContainerName, Timestamps: true, LimitBytes: &limitBytes}
+	if criteria.SinceTime != nil {
+		opts.SinceTime = &meta_v1.Time{Time: *criteria.SinceTime}
+	} else {
+		opts.SinceTime = &meta_v1.Time{Time: time.Now().Add(-defaultAccessLogSince)}
+	}
+
+	logsReader, err := in.k8s.StreamPodLogs(namespace, pod, &opts)
+	if err != nil {
+		return nil, err
+	}
+	// closing the reader unblocks the reading when the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		if e := logsReader.Close(); e != nil {
+			log.Errorf("Error when closing the connection streaming logs of a pod: %s", e.Error())
+		}
+	}()
+
+	var endTime *time.Time
+	if criteria.SinceTime != nil && criteria.Duration != nil {
+		end := criteria.SinceTime.Add(*criteria.Duration)
+		endTime = &end
+	}
+
+	// the parser is not safe for concurrent use, each pod has its own
+	engardeParser := parser.New(parser.IstioProxyAccessLogsPattern)
+	entries := []AccessLogsEntry{}
+	bufferedReader := bufio.NewReader(logsReader)
+	line, readErr := bufferedReader.ReadString('\n')
+	for ; readErr == nil || (readErr == io.EOF && len(line) > 0); line, readErr = bufferedReader.ReadString('\n') {
+		entry := parseLogLine(line, true, engardeParser)
+		if entry == nil || entry.AccessLog == nil {
+			continue
+		}
+
+		if criteria.Duration != nil {
+			if endTime == nil {
+				end := entry.OriginalTime.Add(*criteria.Duration)
+				endTime = &end
+			}
+			if entry.OriginalTime.After(*endTime) {
+				break
+			}
+		}
+
+		if criteria.matches(entry.AccessLog) {
+			entries = append(entries, AccessLogsEntry{LogEntry: *entry, Pod: pod, Workload: workload})
+			if criteria.MaxEntries > 0 && len(entries) >= criteria.MaxEntries {
+				break
+			}
+		}
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if readErr != nil && readErr != io.EOF {
+		return nil, readErr
+	}
+	return entries, nil
+}
+
+// summarizeAccessLogs aggregates the access log entries, the paths being sorted by 5xx responses and then requests
+func summarizeAccessLogs(entries []AccessLogsEntry, topPaths int) AccessLogsSummary {
+	summary := AccessLogsSummary{
+		Requests:      len(entries),
+		StatusCodes:   map[string]int{},
+		ResponseFlags: map[string]int{},
+		TopErrorPaths: []AccessLogsPathSummary{},
+	}
+
+	durations := make([]float64, 0, len(entries))
+	paths := map[string]*AccessLogsPathSummary{}
+	pathDurations := map[string][]float64{}
+	for _, e := range entries {
+		al := e.AccessLog
+		code, err := strconv.Atoi(al.StatusCode)
+		isServerError := err == nil && code >= 500 && code <= 599
+		if err == nil && code >= 100 && code <= 599 {
+			summary.StatusCodes[fmt.Sprintf("%dxx", code/100)]++
+		} else {
+			summary.StatusCodes[al.StatusCode]++
+		}
+
+		for _, flag := range accessLogResponseFlags(al) {
+			summary.ResponseFlags[flag]++
+		}
+
+		path, ok := paths[al.UriPath]
+		if !ok {
+			path = &AccessLogsPathSummary{Path: al.UriPath}
+			paths[al.UriPath] = path
+		}
+		path.Requests++
+		if isServerError {
+			path.ServerErrors++
+		}
+
+		if duration, err := accessLogDuration(al); err == nil {
+			ms := float64(duration.Milliseconds())
+			durations = append(durations, ms)
+			pathDurations[al.UriPath] = append(pathDurations[al.UriPath], ms)
+		}
+	}
+	summary.Duration = latencyPercentiles(durations)
+
+	for _, path := range paths {
+		if path.ServerErrors == 0 {
+			continue
+		}
+		path.Duration = latencyPercentiles(pathDurations[path.Path])
+		summary.TopErrorPaths = append(summary.TopErrorPaths, *path)
+	}
+	sort.Slice(summary.TopErrorPaths, func(i, j int) bool {
+		pi, pj := summary.TopErrorPaths[i], summary.TopErrorPaths[j]
+		if pi.ServerErrors != pj.ServerErrors {
+			return pi.ServerErrors > pj.ServerErrors
+		}
+		if pi.Requests != pj.Requests {
+			return pi.Requests > pj.Requests
+		}
+		return pi.Path < pj.Path
+	})
+	if len(summary.TopErrorPaths) > topPaths {
+		summary.TopErrorPaths = summary.TopErrorPaths[:topPaths]
+	}
+	return summary
+}
+
+// latencyPercentiles returns the nearest-rank percentiles of the durations
+func latencyPercentiles(durations []float64) LatencyPercentiles {
+	if len(durations) == 0 {
+		return LatencyPercentiles{}
+	}
+	sorted := append([]float64{}, durations...)
+	sort.Float64s(sorted)
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+	return LatencyPercentiles{
+		P50: percentile(50),
+		P90: percentile(90),
+		P95: percentile(95),
+		P99: percentile(99),
+		Max: sorted[len(sorted)-1],
+	}
+}
@@ -0,0 +1,175 @@
+package business
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func accessLogLine(timestamp, path string, code int, flags string, duration int) string {
+	return timestamp + `Z [` + timestamp + `.000Z] "GET ` + path + ` HTTP/1.1" ` + strconv.Itoa(code) + ` ` + flags +
+		` via_upstream - "-" 0 99 ` + strconv.Itoa(duration) + ` ` + strconv.Itoa(duration) + ` "-" "curl/7.64.0" "7e7e2dd0" "reviews:9080" "10.0.0.1:9080" inbound|9080|| 127.0.0.1:33704 10.0.0.1:9080 10.0.0.2:39880 outbound_.9080_._.reviews.bookinfo.svc.cluster.local default` + "\n"
+}
+
+func accessLogsWorkloads() models.Workloads {
+	proxy := []*models.ContainerInfo{{Name: proxyContainerName}}
+	return models.Workloads{
+		&models.Workload{
+			WorkloadListItem: models.WorkloadListItem{Name: "reviews-v1"},
+			Pods: models.Pods{
+				{Name: "reviews-v1-a", IstioContainers: proxy},
+				{Name: "reviews-v1-b", IstioContainers: proxy},
+				{Name: "reviews-v1-nosidecar"},
+			},
+		},
+	}
+}
+
+func TestSearchAccessLogs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", mock.Anything).Return(io.NopCloser(strings.NewReader(
+		accessLogLine("2022-05-01T10:00:00", "/reviews/1", 200, "-", 10)+
+			accessLogLine("2022-05-01T10:00:02", "/reviews/2", 503, "UF,URX", 30)+
+			"2022-05-01T10:00:03Z not an access log\n")), nil)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-b", mock.Anything).Return(io.NopCloser(strings.NewReader(
+		accessLogLine("2022-05-01T10:00:01", "/reviews/2", 500, "-", 20)+
+			accessLogLine("2022-05-01T10:00:03", "/health", 200, "-", 1))), nil)
+
+	k8s.On("IsOpenShift").Return(false)
+	svc := setupWorkloadService(k8s)
+	criteria, err := BuildAccessLogCriteria(url.Values{"maxEntries": {"3"}})
+	require.NoError(err)
+
+	accessLogs := svc.searchAccessLogs(context.TODO(), "bookinfo", accessLogsWorkloads(), *criteria)
+	require.Len(accessLogs.Entries, 3)
+	assert.True(accessLogs.EntriesTruncated)
+	assert.Empty(accessLogs.PodErrors)
+
+	// merged by timestamp
+	assert.Equal("reviews-v1-a", accessLogs.Entries[0].Pod)
+	assert.Equal("reviews-v1-b", accessLogs.Entries[1].Pod)
+	assert.Equal("reviews-v1-a", accessLogs.Entries[2].Pod)
+	assert.Equal("reviews-v1", accessLogs.Entries[0].Workload)
+
+	// the summary includes the truncated entries
+	summary := accessLogs.Summary
+	assert.Equal(4, summary.Requests)
+	assert.Equal(map[string]int{"2xx": 2, "5xx": 2}, summary.StatusCodes)
+	assert.Equal(map[string]int{"UF": 1, "URX": 1}, summary.ResponseFlags)
+	assert.Equal(LatencyPercentiles{P50: 10, P90: 30, P95: 30, P99: 30, Max: 30}, summary.Duration)
+	require.Len(summary.TopErrorPaths, 1)
+	assert.Equal(AccessLogsPathSummary{Path: "/reviews/2", Requests: 2, ServerErrors: 2, Duration: LatencyPercentiles{P50: 20, P90: 30, P95: 30, P99: 30, Max: 30}}, summary.TopErrorPaths[0])
+}
+
+func searchFilteredAccessLogs(criteria *AccessLogCriteria) *AccessLogs {
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", mock.Anything).Return(io.NopCloser(strings.NewReader(
+		accessLogLine("2022-05-01T10:00:00", "/reviews/1", 200, "-", 10)+
+			accessLogLine("2022-05-01T10:00:02", "/reviews/2", 503, "UF,URX", 30)+
+			accessLogLine("2022-05-01T10:00:04", "/reviews/3", 404, "NR", 300))), nil)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-b", mock.Anything).Return(io.NopCloser(strings.NewReader("")), errors.New("pod not ready"))
+	k8s.On("IsOpenShift").Return(false)
+	svc := setupWorkloadService(k8s)
+
+	return svc.searchAccessLogs(context.TODO(), "bookinfo", accessLogsWorkloads(), *criteria)
+}
+
+func TestSearchAccessLogsFilters(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	criteria, err := BuildAccessLogCriteria(url.Values{"statusCodes": {"5xx,400-403"}})
+	require.NoError(err)
+	accessLogs := searchFilteredAccessLogs(criteria)
+	require.Len(accessLogs.Entries, 1)
+	assert.Equal("/reviews/2", accessLogs.Entries[0].AccessLog.UriPath)
+	assert.Equal(map[string]string{"reviews-v1-b": "pod not ready"}, accessLogs.PodErrors)
+
+	criteria, err = BuildAccessLogCriteria(url.Values{"responseFlags": {"NR,UH"}})
+	require.NoError(err)
+	accessLogs = searchFilteredAccessLogs(criteria)
+	require.Len(accessLogs.Entries, 1)
+	assert.Equal("/reviews/3", accessLogs.Entries[0].AccessLog.UriPath)
+
+	criteria, err = BuildAccessLogCriteria(url.Values{"pathRegex": {"^/reviews/[12]$"}, "minDuration": {"20ms"}})
+	require.NoError(err)
+	accessLogs = searchFilteredAccessLogs(criteria)
+	require.Len(accessLogs.Entries, 1)
+	assert.Equal("/reviews/2", accessLogs.Entries[0].AccessLog.UriPath)
+
+	// the window starts on the first entry
+	criteria, err = BuildAccessLogCriteria(url.Values{"duration": {"3s"}, "upstreamCluster": {"inbound|9080"}, "maxDuration": {"100ms"}})
+	require.NoError(err)
+	accessLogs = searchFilteredAccessLogs(criteria)
+	assert.Len(accessLogs.Entries, 2)
+}
+
+func TestBuildAccessLogCriteriaErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := BuildAccessLogCriteria(url.Values{"statusCodes": {"6xx"}})
+	assert.Error(err)
+	_, err = BuildAccessLogCriteria(url.Values{"statusCodes": {"404-400"}})
+	assert.Error(err)
+	_, err = BuildAccessLogCriteria(url.Values{"pathRegex": {"("}})
+	assert.Error(err)
+	_, err = BuildAccessLogCriteria(url.Values{"maxEntries": {"0"}})
+	assert.Error(err)
+	_, err = BuildAccessLogCriteria(url.Values{"minDuration": {"fast"}})
+	assert.Error(err)
+
+	criteria, err := BuildAccessLogCriteria(url.Values{"sinceTime": {"1651399200"}, "statusCodes": {"503, 2XX"}})
+	assert.NoError(err)
+	assert.Equal([]StatusCodeRange{{From: 503, To: 503}, {From: 200, To: 299}}, criteria.StatusCodes)
+	assert.Equal(int64(1651399200), criteria.SinceTime.Unix())
+}
+
+func TestReadPodAccessLogsBoundsTheLogs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	var opts *core_v1.PodLogOptions
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", mock.Anything).Run(func(args mock.Arguments) {
+		opts = args.Get(2).(*core_v1.PodLogOptions)
+	}).Return(io.NopCloser(strings.NewReader(
+		accessLogLine("2022-05-01T10:00:00", "/reviews/1", 200, "-", 10)+
+			accessLogLine("2022-05-01T10:00:01", "/reviews/2", 200, "-", 10)+
+			accessLogLine("2022-05-01T10:00:02", "/reviews/3", 200, "-", 10))), nil)
+	k8s.On("IsOpenShift").Return(false)
+	svc := setupWorkloadService(k8s)
+
+	criteria, err := BuildAccessLogCriteria(url.Values{"maxEntries": {"2"}})
+	require.NoError(err)
+	entries, err := svc.readPodAccessLogs(context.TODO(), "bookinfo", "reviews-v1", "reviews-v1-a", *criteria)
+	require.NoError(err)
+	assert.Len(entries, 2)
+	require.NotNil(opts.SinceTime)
+	assert.WithinDuration(time.Now().Add(-defaultAccessLogSince), opts.SinceTime.Time, time.Minute)
+	assert.Equal(accessLogLimitBytes, *opts.LimitBytes)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	_, err = svc.readPodAccessLogs(ctx, "bookinfo", "reviews-v1", "reviews-v1-a", *criteria)
+	assert.Equal(context.Canceled, err)
+}
@@ -105,7 +105,9 @@ func (in *AppService) GetAppList(ctx context.Context, criteria AppCriteria) (mod
 		IncludePeerAuthentications:    true,
 		IncludeRequestAuthentications: true,
 		IncludeSidecars:               true,
+		IncludeTelemetries:            true,
 		IncludeVirtualServices:        true,
+		IncludeWasmPlugins:            true,
 	}
 	var istioConfigList models.IstioConfigList
 
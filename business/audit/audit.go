@@ -0,0 +1,147 @@
+// Package audit records the write operations performed through the Kiali API, and allows searching them.
+package audit
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+)
+
+// Outcomes of the audited operations
+const (
+	OutcomeFailure = "failure"
+	OutcomeSuccess = "success"
+)
+
+// Entry is the record of an audited operation.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	// User is the subject of the session performing the operation, and AuthStrategy the
+	// authentication strategy configured when the operation was performed.
+	User         string `json:"user"`
+	AuthStrategy string `json:"authStrategy"`
+
+	// Operation is the name of the API route, Method and Path the HTTP request line.
+	Operation string `json:"operation"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Query     string `json:"query,omitempty"`
+
+	// The target object. Namespace is empty for cluster-scoped or Kiali objects.
+	Namespace  string `json:"namespace,omitempty"`
+	ObjectType string `json:"objectType"`
+	ObjectName string `json:"objectName,omitempty"`
+
+	// Body is the request body: the JSON patch for updates, the object for creations.
+	Body string `json:"body,omitempty"`
+
+	Outcome    string `json:"outcome"`
+	StatusCode int    `json:"statusCode"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Query filters the entries to search. Empty fields match all the entries.
+type Query struct {
+	User       string
+	Namespace  string
+	ObjectType string
+	From       time.Time
+	To         time.Time
+
+	// Visible, when set, hides the entries the requester is not allowed to see
+	Visible func(e Entry) bool
+
+	Offset int
+	Limit  int
+}
+
+// Matches returns true if the entry passes all the query filters
+func (q Query) Matches(e Entry) bool {
+	switch {
+	case q.User != "" && e.User != q.User:
+		return false
+	case q.Namespace != "" && e.Namespace != q.Namespace:
+		return false
+	case q.ObjectType != "" && e.ObjectType != q.ObjectType:
+		return false
+	case !q.From.IsZero() && e.Timestamp.Before(q.From):
+		return false
+	case !q.To.IsZero() && e.Timestamp.After(q.To):
+		return false
+	case q.Visible != nil && !q.Visible(e):
+		return false
+	}
+	return true
+}
+
+// Page is a page of search results, sorted from the newest to the oldest entry.
+type Page struct {
+	Entries []Entry `json:"entries"`
+	Offset  int     `json:"offset"`
+	Limit   int     `json:"limit"`
+	// Total is the number of entries matching the query
+	Total int `json:"total"`
+}
+
+// Sink persists the audit entries. Implementations must be safe for concurrent use.
+type Sink interface {
+	// Write records the entry.
+	Write(entry Entry) error
+
+	// Search returns the page of the entries matching the query.
+	Search(query Query) (Page, error)
+}
+
+var (
+	sink     Sink
+	sinkOnce sync.Once
+)
+
+// GetSink returns the sink configured by Server.AuditLogSink.
+func GetSink() Sink {
+	sinkOnce.Do(func() {
+		conf := config.Get().Server.AuditLogSink
+		if conf.FilePath != "" {
+			log.Infof("[Audit] Recording audit entries in [%s]", conf.FilePath)
+			sink = NewFileSink(conf.FilePath, int64(conf.MaxSize)*1024*1024, conf.MaxBackups)
+		} else {
+			log.Infof("[Audit] Recording the latest [%d] audit entries in memory", conf.MaxEntries)
+			sink = NewMemorySink(conf.MaxEntries)
+		}
+	})
+	return sink
+}
+
+// SetSink replaces the configured sink, mainly for testing purposes.
+func SetSink(s Sink) {
+	sinkOnce.Do(func() {})
+	sink = s
+}
+
+// Record writes the entry to the configured sink. Failures are logged, as they must not fail the audited operation.
+func Record(entry Entry) {
+	if err := GetSink().Write(entry); err != nil {
+		log.Errorf("[Audit] Failed to record audit entry for operation [%s] by user [%s]: %v", entry.Operation, entry.User, err)
+	}
+}
+
+// paginate sorts the matching entries from the newest to the oldest and returns the requested page
+func paginate(matches []Entry, query Query) Page {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Timestamp.After(matches[j].Timestamp)
+	})
+
+	page := Page{Entries: []Entry{}, Offset: query.Offset, Limit: query.Limit, Total: len(matches)}
+	if query.Offset < len(matches) {
+		end := len(matches)
+		if query.Limit > 0 && query.Offset+query.Limit < end {
+			end = query.Offset + query.Limit
+		}
+		page.Entries = matches[query.Offset:end]
+	}
+	return page
+}
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+
+func testEntry(minute int, user, namespace, objectType string) Entry {
+	return Entry{
+		Timestamp:  testTime.Add(time.Duration(minute) * time.Minute),
+		User:       user,
+		Operation:  "IstioConfigUpdate",
+		Method:     "PATCH",
+		Namespace:  namespace,
+		ObjectType: objectType,
+		ObjectName: "reviews",
+		Outcome:    OutcomeSuccess,
+		StatusCode: 200,
+	}
+}
+
+func TestQueryMatches(t *testing.T) {
+	assert := assert.New(t)
+
+	e := testEntry(5, "alice", "bookinfo", "virtualservices")
+	assert.True(Query{}.Matches(e))
+	assert.True(Query{User: "alice", Namespace: "bookinfo", ObjectType: "virtualservices"}.Matches(e))
+	assert.False(Query{User: "bob"}.Matches(e))
+	assert.False(Query{Namespace: "istio-system"}.Matches(e))
+	assert.False(Query{ObjectType: "workloads"}.Matches(e))
+	assert.True(Query{From: testTime, To: testTime.Add(5 * time.Minute)}.Matches(e))
+	assert.False(Query{From: testTime.Add(6 * time.Minute)}.Matches(e))
+	assert.False(Query{To: testTime.Add(4 * time.Minute)}.Matches(e))
+	assert.False(Query{Visible: func(e Entry) bool { return e.Namespace != "bookinfo" }}.Matches(e))
+}
+
+func TestMemorySinkKeepsLatestEntries(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sink := NewMemorySink(3)
+	for i := 0; i < 5; i++ {
+		require.NoError(sink.Write(testEntry(i, "alice", "bookinfo", "virtualservices")))
+	}
+
+	page, err := sink.Search(Query{})
+	require.NoError(err)
+	assert.Equal(3, page.Total)
+	require.Len(page.Entries, 3)
+	// newest first
+	assert.Equal(testTime.Add(4*time.Minute), page.Entries[0].Timestamp)
+	assert.Equal(testTime.Add(2*time.Minute), page.Entries[2].Timestamp)
+}
+
+func TestMemorySinkSearchPagination(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sink := NewMemorySink(100)
+	for i := 0; i < 10; i++ {
+		user := "alice"
+		if i%2 == 1 {
+			user = "bob"
+		}
+		require.NoError(sink.Write(testEntry(i, user, "bookinfo", "virtualservices")))
+	}
+
+	page, err := sink.Search(Query{User: "bob", Offset: 1, Limit: 2})
+	require.NoError(err)
+	assert.Equal(5, page.Total)
+	assert.Equal(1, page.Offset)
+	assert.Equal(2, page.Limit)
+	require.Len(page.Entries, 2)
+	assert.Equal(testTime.Add(7*time.Minute), page.Entries[0].Timestamp)
+	assert.Equal(testTime.Add(5*time.Minute), page.Entries[1].Timestamp)
+
+	page, err = sink.Search(Query{Offset: 20, Limit: 2})
+	require.NoError(err)
+	assert.Equal(10, page.Total)
+	assert.Empty(page.Entries)
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	line, err := json.Marshal(testEntry(0, "alice", "bookinfo", "virtualservices"))
+	require.NoError(err)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	// every file holds two entries
+	sink := NewFileSink(path, int64(2*(len(line)+1)), 2)
+	for i := 0; i < 10; i++ {
+		require.NoError(sink.Write(testEntry(i, "alice", "bookinfo", "virtualservices")))
+	}
+
+	assert.FileExists(path)
+	assert.FileExists(path + ".1")
+	assert.FileExists(path + ".2")
+	assert.NoFileExists(path + ".3")
+
+	page, err := sink.Search(Query{})
+	require.NoError(err)
+	assert.Equal(6, page.Total)
+	require.Len(page.Entries, 6)
+	assert.Equal(testTime.Add(9*time.Minute), page.Entries[0].Timestamp)
+	assert.Equal(testTime.Add(4*time.Minute), page.Entries[5].Timestamp)
+}
+
+func TestFileSinkReopensExistingFile(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(NewFileSink(path, 1024*1024, 1).Write(testEntry(0, "alice", "bookinfo", "virtualservices")))
+
+	sink := NewFileSink(path, 1024*1024, 1)
+	require.NoError(sink.Write(testEntry(1, "bob", "istio-system", "gateways")))
+
+	page, err := sink.Search(Query{Namespace: "bookinfo"})
+	require.NoError(err)
+	require.Len(page.Entries, 1)
+	assert.Equal("alice", page.Entries[0].User)
+
+	page, err = sink.Search(Query{})
+	require.NoError(err)
+	assert.Equal(2, page.Total)
+}
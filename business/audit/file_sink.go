@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/kiali/kiali/log"
+)
+
+// FileSink writes the entries to a file as JSON lines. The file is rotated when it would exceed
+// maxSize bytes: it is renamed with the .1 suffix, the previous .1 file becomes .2, and so on, keeping
+// up to maxBackups rotated files. Searches read the current and the rotated files.
+type FileSink struct {
+	file       *os.File
+	lock       sync.Mutex
+	maxBackups int
+	maxSize    int64
+	path       string
+	size       int64
+}
+
+// NewFileSink creates a FileSink appending to the file at path. The file is opened on the first write.
+func NewFileSink(path string, maxSize int64, maxBackups int) *FileSink {
+	return &FileSink{
+		maxBackups: maxBackups,
+		maxSize:    maxSize,
+		path:       path,
+	}
+}
+
+func (f *FileSink) Write(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+	if f.size > 0 && f.size+int64(len(line)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := f.file.Write(line)
+	f.size += int64(n)
+	return err
+}
+
+func (f *FileSink) Search(query Query) (Page, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	matches := []Entry{}
+	for i := f.maxBackups; i >= 0; i-- {
+		entries, err := readEntries(f.backupPath(i))
+		if err != nil {
+			return Page{}, err
+		}
+		for _, e := range entries {
+			if query.Matches(e) {
+				matches = append(matches, e)
+			}
+		}
+	}
+	return paginate(matches, query), nil
+}
+
+func (f *FileSink) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate shifts the rotated files, dropping the oldest one, and starts a new file
+func (f *FileSink) rotate() error {
+	if err := f.file.Close(); err != nil {
+		log.Warningf("[Audit] Failed to close audit file [%s]: %v", f.path, err)
+	}
+	f.file = nil
+
+	if err := os.Remove(f.backupPath(f.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := f.maxBackups - 1; i >= 0; i-- {
+		if err := os.Rename(f.backupPath(i), f.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return f.open()
+}
+
+// backupPath returns the path of the i-th rotated file, the current file being the 0th
+func (f *FileSink) backupPath(i int) string {
+	if i == 0 {
+		return f.path
+	}
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
+
+// readEntries reads the entries of a file, skipping the malformed lines
+func readEntries(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			log.Warningf("[Audit] Skipping malformed audit entry in [%s]: %v", path, err)
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"sync"
+)
+
+// MemorySink keeps the latest entries in memory. Entries are lost when Kiali restarts
+// and are not shared across replicas.
+type MemorySink struct {
+	entries    []Entry
+	lock       sync.RWMutex
+	maxEntries int
+}
+
+// NewMemorySink creates an empty MemorySink keeping up to maxEntries entries.
+func NewMemorySink(maxEntries int) *MemorySink {
+	return &MemorySink{
+		entries:    []Entry{},
+		maxEntries: maxEntries,
+	}
+}
+
+func (m *MemorySink) Write(entry Entry) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.entries = append(m.entries, entry)
+	if len(m.entries) > m.maxEntries {
+		m.entries = append([]Entry{}, m.entries[len(m.entries)-m.maxEntries:]...)
+	}
+	return nil
+}
+
+func (m *MemorySink) Search(query Query) (Page, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	matches := []Entry{}
+	for _, e := range m.entries {
+		if query.Matches(e) {
+			matches = append(matches, e)
+		}
+	}
+	return paginate(matches, query), nil
+}
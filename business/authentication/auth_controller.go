@@ -100,17 +100,29 @@ func (e *AuthenticationFailureError) Error() string {
 
 var authController AuthController
 
+// serverSessionPersistor is set when sessions are kept in a server-side store.
+var serverSessionPersistor *ServerSessionPersistor
+
 // GetAuthController gets the authentication controller that is currently configured and handling
 // user sessions and any authentication related requests.
 func GetAuthController() AuthController {
 	return authController
 }
 
+// GetServerSessionPersistor gets the persistor of server-side sessions. It returns nil
+// when sessions are stored in browser cookies.
+func GetServerSessionPersistor() *ServerSessionPersistor {
+	return serverSessionPersistor
+}
+
 // InitializeAuthenticationController initializes the authentication controller associated to the
 // given strategy and prepares it to control user sessions and handle authentication requests.
 // This should be called during Kiali startup, before starting to listen to HTTP requests.
-func InitializeAuthenticationController(strategy string) {
-	persistor := CookieSessionPersistor{}
+func InitializeAuthenticationController(strategy string) error {
+	persistor, err := newSessionPersistor(config.Get().Auth.Session)
+	if err != nil {
+		return err
+	}
 
 	if strategy == config.AuthStrategyToken {
 		authController = NewTokenAuthController(persistor, nil)
@@ -121,4 +133,24 @@ func InitializeAuthenticationController(strategy string) {
 	} else if strategy == config.AuthStrategyHeader {
 		authController = NewHeaderAuthController(persistor, nil)
 	}
+	return nil
+}
+
+// newSessionPersistor creates the SessionPersistor for the configured session store.
+func newSessionPersistor(sessionConfig config.SessionConfig) (SessionPersistor, error) {
+	serverSessionPersistor = nil
+
+	switch sessionConfig.Store {
+	case config.SessionStoreMemory:
+		serverSessionPersistor = NewServerSessionPersistor(NewMemorySessionStore())
+	case config.SessionStoreFile:
+		store, err := NewFileSessionStore(sessionConfig.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the file session store: %w", err)
+		}
+		serverSessionPersistor = NewServerSessionPersistor(store)
+	default:
+		return CookieSessionPersistor{}, nil
+	}
+	return serverSessionPersistor, nil
 }
@@ -0,0 +1,188 @@
+package authentication
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/util"
+)
+
+// ServerSessionCookieName is the name of the cookie holding the opaque session id
+// when sessions are persisted with the ServerSessionPersistor.
+const ServerSessionCookieName = config.TokenCookieName + "-session"
+
+// sessionIdSize is the number of random bytes of a session id.
+const sessionIdSize = 32
+
+// ServerSessionPersistor is a session storage that keeps session data in a server-side
+// SessionStore. Only an opaque and random session id is sent to the browser in a cookie,
+// which keeps request headers small and allows revoking sessions from the server.
+type ServerSessionPersistor struct {
+	Store SessionStore
+}
+
+// NewServerSessionPersistor creates a ServerSessionPersistor using the given store.
+func NewServerSessionPersistor(store SessionStore) *ServerSessionPersistor {
+	return &ServerSessionPersistor{Store: store}
+}
+
+// CreateSession starts a user session by saving the session data in the server-side store
+// and setting a cookie with the id of the session. The strategy, expiresOn and payload
+// arguments are all required.
+func (p *ServerSessionPersistor) CreateSession(_ *http.Request, w http.ResponseWriter, strategy string, expiresOn time.Time, payload interface{}) error {
+	if payload == nil || len(strategy) == 0 {
+		return errors.New("a session cannot be created without strategy, or with a nil payload")
+	}
+
+	if !util.Clock.Now().Before(expiresOn) {
+		return errors.New("the expiration time of a session cannot be in the past")
+	}
+
+	payloadMarshalled, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error when creating the session - failed to marshal payload: %w", err)
+	}
+
+	randomId, err := util.CryptoRandomBytes(sessionIdSize)
+	if err != nil {
+		return fmt.Errorf("error when creating the session - failed to generate the session id: %w", err)
+	}
+	sessionId := base64.RawURLEncoding.EncodeToString(randomId)
+
+	session := StoredSession{
+		ID:        hashSessionId(sessionId),
+		Strategy:  strategy,
+		Subject:   sessionSubject(payloadMarshalled),
+		CreatedOn: util.Clock.Now(),
+		ExpiresOn: expiresOn,
+		Payload:   string(payloadMarshalled),
+	}
+	if err = p.Store.Put(session); err != nil {
+		return fmt.Errorf("error when creating the session - failed to store the session: %w", err)
+	}
+
+	sessionCookie := http.Cookie{
+		Name:     ServerSessionCookieName,
+		Value:    sessionId,
+		Expires:  expiresOn,
+		HttpOnly: true,
+		Path:     config.Get().Server.WebRoot,
+		SameSite: http.SameSiteStrictMode,
+	}
+	http.SetCookie(w, &sessionCookie)
+
+	return nil
+}
+
+// ReadSession looks up the session referenced by the session cookie and returns its data.
+// If a payload is provided, the original data is parsed and stored in the payload argument.
+// Sessions created with a different authentication strategy are terminated and no data is returned.
+func (p *ServerSessionPersistor) ReadSession(r *http.Request, w http.ResponseWriter, payload interface{}) (*sessionData, error) {
+	sessionCookie, err := r.Cookie(ServerSessionCookieName)
+	if err != nil {
+		if err == http.ErrNoCookie {
+			log.Tracef("The session cookie is missing.")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read the session cookie: %w", err)
+	}
+
+	session, err := p.Store.Get(hashSessionId(sessionCookie.Value))
+	if err != nil {
+		return nil, fmt.Errorf("error when restoring the session - failed to read the session store: %w", err)
+	}
+	if session == nil {
+		// Unknown, expired or revoked session.
+		log.Tracef("Session is invalid because it is not present in the session store")
+		p.TerminateSession(r, w)
+		return nil, nil
+	}
+
+	if session.Strategy != config.Get().Auth.Strategy {
+		log.Tracef("Session is invalid because it was created with authentication strategy %s, but current authentication strategy is %s", session.Strategy, config.Get().Auth.Strategy)
+		p.TerminateSession(r, w)
+		return nil, nil
+	}
+
+	if payload != nil {
+		payloadErr := json.Unmarshal([]byte(session.Payload), payload)
+		if payloadErr != nil {
+			return nil, fmt.Errorf("error when restoring the session - failed to parse the session payload: %w", payloadErr)
+		}
+	}
+
+	return &sessionData{
+		Strategy:  session.Strategy,
+		ExpiresOn: session.ExpiresOn,
+		Payload:   session.Payload,
+	}, nil
+}
+
+// TerminateSession removes the session from the store and clears the session cookie.
+// The session is terminated unconditionally.
+func (p *ServerSessionPersistor) TerminateSession(r *http.Request, w http.ResponseWriter) {
+	sessionCookie, err := r.Cookie(ServerSessionCookieName)
+	if err != nil {
+		return
+	}
+
+	if err = p.Store.Delete(hashSessionId(sessionCookie.Value)); err != nil {
+		log.Errorf("Failed to remove a session from the session store: %v", err)
+	}
+
+	tokenCookie := http.Cookie{
+		Name:     ServerSessionCookieName,
+		Value:    "",
+		Expires:  time.Unix(0, 0),
+		HttpOnly: true,
+		MaxAge:   -1,
+		Path:     config.Get().Server.WebRoot,
+		SameSite: http.SameSiteStrictMode,
+	}
+	http.SetCookie(w, &tokenCookie)
+}
+
+// ListSessions returns all the active sessions.
+func (p *ServerSessionPersistor) ListSessions() ([]StoredSession, error) {
+	return p.Store.List()
+}
+
+// RevokeSession removes the session with the given store id. Revoked sessions
+// are rejected the next time their owner sends a request.
+func (p *ServerSessionPersistor) RevokeSession(id string) error {
+	return p.Store.Delete(id)
+}
+
+// hashSessionId returns the key used to save the session in the store. Only hashes of
+// session ids are stored, so the content of a store cannot be used to hijack sessions.
+func hashSessionId(sessionId string) string {
+	hash := sha256.Sum256([]byte(sessionId))
+	return hex.EncodeToString(hash[:])
+}
+
+// sessionSubject resolves the user owning a session from its serialized payload.
+// Payloads of the authentication controllers either have a subject or a Kubernetes token.
+func sessionSubject(payload []byte) string {
+	var fields struct {
+		Subject string `json:"subject"`
+		Token   string `json:"token"`
+	}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return ""
+	}
+	if fields.Subject != "" {
+		return fields.Subject
+	}
+	if fields.Token != "" {
+		return extractSubjectFromK8sToken(fields.Token)
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/util"
+)
+
+func setupServerSessionTest() {
+	cfg := config.NewConfig()
+	cfg.Auth.Strategy = "test"
+	cfg.Server.WebRoot = "/kiali-app"
+	cfg.LoginToken.SigningKey = "kiali67890123456"
+	config.Set(cfg)
+
+	clockTime := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	util.Clock = util.ClockMock{Time: clockTime}
+}
+
+// createServerSession creates a session and returns a request carrying the session cookie
+func createServerSession(t *testing.T, persistor *ServerSessionPersistor, payload interface{}, expiresTime time.Time) *http.Request {
+	rr := httptest.NewRecorder()
+	err := persistor.CreateSession(nil, rr, "test", expiresTime, payload)
+	assert.Nil(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/logout", nil)
+	for _, c := range rr.Result().Cookies() {
+		request.AddCookie(c)
+	}
+	return request
+}
+
+// TestServerCreateSessionSetsOpaqueCookie tests that the ServerSessionPersistor
+// only sends an opaque session id to the browser
+func TestServerCreateSessionSetsOpaqueCookie(t *testing.T) {
+	setupServerSessionTest()
+
+	rr := httptest.NewRecorder()
+	store := NewMemorySessionStore()
+	persistor := NewServerSessionPersistor(store)
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	err := persistor.CreateSession(nil, rr, "test", expiresTime, testSessionPayload{FirstField: "Foo"})
+
+	response := rr.Result()
+
+	assert.Nil(t, err)
+	assert.Len(t, response.Cookies(), 1)
+
+	cookie := response.Cookies()[0]
+	assert.True(t, cookie.HttpOnly)
+	assert.Equal(t, ServerSessionCookieName, cookie.Name)
+	assert.Equal(t, "/kiali-app", cookie.Path)
+	assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+	assert.Equal(t, expiresTime, cookie.Expires)
+	assert.NotContains(t, cookie.Value, "Foo")
+
+	// The store must not be keyed by the id sent to the browser
+	sessions, _ := store.List()
+	assert.Len(t, sessions, 1)
+	assert.NotEqual(t, cookie.Value, sessions[0].ID)
+	assert.Equal(t, "test", sessions[0].Strategy)
+}
+
+// TestServerCreateSessionRejectsInvalidArguments tests that the ServerSessionPersistor
+// rejects sessions without payload, without strategy or already expired
+func TestServerCreateSessionRejectsInvalidArguments(t *testing.T) {
+	setupServerSessionTest()
+
+	persistor := NewServerSessionPersistor(NewMemorySessionStore())
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	payload := testSessionPayload{FirstField: "Foo"}
+
+	assert.NotNil(t, persistor.CreateSession(nil, httptest.NewRecorder(), "test", expiresTime, nil))
+	assert.NotNil(t, persistor.CreateSession(nil, httptest.NewRecorder(), "", expiresTime, payload))
+	assert.NotNil(t, persistor.CreateSession(nil, httptest.NewRecorder(), "test", time.Date(2021, 11, 30, 0, 0, 0, 0, time.UTC), payload))
+}
+
+// TestServerReadSession tests that the ServerSessionPersistor restores
+// the payload of a session referenced by the session cookie
+func TestServerReadSession(t *testing.T) {
+	setupServerSessionTest()
+
+	persistor := NewServerSessionPersistor(NewMemorySessionStore())
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	request := createServerSession(t, persistor, testSessionPayload{FirstField: "FooBar"}, expiresTime)
+
+	restoredPayload := testSessionPayload{}
+	sData, err := persistor.ReadSession(request, httptest.NewRecorder(), &restoredPayload)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, sData)
+	assert.Equal(t, expiresTime, sData.ExpiresOn)
+	assert.Equal(t, "test", sData.Strategy)
+	assert.Equal(t, "FooBar", restoredPayload.FirstField)
+}
+
+// TestServerReadSessionRejectsExpired tests that the ServerSessionPersistor
+// does not restore expired sessions
+func TestServerReadSessionRejectsExpired(t *testing.T) {
+	setupServerSessionTest()
+
+	persistor := NewServerSessionPersistor(NewMemorySessionStore())
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	request := createServerSession(t, persistor, testSessionPayload{FirstField: "FooBar"}, expiresTime)
+
+	util.Clock = util.ClockMock{Time: time.Date(2021, 12, 1, 2, 0, 0, 0, time.UTC)}
+
+	rr := httptest.NewRecorder()
+	sData, err := persistor.ReadSession(request, rr, nil)
+
+	assert.Nil(t, err)
+	assert.Nil(t, sData)
+
+	// The stale cookie should be dropped
+	assert.Len(t, rr.Result().Cookies(), 1)
+	assert.Equal(t, -1, rr.Result().Cookies()[0].MaxAge)
+}
+
+// TestServerReadSessionRejectsDifferentStrategy tests that the ServerSessionPersistor
+// does not restore sessions created with a different authentication strategy
+func TestServerReadSessionRejectsDifferentStrategy(t *testing.T) {
+	setupServerSessionTest()
+
+	store := NewMemorySessionStore()
+	persistor := NewServerSessionPersistor(store)
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	request := createServerSession(t, persistor, testSessionPayload{FirstField: "FooBar"}, expiresTime)
+
+	cfg := config.Get()
+	cfg.Auth.Strategy = "other"
+	config.Set(cfg)
+
+	sData, err := persistor.ReadSession(request, httptest.NewRecorder(), nil)
+
+	assert.Nil(t, err)
+	assert.Nil(t, sData)
+
+	sessions, _ := store.List()
+	assert.Empty(t, sessions)
+}
+
+// TestServerRevokeSession tests that a revoked session can no longer be restored
+func TestServerRevokeSession(t *testing.T) {
+	setupServerSessionTest()
+
+	persistor := NewServerSessionPersistor(NewMemorySessionStore())
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	request := createServerSession(t, persistor, tokenSessionPayload{Token: "foo"}, expiresTime)
+	createServerSession(t, persistor, headerSessionPayload{Subject: "jdoe"}, expiresTime)
+
+	sessions, err := persistor.ListSessions()
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 2)
+
+	// Find the session of the request
+	var id string
+	for _, s := range sessions {
+		if s.Subject == "jdoe" {
+			continue
+		}
+		id = s.ID
+	}
+	assert.Nil(t, persistor.RevokeSession(id))
+
+	sData, err := persistor.ReadSession(request, httptest.NewRecorder(), nil)
+	assert.Nil(t, err)
+	assert.Nil(t, sData)
+
+	sessions, _ = persistor.ListSessions()
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "jdoe", sessions[0].Subject)
+}
+
+// TestServerTerminateSession tests that the ServerSessionPersistor removes
+// the session from the store and clears the session cookie
+func TestServerTerminateSession(t *testing.T) {
+	setupServerSessionTest()
+
+	store := NewMemorySessionStore()
+	persistor := NewServerSessionPersistor(store)
+	expiresTime := time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC)
+	request := createServerSession(t, persistor, testSessionPayload{FirstField: "FooBar"}, expiresTime)
+
+	rr := httptest.NewRecorder()
+	persistor.TerminateSession(request, rr)
+
+	sessions, _ := store.List()
+	assert.Empty(t, sessions)
+
+	response := rr.Result()
+	assert.Len(t, response.Cookies(), 1)
+	assert.Equal(t, ServerSessionCookieName, response.Cookies()[0].Name)
+	assert.Equal(t, "", response.Cookies()[0].Value)
+	assert.Equal(t, -1, response.Cookies()[0].MaxAge)
+}
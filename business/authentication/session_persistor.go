@@ -92,22 +92,10 @@ func (p CookieSessionPersistor) CreateSession(_ *http.Request, w http.ResponseWr
 	// The sDataJson string holds the session data that we want to persist.
 	// It's time to encrypt this data which will result in an illegible sequence of bytes which are then
 	// encoded to base64 get a string that is suitable to store in browser cookies.
-	block, err := aes.NewCipher([]byte(config.GetSigningKey()))
+	cipherSessionData, err := encryptSessionData(sDataJson)
 	if err != nil {
-		return fmt.Errorf("error when creating the session - failed to create cipher: %w", err)
+		return fmt.Errorf("error when creating the session - %w", err)
 	}
-
-	aesGcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return fmt.Errorf("error when creating credentials - failed to create gcm: %w", err)
-	}
-
-	aesGcmNonce, err := util.CryptoRandomBytes(aesGcm.NonceSize())
-	if err != nil {
-		return fmt.Errorf("error when creating credentials - failed to generate random bytes: %w", err)
-	}
-
-	cipherSessionData := aesGcm.Seal(aesGcmNonce, aesGcmNonce, sDataJson, nil)
 	base64SessionData := base64.StdEncoding.EncodeToString(cipherSessionData)
 
 	// The base64SessionData holds what we want to store in browser cookies.
@@ -233,22 +221,9 @@ func (p CookieSessionPersistor) ReadSession(r *http.Request, w http.ResponseWrit
 		}
 	}
 
-	block, err := aes.NewCipher([]byte(config.GetSigningKey()))
+	sessionDataJson, err := decryptSessionData(cipherSessionData)
 	if err != nil {
-		return nil, fmt.Errorf("error when restoring the session - failed to create the cipher: %w", err)
-	}
-
-	aesGCM, err := cipher.NewGCM(block)
-	if err != nil {
-		return nil, fmt.Errorf("error when restoring the session - failed to create gcm: %w", err)
-	}
-
-	nonceSize := aesGCM.NonceSize()
-	nonce, cipherSessionData := cipherSessionData[:nonceSize], cipherSessionData[nonceSize:]
-
-	sessionDataJson, err := aesGCM.Open(nil, nonce, cipherSessionData, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error when restoring the session - failed to decrypt: %w", err)
+		return nil, fmt.Errorf("error when restoring the session - %w", err)
 	}
 
 	// sessionDataJson is holding the decrypted data as a string. This should be a JSON document. Let's parse it.
@@ -335,6 +310,52 @@ func (p CookieSessionPersistor) TerminateSession(r *http.Request, w http.Respons
 	}
 }
 
+// encryptSessionData ciphers the given data using the AES-GCM algorithm and the configured signing key.
+// The returned bytes are prefixed with the random nonce that was used to seal the data.
+func encryptSessionData(data []byte) ([]byte, error) {
+	block, err := aes.NewCipher([]byte(config.GetSigningKey()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aesGcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	aesGcmNonce, err := util.CryptoRandomBytes(aesGcm.NonceSize())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return aesGcm.Seal(aesGcmNonce, aesGcmNonce, data, nil), nil
+}
+
+// decryptSessionData restores the data ciphered with the encryptSessionData function.
+func decryptSessionData(cipherData []byte) ([]byte, error) {
+	block, err := aes.NewCipher([]byte(config.GetSigningKey()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cipher: %w", err)
+	}
+
+	aesGCM, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	nonceSize := aesGCM.NonceSize()
+	if len(cipherData) < nonceSize {
+		return nil, errors.New("failed to decrypt: data is too short")
+	}
+	nonce, cipherData := cipherData[:nonceSize], cipherData[nonceSize:]
+
+	data, err := aesGCM.Open(nil, nonce, cipherData, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return data, nil
+}
+
 // Acknowledgement to rinat.io user of SO.
 // Taken from https://stackoverflow.com/a/48479355 with a few modifications
 func chunkString(s string, chunkSize int) []string {
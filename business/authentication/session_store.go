@@ -0,0 +1,255 @@
+package authentication
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/util"
+)
+
+// StoredSession holds the data of a user session kept in a server-side SessionStore.
+type StoredSession struct {
+	// ID is the key of the session in the store. It is a hash of the opaque
+	// id sent to the browser, so it is safe to show it to administrators.
+	ID string `json:"id"`
+
+	// Strategy is the authentication strategy that was configured when the session was created.
+	Strategy string `json:"strategy"`
+
+	// Subject is the name of the user owning the session, if it could be resolved.
+	Subject string `json:"subject,omitempty"`
+
+	CreatedOn time.Time `json:"createdOn"`
+	ExpiresOn time.Time `json:"expiresOn"`
+
+	// Payload is the serialized payload of the session. It is never sent to clients.
+	Payload string `json:"-"`
+}
+
+// isExpired checks if the session is already expired.
+func (s StoredSession) isExpired() bool {
+	return !util.Clock.Now().Before(s.ExpiresOn)
+}
+
+// SessionStore is a server-side storage for the sessions managed by the ServerSessionPersistor.
+// Implementations must be safe for concurrent use and must not return expired sessions.
+type SessionStore interface {
+	// Get returns the session with the given id, or nil if there is no active session with that id.
+	Get(id string) (*StoredSession, error)
+
+	// Put stores the session, replacing any existing session with the same id.
+	Put(session StoredSession) error
+
+	// Delete removes the session with the given id. Deleting a missing session is not an error.
+	Delete(id string) error
+
+	// List returns all the active sessions, sorted by creation time.
+	List() ([]StoredSession, error)
+}
+
+// MemorySessionStore keeps sessions in memory. Sessions are lost when Kiali restarts
+// and are not shared across replicas. Expired sessions are evicted when the store is accessed.
+type MemorySessionStore struct {
+	lock     sync.RWMutex
+	sessions map[string]StoredSession
+}
+
+// NewMemorySessionStore creates an empty MemorySessionStore.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{
+		sessions: map[string]StoredSession{},
+	}
+}
+
+func (m *MemorySessionStore) Get(id string) (*StoredSession, error) {
+	m.lock.RLock()
+	session, ok := m.sessions[id]
+	m.lock.RUnlock()
+
+	if !ok {
+		return nil, nil
+	}
+	if session.isExpired() {
+		_ = m.Delete(id)
+		return nil, nil
+	}
+	return &session, nil
+}
+
+func (m *MemorySessionStore) Put(session StoredSession) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.evictExpired()
+	m.sessions[session.ID] = session
+	return nil
+}
+
+func (m *MemorySessionStore) Delete(id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.sessions, id)
+	return nil
+}
+
+func (m *MemorySessionStore) List() ([]StoredSession, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.evictExpired()
+	sessions := make([]StoredSession, 0, len(m.sessions))
+	for _, session := range m.sessions {
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedOn.Before(sessions[j].CreatedOn)
+	})
+	return sessions, nil
+}
+
+// evictExpired removes expired sessions. The caller must hold the write lock.
+func (m *MemorySessionStore) evictExpired() {
+	for id, session := range m.sessions {
+		if session.isExpired() {
+			delete(m.sessions, id)
+		}
+	}
+}
+
+// FileSessionStore keeps sessions in memory and mirrors them to a file, so sessions
+// survive restarts of a single Kiali replica. Session payloads are encrypted with the
+// signing key before being written to disk.
+type FileSessionStore struct {
+	// fileLock serializes writes to the file
+	fileLock sync.Mutex
+	memory   *MemorySessionStore
+	path     string
+}
+
+// fileSessionEntry is the on-disk representation of a StoredSession.
+type fileSessionEntry struct {
+	StoredSession
+	EncryptedPayload string `json:"payload"`
+}
+
+// NewFileSessionStore creates a FileSessionStore backed by the file at the given path.
+// Active sessions already present in the file are loaded.
+func NewFileSessionStore(path string) (*FileSessionStore, error) {
+	store := &FileSessionStore{
+		memory: NewMemorySessionStore(),
+		path:   path,
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return store, nil
+		}
+		return nil, fmt.Errorf("unable to read the sessions file: %w", err)
+	}
+	if len(content) == 0 {
+		return store, nil
+	}
+
+	var entries []fileSessionEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("unable to parse the sessions file: %w", err)
+	}
+
+	for _, entry := range entries {
+		session := entry.StoredSession
+		if session.isExpired() {
+			continue
+		}
+		cipherPayload, err := base64.StdEncoding.DecodeString(entry.EncryptedPayload)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the payload of session [%s]: %w", session.ID, err)
+		}
+		payload, err := decryptSessionData(cipherPayload)
+		if err != nil {
+			// Most likely, the signing key changed. Drop the session and force the user to login again.
+			continue
+		}
+		session.Payload = string(payload)
+		store.memory.sessions[session.ID] = session
+	}
+
+	return store, nil
+}
+
+func (f *FileSessionStore) Get(id string) (*StoredSession, error) {
+	return f.memory.Get(id)
+}
+
+func (f *FileSessionStore) Put(session StoredSession) error {
+	if err := f.memory.Put(session); err != nil {
+		return err
+	}
+	return f.flush()
+}
+
+func (f *FileSessionStore) Delete(id string) error {
+	if err := f.memory.Delete(id); err != nil {
+		return err
+	}
+	return f.flush()
+}
+
+func (f *FileSessionStore) List() ([]StoredSession, error) {
+	return f.memory.List()
+}
+
+// flush writes all active sessions to the file. The file is first written to a temporary
+// location and then renamed, so a crash never leaves a truncated file behind.
+func (f *FileSessionStore) flush() error {
+	f.fileLock.Lock()
+	defer f.fileLock.Unlock()
+
+	sessions, err := f.memory.List()
+	if err != nil {
+		return err
+	}
+
+	entries := make([]fileSessionEntry, 0, len(sessions))
+	for _, session := range sessions {
+		cipherPayload, err := encryptSessionData([]byte(session.Payload))
+		if err != nil {
+			return fmt.Errorf("unable to encrypt the payload of session [%s]: %w", session.ID, err)
+		}
+		entries = append(entries, fileSessionEntry{
+			StoredSession:    session,
+			EncryptedPayload: base64.StdEncoding.EncodeToString(cipherPayload),
+		})
+	}
+
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("unable to serialize sessions: %w", err)
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("unable to write the sessions file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err = tmpFile.Write(content); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("unable to write the sessions file: %w", err)
+	}
+	if err = tmpFile.Close(); err != nil {
+		return fmt.Errorf("unable to write the sessions file: %w", err)
+	}
+	if err = os.Rename(tmpFile.Name(), f.path); err != nil {
+		return fmt.Errorf("unable to write the sessions file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package authentication
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/util"
+)
+
+func buildStoredSession(id string, expiresOn time.Time) StoredSession {
+	return StoredSession{
+		ID:        id,
+		Strategy:  "test",
+		CreatedOn: util.Clock.Now(),
+		ExpiresOn: expiresOn,
+		Payload:   `{"token":"secret-token"}`,
+	}
+}
+
+// TestMemorySessionStoreEvictsExpired tests that the MemorySessionStore
+// does not return sessions after they expire
+func TestMemorySessionStoreEvictsExpired(t *testing.T) {
+	util.Clock = util.ClockMock{Time: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)}
+
+	store := NewMemorySessionStore()
+	assert.Nil(t, store.Put(buildStoredSession("short", time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC))))
+	assert.Nil(t, store.Put(buildStoredSession("long", time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC))))
+
+	session, err := store.Get("short")
+	assert.Nil(t, err)
+	assert.NotNil(t, session)
+
+	util.Clock = util.ClockMock{Time: time.Date(2021, 12, 1, 2, 0, 0, 0, time.UTC)}
+
+	session, err = store.Get("short")
+	assert.Nil(t, err)
+	assert.Nil(t, session)
+
+	sessions, err := store.List()
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "long", sessions[0].ID)
+}
+
+// TestFileSessionStorePersistsSessions tests that sessions saved in a FileSessionStore
+// are restored by a new store using the same file, and that payloads are not saved in plain text
+func TestFileSessionStorePersistsSessions(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.LoginToken.SigningKey = "kiali67890123456"
+	config.Set(cfg)
+	util.Clock = util.ClockMock{Time: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)}
+
+	path := filepath.Join(t.TempDir(), "sessions.json")
+	store, err := NewFileSessionStore(path)
+	assert.Nil(t, err)
+
+	assert.Nil(t, store.Put(buildStoredSession("first", time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC))))
+	assert.Nil(t, store.Put(buildStoredSession("second", time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC))))
+	assert.Nil(t, store.Delete("first"))
+
+	content, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "second")
+	assert.NotContains(t, string(content), "secret-token")
+
+	restored, err := NewFileSessionStore(path)
+	assert.Nil(t, err)
+
+	sessions, err := restored.List()
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "second", sessions[0].ID)
+	assert.Equal(t, `{"token":"secret-token"}`, sessions[0].Payload)
+}
+
+// TestFileSessionStoreDropsSessionsOfOtherKey tests that sessions encrypted with
+// a different signing key are discarded when loading the file
+func TestFileSessionStoreDropsSessionsOfOtherKey(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.LoginToken.SigningKey = "kiali67890123456"
+	config.Set(cfg)
+	util.Clock = util.ClockMock{Time: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)}
+
+	path := filepath.Join(t.TempDir(), "sessions.json")
+	store, err := NewFileSessionStore(path)
+	assert.Nil(t, err)
+	assert.Nil(t, store.Put(buildStoredSession("first", time.Date(2021, 12, 1, 1, 0, 0, 0, time.UTC))))
+
+	cfg.LoginToken.SigningKey = "kiali-other-1234"
+	config.Set(cfg)
+
+	restored, err := NewFileSessionStore(path)
+	assert.Nil(t, err)
+
+	sessions, err := restored.List()
+	assert.Nil(t, err)
+	assert.Empty(t, sessions)
+}
@@ -0,0 +1,397 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	api_security_v1beta1 "istio.io/api/security/v1beta1"
+	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+)
+
+// SimulateAuthorization evaluates a request to a workload against the AuthorizationPolicies that apply to it, from
+// its namespace and from the root namespace, and against the mTLS mode given by the PeerAuthentications.
+func (in *WorkloadService) SimulateAuthorization(ctx context.Context, namespace, workloadName string, request models.AuthorizationRequest) (models.AuthorizationDecision, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "SimulateAuthorization",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("workloadName", workloadName),
+	)
+	defer end()
+
+	workload, err := in.GetWorkload(ctx, WorkloadCriteria{Namespace: namespace, WorkloadName: workloadName})
+	if err != nil {
+		return models.AuthorizationDecision{}, err
+	}
+
+	criteria := IstioConfigCriteria{
+		AllNamespaces:                true,
+		IncludeAuthorizationPolicies: true,
+		IncludePeerAuthentications:   true,
+	}
+	istioConfigList, err := in.businessLayer.IstioConfig.GetIstioConfigList(ctx, criteria)
+	if err != nil {
+		return models.AuthorizationDecision{}, err
+	}
+
+	return evaluateAuthorization(namespace, workload.Labels, istioConfigList.AuthorizationPolicies, istioConfigList.PeerAuthentications, request), nil
+}
+
+// evaluateAuthorization follows the Istio evaluation order: CUSTOM policies are delegated to an external authorizer,
+// a request matching a DENY policy is denied, and the request is allowed when no ALLOW policy applies to the workload
+// or when an ALLOW policy matches it. AUDIT policies don't affect the decision. The decision is UNKNOWN when it
+// depends on a policy that may match the request through a condition that can't be evaluated.
+func evaluateAuthorization(namespace string, workloadLabels map[string]string, authorizationPolicies []security_v1beta1.AuthorizationPolicy, peerAuthentications []security_v1beta1.PeerAuthentication, request models.AuthorizationRequest) models.AuthorizationDecision {
+	workloadSelector := labels.Set(workloadLabels).AsSelector().String()
+
+	decision := models.AuthorizationDecision{
+		MTLSMode:  peerAuthenticationMode(namespace, workloadSelector, request.Port, peerAuthentications),
+		Principal: sourcePrincipal(request.Source),
+		Policies:  []models.AuthorizationPolicyMatch{},
+	}
+	if decision.MTLSMode == "DISABLE" {
+		decision.Principal = ""
+	} else if decision.MTLSMode == "STRICT" && decision.Principal == "" {
+		decision.Decision = models.AuthorizationDeny
+		decision.Reason = "The workload port requires mTLS and the client has no mesh identity"
+		return decision
+	}
+
+	applying := []security_v1beta1.AuthorizationPolicy{}
+	for _, ap := range authorizationPolicies {
+		if ap.Namespace == namespace || config.IsRootNamespace(ap.Namespace) {
+			applying = append(applying, ap)
+		}
+	}
+	applying = kubernetes.FilterAuthorizationPoliciesBySelector(workloadSelector, applying)
+	sort.Slice(applying, func(i, j int) bool {
+		if applying[i].Namespace != applying[j].Namespace {
+			return applying[i].Namespace < applying[j].Namespace
+		}
+		return applying[i].Name < applying[j].Name
+	})
+
+	authzContext := authorizationContext{request: request, principal: decision.Principal, namespace: principalNamespace(decision.Principal)}
+	var custom, deny, allow *models.AuthorizationPolicyMatch
+	var maybeCustom, maybeDeny, maybeAllow *models.AuthorizationPolicyMatch
+	hasAllowPolicies := false
+	for _, ap := range applying {
+		match := authzContext.matchPolicy(ap)
+		decision.Policies = append(decision.Policies, match)
+		if !match.Matched {
+			switch match.Action {
+			case api_security_v1beta1.AuthorizationPolicy_ALLOW.String():
+				hasAllowPolicies = true
+				if match.Indeterminate != "" && maybeAllow == nil {
+					maybeAllow = &match
+				}
+			case api_security_v1beta1.AuthorizationPolicy_DENY.String():
+				if match.Indeterminate != "" && maybeDeny == nil {
+					maybeDeny = &match
+				}
+			case api_security_v1beta1.AuthorizationPolicy_CUSTOM.String():
+				if match.Indeterminate != "" && maybeCustom == nil {
+					maybeCustom = &match
+				}
+			}
+			continue
+		}
+		switch match.Action {
+		case api_security_v1beta1.AuthorizationPolicy_ALLOW.String():
+			hasAllowPolicies = true
+			if allow == nil {
+				allow = &match
+			}
+		case api_security_v1beta1.AuthorizationPolicy_DENY.String():
+			if deny == nil {
+				deny = &match
+			}
+		case api_security_v1beta1.AuthorizationPolicy_CUSTOM.String():
+			if custom == nil {
+				custom = &match
+			}
+		case api_security_v1beta1.AuthorizationPolicy_AUDIT.String():
+			if decision.Audit == nil {
+				decision.Audit = &match
+			}
+		}
+	}
+
+	switch {
+	case deny != nil:
+		decision.Decision, decision.Reason, decision.Policy = models.AuthorizationDeny, "The request matches a DENY policy", deny
+	case maybeDeny != nil:
+		decision.Decision, decision.Reason, decision.Policy = models.AuthorizationUnknown, indeterminateReason("DENY", maybeDeny), maybeDeny
+	case hasAllowPolicies && allow == nil && maybeAllow != nil:
+		decision.Decision, decision.Reason, decision.Policy = models.AuthorizationUnknown, indeterminateReason("ALLOW", maybeAllow), maybeAllow
+	case hasAllowPolicies && allow == nil:
+		decision.Decision, decision.Reason = models.AuthorizationDeny, "The request doesn't match any of the ALLOW policies of the workload"
+	case custom != nil:
+		decision.Decision, decision.Reason, decision.Policy = models.AuthorizationCustom, "The request is delegated to the external authorizer of a CUSTOM policy", custom
+	case maybeCustom != nil:
+		decision.Decision, decision.Reason, decision.Policy = models.AuthorizationUnknown, indeterminateReason("CUSTOM", maybeCustom), maybeCustom
+	case allow != nil:
+		decision.Decision, decision.Reason, decision.Policy = models.AuthorizationAllow, "The request matches an ALLOW policy", allow
+	default:
+		decision.Decision, decision.Reason = models.AuthorizationAllow, "No ALLOW policy applies to the workload"
+	}
+	return decision
+}
+
+func indeterminateReason(action string, match *models.AuthorizationPolicyMatch) string {
+	return fmt.Sprintf("The request may match a %s policy, depending on its condition on %s that can't be evaluated", action, match.Indeterminate)
+}
+
+// peerAuthenticationMode returns the mTLS mode of the workload port: the workload PeerAuthentications take precedence
+// over the namespace one, and the namespace one over the mesh-wide one in the root namespace. Default is PERMISSIVE.
+func peerAuthenticationMode(namespace, workloadSelector string, port int, peerAuthentications []security_v1beta1.PeerAuthentication) string {
+	workloadPAs, namespacePAs, meshPAs := []security_v1beta1.PeerAuthentication{}, []security_v1beta1.PeerAuthentication{}, []security_v1beta1.PeerAuthentication{}
+	for _, pa := range peerAuthentications {
+		hasSelector := pa.Spec.Selector != nil && len(pa.Spec.Selector.MatchLabels) > 0
+		switch {
+		case pa.Namespace == namespace && hasSelector:
+			workloadPAs = append(workloadPAs, pa)
+		case pa.Namespace == namespace:
+			namespacePAs = append(namespacePAs, pa)
+		case config.IsRootNamespace(pa.Namespace) && !hasSelector:
+			meshPAs = append(meshPAs, pa)
+		}
+	}
+
+	for _, pa := range kubernetes.FilterPeerAuthenticationsBySelector(workloadSelector, workloadPAs) {
+		if portMtls, ok := pa.Spec.PortLevelMtls[uint32(port)]; ok && portMtls != nil && portMtls.Mode != api_security_v1beta1.PeerAuthentication_MutualTLS_UNSET {
+			return portMtls.Mode.String()
+		}
+		if _, mode := kubernetes.PeerAuthnMTLSMode(pa); mode != "" && mode != "UNSET" {
+			return mode
+		}
+	}
+	for _, pas := range [][]security_v1beta1.PeerAuthentication{namespacePAs, meshPAs} {
+		for _, pa := range pas {
+			if _, mode := kubernetes.PeerAuthnMTLSMode(pa); mode != "" && mode != "UNSET" {
+				return mode
+			}
+		}
+	}
+	return "PERMISSIVE"
+}
+
+// sourcePrincipal returns the principal of the client, <trust domain>/ns/<namespace>/sa/<service account>
+func sourcePrincipal(source models.AuthorizationSource) string {
+	if source.Principal != "" {
+		return source.Principal
+	}
+	if source.Namespace == "" {
+		return ""
+	}
+	serviceAccount := source.ServiceAccount
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	trustDomain := strings.Replace(config.Get().ExternalServices.Istio.IstioIdentityDomain, "svc.", "", 1)
+	return fmt.Sprintf("%s/ns/%s/sa/%s", trustDomain, source.Namespace, serviceAccount)
+}
+
+func principalNamespace(principal string) string {
+	parts := strings.Split(principal, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "ns" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// authorizationContext holds the attributes of the request seen by the workload
+type authorizationContext struct {
+	request   models.AuthorizationRequest
+	principal string
+	namespace string
+}
+
+func (c authorizationContext) matchPolicy(ap security_v1beta1.AuthorizationPolicy) models.AuthorizationPolicyMatch {
+	match := models.AuthorizationPolicyMatch{Name: ap.Name, Namespace: ap.Namespace, Action: ap.Spec.Action.String(), Rule: -1}
+	if provider := ap.Spec.GetProvider(); provider != nil {
+		match.Provider = provider.Name
+	}
+	// A policy without rules doesn't match any request
+	for i, rule := range ap.Spec.Rules {
+		if rule == nil {
+			continue
+		}
+		matched, indeterminate := c.matchRule(rule)
+		if matched {
+			match.Matched, match.Rule, match.Path, match.Indeterminate = true, i, fmt.Sprintf("spec/rules[%d]", i), ""
+			break
+		}
+		if indeterminate != "" && match.Indeterminate == "" {
+			match.Indeterminate = indeterminate
+		}
+	}
+	return match
+}
+
+// matchRule returns true when the request matches any of the sources, any of the operations and all the conditions.
+// When the request matches everything but conditions that can't be evaluated, it returns false with the key of the
+// first of them.
+func (c authorizationContext) matchRule(rule *api_security_v1beta1.Rule) (bool, string) {
+	if len(rule.From) > 0 {
+		matched := false
+		for _, from := range rule.From {
+			if from != nil && c.matchSource(from.Source) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, ""
+		}
+	}
+	if len(rule.To) > 0 {
+		matched := false
+		for _, to := range rule.To {
+			if to != nil && c.matchOperation(to.Operation) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, ""
+		}
+	}
+	indeterminate := ""
+	for _, condition := range rule.When {
+		if condition == nil {
+			continue
+		}
+		matched, supported := c.matchCondition(condition)
+		if !supported {
+			if indeterminate == "" {
+				indeterminate = condition.Key
+			}
+		} else if !matched {
+			return false, ""
+		}
+	}
+	return indeterminate == "", indeterminate
+}
+
+func (c authorizationContext) matchSource(source *api_security_v1beta1.Source) bool {
+	if source == nil {
+		return true
+	}
+	ip := c.request.Source.IP
+	return matchValues(source.Principals, source.NotPrincipals, c.principal, matchString) &&
+		matchValues(source.RequestPrincipals, source.NotRequestPrincipals, c.request.Source.RequestPrincipal, matchString) &&
+		matchValues(source.Namespaces, source.NotNamespaces, c.namespace, matchString) &&
+		matchValues(source.IpBlocks, source.NotIpBlocks, ip, matchIP) &&
+		matchValues(source.RemoteIpBlocks, source.NotRemoteIpBlocks, ip, matchIP)
+}
+
+func (c authorizationContext) matchOperation(operation *api_security_v1beta1.Operation) bool {
+	if operation == nil {
+		return true
+	}
+	return matchValues(operation.Hosts, operation.NotHosts, c.request.Host, matchHost) &&
+		matchValues(operation.Ports, operation.NotPorts, strconv.Itoa(c.request.Port), matchString) &&
+		matchValues(operation.Methods, operation.NotMethods, c.request.Method, matchString) &&
+		matchValues(operation.Paths, operation.NotPaths, c.request.Path, matchString)
+}
+
+// matchCondition supports the request headers, source and destination port conditions. The conditions on other
+// attributes, like JWT claims, can't be evaluated and are reported as not supported.
+func (c authorizationContext) matchCondition(condition *api_security_v1beta1.Condition) (matched bool, supported bool) {
+	matcher := matchString
+	var value string
+	switch {
+	case strings.HasPrefix(condition.Key, "request.headers[") && strings.HasSuffix(condition.Key, "]"):
+		value = c.header(condition.Key[len("request.headers[") : len(condition.Key)-1])
+	case condition.Key == "source.ip" || condition.Key == "remote.ip":
+		value, matcher = c.request.Source.IP, matchIP
+	case condition.Key == "source.namespace":
+		value = c.namespace
+	case condition.Key == "source.principal":
+		value = c.principal
+	case condition.Key == "request.auth.principal":
+		value = c.request.Source.RequestPrincipal
+	case condition.Key == "destination.port":
+		value = strconv.Itoa(c.request.Port)
+	default:
+		return false, false
+	}
+	return matchValues(condition.Values, condition.NotValues, value, matcher), true
+}
+
+func (c authorizationContext) header(name string) string {
+	for k, v := range c.request.Headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+// matchValues returns true when the value matches any of the values, if any, and none of the excluded values
+func matchValues(values, notValues []string, value string, matcher func(pattern, value string) bool) bool {
+	if len(values) > 0 {
+		matched := false
+		for _, pattern := range values {
+			if matcher(pattern, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, pattern := range notValues {
+		if matcher(pattern, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchString supports exact, prefix (abc*), suffix (*abc) and presence (*) matching
+func matchString(pattern, value string) bool {
+	switch {
+	case pattern == "*":
+		return value != ""
+	case strings.HasPrefix(pattern, "*"):
+		return strings.HasSuffix(value, pattern[1:])
+	case strings.HasSuffix(pattern, "*"):
+		return strings.HasPrefix(value, pattern[:len(pattern)-1])
+	}
+	return pattern == value
+}
+
+// matchHost is case-insensitive and ignores the port of the host
+func matchHost(pattern, value string) bool {
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	return matchString(strings.ToLower(pattern), strings.ToLower(value))
+}
+
+// matchIP supports single IPs and CIDR ranges
+func matchIP(pattern, value string) bool {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return false
+	}
+	if _, cidr, err := net.ParseCIDR(pattern); err == nil {
+		return cidr.Contains(ip)
+	}
+	return ip.Equal(net.ParseIP(pattern))
+}
@@ -0,0 +1,217 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api_security_v1beta1 "istio.io/api/security/v1beta1"
+	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+var reviewsLabels = map[string]string{"app": "reviews", "version": "v1"}
+
+func TestEvaluateAuthorizationWithoutPolicies(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	decision := evaluateAuthorization("bookinfo", reviewsLabels, nil, nil, models.AuthorizationRequest{Port: 9080, Method: "GET", Path: "/reviews/1"})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	assert.Equal("PERMISSIVE", decision.MTLSMode)
+	assert.Empty(decision.Principal)
+	assert.Nil(decision.Policy)
+	assert.Empty(decision.Policies)
+}
+
+func TestEvaluateAuthorizationPeerAuthentication(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	pas := []security_v1beta1.PeerAuthentication{
+		*data.CreateEmptyMeshPeerAuthentication("default", data.CreateMTLS("STRICT")),
+	}
+
+	// Clients outside the mesh can't connect
+	decision := evaluateAuthorization("bookinfo", reviewsLabels, nil, pas, models.AuthorizationRequest{Port: 9080})
+	assert.Equal(models.AuthorizationDeny, decision.Decision)
+	assert.Equal("STRICT", decision.MTLSMode)
+	assert.Contains(decision.Reason, "mTLS")
+
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, nil, pas, models.AuthorizationRequest{Port: 9080, Source: models.AuthorizationSource{Namespace: "bookinfo"}})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	assert.Equal("cluster.local/ns/bookinfo/sa/default", decision.Principal)
+
+	// Namespace and workload PeerAuthentications take precedence, port level settings first
+	workloadPA := data.CreateEmptyPeerAuthenticationWithSelector("reviews", "bookinfo", map[string]string{"app": "reviews"})
+	workloadPA.Spec.Mtls = data.CreateMTLS("STRICT")
+	workloadPA.Spec.PortLevelMtls = map[uint32]*api_security_v1beta1.PeerAuthentication_MutualTLS{9080: data.CreateMTLS("DISABLE")}
+	pas = append(pas, *data.CreateEmptyPeerAuthentication("default", "bookinfo", data.CreateMTLS("PERMISSIVE")), *workloadPA)
+
+	assert.Equal("DISABLE", peerAuthenticationMode("bookinfo", "app=reviews,version=v1", 9080, pas))
+	assert.Equal("STRICT", peerAuthenticationMode("bookinfo", "app=reviews,version=v1", 9090, pas))
+	assert.Equal("PERMISSIVE", peerAuthenticationMode("bookinfo", "app=ratings", 9080, pas))
+	assert.Equal("STRICT", peerAuthenticationMode("travels", "app=reviews", 9080, pas))
+
+	// Without mTLS, the principal isn't available
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, nil, pas, models.AuthorizationRequest{Port: 9080, Source: models.AuthorizationSource{Namespace: "bookinfo"}})
+	assert.Equal("DISABLE", decision.MTLSMode)
+	assert.Empty(decision.Principal)
+}
+
+func TestEvaluateAuthorizationPolicies(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	allow := data.CreateAuthorizationPolicyWithMetaAndSelector("reviews-viewer", "bookinfo", map[string]string{"app": "reviews"})
+	allow.Spec.Rules = []*api_security_v1beta1.Rule{
+		{
+			From: []*api_security_v1beta1.Rule_From{{Source: &api_security_v1beta1.Source{Principals: []string{"cluster.local/ns/bookinfo/sa/bookinfo-admin"}}}},
+		},
+		{
+			From: []*api_security_v1beta1.Rule_From{{Source: &api_security_v1beta1.Source{Namespaces: []string{"bookinfo"}}}},
+			To:   []*api_security_v1beta1.Rule_To{{Operation: &api_security_v1beta1.Operation{Methods: []string{"GET"}, Paths: []string{"/reviews/*"}, NotPaths: []string{"/reviews/admin"}}}},
+		},
+	}
+	deny := data.CreateEmptyMeshAuthorizationPolicy("deny-debug")
+	deny.Spec.Action = api_security_v1beta1.AuthorizationPolicy_DENY
+	deny.Spec.Rules = []*api_security_v1beta1.Rule{
+		{When: []*api_security_v1beta1.Condition{{Key: "request.headers[X-Debug]", Values: []string{"true"}}}},
+	}
+	audit := data.CreateEmptyAuthorizationPolicy("audit-writes", "bookinfo")
+	audit.Spec.Action = api_security_v1beta1.AuthorizationPolicy_AUDIT
+	audit.Spec.Rules = []*api_security_v1beta1.Rule{
+		{To: []*api_security_v1beta1.Rule_To{{Operation: &api_security_v1beta1.Operation{NotMethods: []string{"GET"}}}}},
+	}
+	aps := []security_v1beta1.AuthorizationPolicy{
+		*allow, *deny, *audit,
+		// Policies of other namespaces and workloads don't apply
+		*data.CreateEmptyAuthorizationPolicy("allow-nothing", "travels"),
+		*data.CreateAuthorizationPolicyWithMetaAndSelector("ratings", "bookinfo", map[string]string{"app": "ratings"}),
+	}
+	productpage := models.AuthorizationSource{Namespace: "bookinfo", ServiceAccount: "bookinfo-productpage"}
+
+	decision := evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "GET", Path: "/reviews/1"})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	require.NotNil(decision.Policy)
+	assert.Equal(models.AuthorizationPolicyMatch{Name: "reviews-viewer", Namespace: "bookinfo", Action: "ALLOW", Matched: true, Rule: 1, Path: "spec/rules[1]"}, *decision.Policy)
+	assert.Nil(decision.Audit)
+	require.Len(decision.Policies, 3)
+	assert.Equal("audit-writes", decision.Policies[0].Name)
+	assert.Equal("deny-debug", decision.Policies[2].Name)
+	assert.False(decision.Policies[2].Matched)
+	assert.Equal(-1, decision.Policies[2].Rule)
+
+	// Excluded path
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "GET", Path: "/reviews/admin"})
+	assert.Equal(models.AuthorizationDeny, decision.Decision)
+	assert.Nil(decision.Policy)
+
+	// No ALLOW rule matches, the AUDIT policy does
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "POST", Path: "/reviews/1"})
+	assert.Equal(models.AuthorizationDeny, decision.Decision)
+	require.NotNil(decision.Audit)
+	assert.Equal("audit-writes", decision.Audit.Name)
+
+	// The principal matches the first rule, but the root namespace DENY policy takes precedence
+	admin := models.AuthorizationSource{Principal: "cluster.local/ns/bookinfo/sa/bookinfo-admin"}
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: admin, Port: 9080, Method: "POST", Path: "/reviews/admin"})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	assert.Equal(0, decision.Policy.Rule)
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: admin, Port: 9080, Method: "GET", Path: "/reviews/1", Headers: map[string]string{"x-debug": "true"}})
+	assert.Equal(models.AuthorizationDeny, decision.Decision)
+	assert.Equal("istio-system", decision.Policy.Namespace)
+	assert.Equal("deny-debug", decision.Policy.Name)
+}
+
+func TestEvaluateAuthorizationCustomPolicy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	custom := data.CreateAuthorizationPolicyWithMetaAndSelector("ext-authz", "bookinfo", map[string]string{"app": "reviews"})
+	custom.Spec.Action = api_security_v1beta1.AuthorizationPolicy_CUSTOM
+	custom.Spec.ActionDetail = &api_security_v1beta1.AuthorizationPolicy_Provider{Provider: &api_security_v1beta1.AuthorizationPolicy_ExtensionProvider{Name: "opa"}}
+	custom.Spec.Rules = []*api_security_v1beta1.Rule{
+		{To: []*api_security_v1beta1.Rule_To{{Operation: &api_security_v1beta1.Operation{Hosts: []string{"*.example.com"}}}}},
+	}
+	aps := []security_v1beta1.AuthorizationPolicy{*custom}
+
+	decision := evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Port: 9080, Host: "Reviews.Example.com:9080"})
+	assert.Equal(models.AuthorizationCustom, decision.Decision)
+	require.NotNil(decision.Policy)
+	assert.Equal("opa", decision.Policy.Provider)
+
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Port: 9080, Host: "reviews.bookinfo"})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	assert.Nil(decision.Policy)
+}
+
+func TestEvaluateAuthorizationUnsupportedCondition(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	deny := data.CreateAuthorizationPolicyWithMetaAndSelector("deny-guests", "bookinfo", map[string]string{"app": "reviews"})
+	deny.Spec.Action = api_security_v1beta1.AuthorizationPolicy_DENY
+	deny.Spec.Rules = []*api_security_v1beta1.Rule{
+		{
+			To: []*api_security_v1beta1.Rule_To{{Operation: &api_security_v1beta1.Operation{Methods: []string{"POST"}}}},
+			When: []*api_security_v1beta1.Condition{
+				{Key: "request.auth.claims[groups]", Values: []string{"guests"}},
+				{Key: "request.headers[X-Tenant]", Values: []string{"acme"}},
+			},
+		},
+	}
+	aps := []security_v1beta1.AuthorizationPolicy{*deny}
+	productpage := models.AuthorizationSource{Namespace: "bookinfo", ServiceAccount: "bookinfo-productpage"}
+
+	// The JWT claim can't be evaluated, the DENY policy may match
+	decision := evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "POST", Headers: map[string]string{"X-Tenant": "acme"}})
+	assert.Equal(models.AuthorizationUnknown, decision.Decision)
+	assert.Contains(decision.Reason, "request.auth.claims[groups]")
+	require.NotNil(decision.Policy)
+	assert.Equal(models.AuthorizationPolicyMatch{Name: "deny-guests", Namespace: "bookinfo", Action: "DENY", Rule: -1, Indeterminate: "request.auth.claims[groups]"}, *decision.Policy)
+
+	// The other attributes of the rule don't match, the DENY policy doesn't either
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "GET", Headers: map[string]string{"X-Tenant": "acme"}})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, aps, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "POST", Headers: map[string]string{"X-Tenant": "other"}})
+	assert.Equal(models.AuthorizationAllow, decision.Decision)
+	require.Len(decision.Policies, 1)
+	assert.Empty(decision.Policies[0].Indeterminate)
+
+	// An ALLOW policy that may match doesn't allow the request
+	allow := data.CreateAuthorizationPolicyWithMetaAndSelector("allow-admins", "bookinfo", map[string]string{"app": "reviews"})
+	allow.Spec.Rules = []*api_security_v1beta1.Rule{
+		{When: []*api_security_v1beta1.Condition{{Key: "request.auth.audiences", Values: []string{"reviews"}}}},
+	}
+	decision = evaluateAuthorization("bookinfo", reviewsLabels, []security_v1beta1.AuthorizationPolicy{*allow}, nil, models.AuthorizationRequest{Source: productpage, Port: 9080, Method: "GET"})
+	assert.Equal(models.AuthorizationUnknown, decision.Decision)
+	require.NotNil(decision.Policy)
+	assert.Equal("allow-admins", decision.Policy.Name)
+	assert.Equal("request.auth.audiences", decision.Policy.Indeterminate)
+}
+
+func TestAuthorizationMatchers(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(matchString("*", "GET"))
+	assert.False(matchString("*", ""))
+	assert.True(matchString("/api/*", "/api/v1"))
+	assert.True(matchString("*/sa/admin", "cluster.local/ns/bookinfo/sa/admin"))
+	assert.False(matchString("/api", "/api/v1"))
+
+	assert.True(matchIP("10.0.0.0/16", "10.0.1.2"))
+	assert.True(matchIP("10.0.1.2", "10.0.1.2"))
+	assert.False(matchIP("10.0.0.0/16", "10.1.1.2"))
+	assert.False(matchIP("10.0.0.0/16", ""))
+
+	assert.True(matchValues(nil, []string{"POST"}, "GET", matchString))
+	assert.False(matchValues([]string{"GET"}, nil, "POST", matchString))
+	assert.False(matchValues([]string{"/api/*"}, []string{"/api/admin"}, "/api/admin", matchString))
+}
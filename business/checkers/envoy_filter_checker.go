@@ -0,0 +1,76 @@
+package checkers
+
+import (
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/business/checkers/envoyfilters"
+	"github.com/kiali/kiali/models"
+)
+
+const EnvoyFilterCheckerType = "envoyfilter"
+
+type EnvoyFilterChecker struct {
+	EnvoyFilters          []networking_v1alpha3.EnvoyFilter
+	Gateways              []networking_v1beta1.Gateway
+	WorkloadsPerNamespace map[string]models.WorkloadList
+	MeshVersion           string
+}
+
+func (e EnvoyFilterChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	validations = validations.MergeValidations(e.runIndividualChecks())
+	validations = validations.MergeValidations(e.runGroupChecks())
+
+	return validations
+}
+
+func (e EnvoyFilterChecker) runGroupChecks() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	enabledCheckers := []GroupChecker{
+		envoyfilters.PriorityChecker{SubjectType: EnvoyFilterCheckerType, EnvoyFilters: e.EnvoyFilters, WorkloadsPerNamespace: e.WorkloadsPerNamespace},
+	}
+
+	for _, checker := range enabledCheckers {
+		validations = validations.MergeValidations(checker.Check())
+	}
+
+	return validations
+}
+
+func (e EnvoyFilterChecker) runIndividualChecks() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, envoyFilter := range e.EnvoyFilters {
+		validations.MergeValidations(e.runChecks(envoyFilter))
+	}
+
+	return validations
+}
+
+func (e EnvoyFilterChecker) runChecks(envoyFilter networking_v1alpha3.EnvoyFilter) models.IstioValidations {
+	key, rrValidation := EmptyValidValidation(envoyFilter.Name, envoyFilter.Namespace, EnvoyFilterCheckerType)
+	selectorLabels := make(map[string]string)
+	if envoyFilter.Spec.WorkloadSelector != nil {
+		selectorLabels = envoyFilter.Spec.WorkloadSelector.Labels
+	}
+
+	enabledCheckers := []Checker{
+		common.WorkloadSelectorNoWorkloadFoundChecker(EnvoyFilterCheckerType, selectorLabels, e.WorkloadsPerNamespace),
+		envoyfilters.PatchChecker{EnvoyFilter: envoyFilter},
+		envoyfilters.ProxyVersionChecker{EnvoyFilter: envoyFilter, MeshVersion: e.MeshVersion},
+		envoyfilters.ContextChecker{EnvoyFilter: envoyFilter, Gateways: e.Gateways, WorkloadsPerNamespace: e.WorkloadsPerNamespace},
+		envoyfilters.GlobalChecker{EnvoyFilter: envoyFilter},
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		rrValidation.Checks = append(rrValidation.Checks, checks...)
+		rrValidation.Valid = rrValidation.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
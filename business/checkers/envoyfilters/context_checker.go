@@ -0,0 +1,59 @@
+package envoyfilters
+
+import (
+	"fmt"
+
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/kiali/kiali/models"
+)
+
+// ContextChecker looks for patches whose context matches none of the proxies of the workloads selected by the
+// EnvoyFilter: a GATEWAY context for workloads that aren't gateways, or a SIDECAR context for workloads without sidecar.
+// Filters without workload selector or whose selector matches no workload are not checked.
+type ContextChecker struct {
+	EnvoyFilter           networking_v1alpha3.EnvoyFilter
+	Gateways              []networking_v1beta1.Gateway
+	WorkloadsPerNamespace map[string]models.WorkloadList
+}
+
+func (cc ContextChecker) Check() ([]*models.IstioCheck, bool) {
+	checks, valid := make([]*models.IstioCheck, 0), true
+
+	if cc.EnvoyFilter.Spec.WorkloadSelector == nil || len(cc.EnvoyFilter.Spec.WorkloadSelector.Labels) == 0 {
+		return checks, valid
+	}
+	workloads := appliedWorkloads(cc.EnvoyFilter, cc.WorkloadsPerNamespace)
+	if len(workloads) == 0 {
+		return checks, valid
+	}
+
+	hasGateway, hasSidecar := false, false
+	for _, w := range workloads {
+		if isGatewayWorkload(w, cc.Gateways) {
+			hasGateway = true
+		} else if w.IstioSidecar {
+			hasSidecar = true
+		}
+	}
+
+	for i, cp := range cc.EnvoyFilter.Spec.ConfigPatches {
+		if cp == nil || cp.Match == nil {
+			continue
+		}
+		matches := true
+		switch cp.Match.Context {
+		case api_networking_v1alpha3.EnvoyFilter_GATEWAY:
+			matches = hasGateway
+		case api_networking_v1alpha3.EnvoyFilter_SIDECAR_INBOUND, api_networking_v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND:
+			matches = hasSidecar
+		}
+		if !matches {
+			check := models.Build("envoyfilter.context.nomatch", fmt.Sprintf("spec/configPatches[%d]/match/context", i))
+			checks = append(checks, &check)
+		}
+	}
+	return checks, valid
+}
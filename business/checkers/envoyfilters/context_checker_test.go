@@ -0,0 +1,106 @@
+package envoyfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func contextWorkloads() map[string]models.WorkloadList {
+	reviews := data.CreateWorkloadListItem("reviews-v1", map[string]string{"app": "reviews"})
+	reviews.IstioSidecar = true
+	legacy := data.CreateWorkloadListItem("legacy", map[string]string{"app": "legacy"})
+	gateway := data.CreateWorkloadListItem("ingressgateway", map[string]string{"istio": "ingressgateway"})
+	gateway.IstioSidecar = true
+	return data.CreateWorkloadsPerNamespace("bookinfo", reviews, legacy, gateway)
+}
+
+func contextGateways() []networking_v1beta1.Gateway {
+	return []networking_v1beta1.Gateway{*data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", map[string]string{"istio": "ingressgateway"})}
+}
+
+func envoyFilterWithContext(selector map[string]string, context api_networking_v1alpha3.EnvoyFilter_PatchContext) *networking_v1alpha3.EnvoyFilter {
+	return data.AddPatchToEnvoyFilter(
+		data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER, context),
+		data.AddSelectorToEnvoyFilter(selector, data.CreateEnvoyFilter("filter", "bookinfo")))
+}
+
+func TestContextMatchingSelectedProxies(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	for selector, context := range map[string]api_networking_v1alpha3.EnvoyFilter_PatchContext{
+		"reviews":        api_networking_v1alpha3.EnvoyFilter_SIDECAR_INBOUND,
+		"ingressgateway": api_networking_v1alpha3.EnvoyFilter_GATEWAY,
+		"legacy":         api_networking_v1alpha3.EnvoyFilter_ANY,
+	} {
+		labels := map[string]string{"app": selector}
+		if selector == "ingressgateway" {
+			labels = map[string]string{"istio": selector}
+		}
+		vals, valid := ContextChecker{
+			EnvoyFilter:           *envoyFilterWithContext(labels, context),
+			Gateways:              contextGateways(),
+			WorkloadsPerNamespace: contextWorkloads(),
+		}.Check()
+
+		assert.Empty(vals, selector)
+		assert.True(valid)
+	}
+}
+
+func TestGatewayContextOnSidecar(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ContextChecker{
+		EnvoyFilter:           *envoyFilterWithContext(map[string]string{"app": "reviews"}, api_networking_v1alpha3.EnvoyFilter_GATEWAY),
+		Gateways:              contextGateways(),
+		WorkloadsPerNamespace: contextWorkloads(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.context.nomatch", vals[0]))
+	assert.Equal("spec/configPatches[0]/match/context", vals[0].Path)
+}
+
+func TestSidecarContextWithoutSidecar(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	for _, labels := range []map[string]string{{"app": "legacy"}, {"istio": "ingressgateway"}} {
+		vals, valid := ContextChecker{
+			EnvoyFilter:           *envoyFilterWithContext(labels, api_networking_v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND),
+			Gateways:              contextGateways(),
+			WorkloadsPerNamespace: contextWorkloads(),
+		}.Check()
+
+		assert.True(valid)
+		assert.Len(vals, 1)
+		assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.context.nomatch", vals[0]))
+	}
+}
+
+func TestContextWithoutSelectedWorkloads(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := ContextChecker{
+		EnvoyFilter:           *envoyFilterWithContext(map[string]string{"app": "ratings"}, api_networking_v1alpha3.EnvoyFilter_GATEWAY),
+		Gateways:              contextGateways(),
+		WorkloadsPerNamespace: contextWorkloads(),
+	}.Check()
+
+	assert.Empty(vals)
+	assert.True(valid)
+}
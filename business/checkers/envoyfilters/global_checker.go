@@ -0,0 +1,26 @@
+package envoyfilters
+
+import (
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+type GlobalChecker struct {
+	EnvoyFilter networking_v1alpha3.EnvoyFilter
+}
+
+func (gc GlobalChecker) Check() ([]*models.IstioCheck, bool) {
+	checks, valid := make([]*models.IstioCheck, 0), true
+
+	if !config.IsRootNamespace(gc.EnvoyFilter.Namespace) {
+		return checks, valid
+	}
+
+	if gc.EnvoyFilter.Spec.WorkloadSelector == nil || len(gc.EnvoyFilter.Spec.WorkloadSelector.Labels) == 0 {
+		check := models.Build("envoyfilter.global.meshwide", "spec")
+		checks = append(checks, &check)
+	}
+	return checks, valid
+}
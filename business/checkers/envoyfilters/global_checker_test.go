@@ -0,0 +1,54 @@
+package envoyfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestEnvoyFilterWithoutSelectorOutOfControlPlane(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals, valid := GlobalChecker{
+		EnvoyFilter: *data.CreateEnvoyFilter("filter", "bookinfo"),
+	}.Check()
+
+	assert.Empty(vals)
+	assert.True(valid)
+}
+
+func TestEnvoyFilterWithSelectorInControlPlane(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	vals, valid := GlobalChecker{
+		EnvoyFilter: *data.AddSelectorToEnvoyFilter(map[string]string{
+			"app": "reviews",
+		}, data.CreateEnvoyFilter("filter", conf.ExternalServices.Istio.RootNamespace)),
+	}.Check()
+
+	assert.Empty(vals)
+	assert.True(valid)
+}
+
+func TestEnvoyFilterWithoutSelectorInControlPlane(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	vals, valid := GlobalChecker{
+		EnvoyFilter: *data.CreateEnvoyFilter("filter", conf.ExternalServices.Istio.RootNamespace),
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.global.meshwide", vals[0]))
+}
@@ -0,0 +1,59 @@
+package envoyfilters
+
+import (
+	"fmt"
+
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/kiali/kiali/models"
+)
+
+type PatchChecker struct {
+	EnvoyFilter networking_v1alpha3.EnvoyFilter
+}
+
+func (pc PatchChecker) Check() ([]*models.IstioCheck, bool) {
+	checks, valid := make([]*models.IstioCheck, 0), true
+
+	for i, cp := range pc.EnvoyFilter.Spec.ConfigPatches {
+		if cp == nil {
+			continue
+		}
+		if _, found := api_networking_v1alpha3.EnvoyFilter_ApplyTo_name[int32(cp.ApplyTo)]; !found || cp.ApplyTo == api_networking_v1alpha3.EnvoyFilter_INVALID {
+			check := models.Build("envoyfilter.applyto.invalid", fmt.Sprintf("spec/configPatches[%d]/applyTo", i))
+			checks = append(checks, &check)
+			valid = false
+			continue
+		}
+		if cp.Match != nil && !validMatchObjectType(cp.ApplyTo, cp.Match) {
+			check := models.Build("envoyfilter.applyto.match", fmt.Sprintf("spec/configPatches[%d]/match", i))
+			checks = append(checks, &check)
+			valid = false
+		}
+	}
+	return checks, valid
+}
+
+// validMatchObjectType checks that the object type of the match, if any, is the one patched by applyTo:
+// listener class objects are matched by listener, route class objects by routeConfiguration and clusters by cluster.
+// Extension configs and the bootstrap can't be matched by object type.
+func validMatchObjectType(applyTo api_networking_v1alpha3.EnvoyFilter_ApplyTo, match *api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch) bool {
+	if match.ObjectTypes == nil {
+		return true
+	}
+	switch applyTo {
+	case api_networking_v1alpha3.EnvoyFilter_LISTENER,
+		api_networking_v1alpha3.EnvoyFilter_FILTER_CHAIN,
+		api_networking_v1alpha3.EnvoyFilter_NETWORK_FILTER,
+		api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER:
+		return match.GetListener() != nil
+	case api_networking_v1alpha3.EnvoyFilter_ROUTE_CONFIGURATION,
+		api_networking_v1alpha3.EnvoyFilter_VIRTUAL_HOST,
+		api_networking_v1alpha3.EnvoyFilter_HTTP_ROUTE:
+		return match.GetRouteConfiguration() != nil
+	case api_networking_v1alpha3.EnvoyFilter_CLUSTER:
+		return match.GetCluster() != nil
+	}
+	return false
+}
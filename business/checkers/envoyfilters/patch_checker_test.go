@@ -0,0 +1,73 @@
+package envoyfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestPatchWithValidMatch(t *testing.T) {
+	assert := assert.New(t)
+
+	listenerPatch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER, api_networking_v1alpha3.EnvoyFilter_SIDECAR_INBOUND)
+	listenerPatch.Match.ObjectTypes = &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch_Listener{Listener: &api_networking_v1alpha3.EnvoyFilter_ListenerMatch{}}
+	clusterPatch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_CLUSTER, api_networking_v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND)
+	clusterPatch.Match.ObjectTypes = &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch_Cluster{Cluster: &api_networking_v1alpha3.EnvoyFilter_ClusterMatch{}}
+	bootstrapPatch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_BOOTSTRAP, api_networking_v1alpha3.EnvoyFilter_ANY)
+
+	ef := data.CreateEnvoyFilter("filter", "bookinfo")
+	data.AddPatchToEnvoyFilter(listenerPatch, ef)
+	data.AddPatchToEnvoyFilter(clusterPatch, ef)
+	data.AddPatchToEnvoyFilter(bootstrapPatch, ef)
+
+	vals, valid := PatchChecker{EnvoyFilter: *ef}.Check()
+
+	assert.Empty(vals)
+	assert.True(valid)
+}
+
+func TestPatchWithoutApplyTo(t *testing.T) {
+	assert := assert.New(t)
+
+	ef := data.AddPatchToEnvoyFilter(
+		data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_INVALID, api_networking_v1alpha3.EnvoyFilter_ANY),
+		data.CreateEnvoyFilter("filter", "bookinfo"))
+
+	vals, valid := PatchChecker{EnvoyFilter: *ef}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.applyto.invalid", vals[0]))
+	assert.Equal("spec/configPatches[0]/applyTo", vals[0].Path)
+}
+
+func TestPatchWithWrongMatchObjectType(t *testing.T) {
+	assert := assert.New(t)
+
+	routePatch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_HTTP_ROUTE, api_networking_v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND)
+	routePatch.Match.ObjectTypes = &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch_Cluster{Cluster: &api_networking_v1alpha3.EnvoyFilter_ClusterMatch{}}
+	filterPatch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER, api_networking_v1alpha3.EnvoyFilter_SIDECAR_INBOUND)
+	filterPatch.Match.ObjectTypes = &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch_Listener{Listener: &api_networking_v1alpha3.EnvoyFilter_ListenerMatch{}}
+	extensionPatch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_EXTENSION_CONFIG, api_networking_v1alpha3.EnvoyFilter_ANY)
+	extensionPatch.Match.ObjectTypes = &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch_Listener{Listener: &api_networking_v1alpha3.EnvoyFilter_ListenerMatch{}}
+
+	ef := data.CreateEnvoyFilter("filter", "bookinfo")
+	data.AddPatchToEnvoyFilter(routePatch, ef)
+	data.AddPatchToEnvoyFilter(filterPatch, ef)
+	data.AddPatchToEnvoyFilter(extensionPatch, ef)
+
+	vals, valid := PatchChecker{EnvoyFilter: *ef}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 2)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.applyto.match", vals[0]))
+	assert.Equal("spec/configPatches[0]/match", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.applyto.match", vals[1]))
+	assert.Equal("spec/configPatches[2]/match", vals[1].Path)
+}
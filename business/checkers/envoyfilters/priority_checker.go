@@ -0,0 +1,72 @@
+package envoyfilters
+
+import (
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/kiali/kiali/models"
+)
+
+// PriorityChecker looks for EnvoyFilters of the same namespace with the same priority patching the same kind of
+// objects of a workload. Istio applies them in creation order, which is rarely what was intended.
+type PriorityChecker struct {
+	SubjectType           string
+	EnvoyFilters          []networking_v1alpha3.EnvoyFilter
+	WorkloadsPerNamespace map[string]models.WorkloadList
+}
+
+func (pc PriorityChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	applied := make([]map[models.IstioValidationKey]models.WorkloadListItem, len(pc.EnvoyFilters))
+	for i, ef := range pc.EnvoyFilters {
+		applied[i] = appliedWorkloads(ef, pc.WorkloadsPerNamespace)
+	}
+
+	for i, ef := range pc.EnvoyFilters {
+		refs := make([]models.IstioValidationKey, 0)
+		for j, other := range pc.EnvoyFilters {
+			if i == j || ef.Namespace != other.Namespace || ef.Spec.Priority != other.Spec.Priority {
+				continue
+			}
+			if sharesApplyTo(ef, other) && sharesWorkload(applied[i], applied[j]) {
+				refs = append(refs, models.BuildKey(pc.SubjectType, other.Name, other.Namespace))
+			}
+		}
+		if len(refs) == 0 {
+			continue
+		}
+
+		check := models.Build("envoyfilter.priority.conflict", "spec/priority")
+		key := models.BuildKey(pc.SubjectType, ef.Name, ef.Namespace)
+		validations.MergeValidations(models.IstioValidations{
+			key: &models.IstioValidation{
+				Name:       ef.Name,
+				ObjectType: pc.SubjectType,
+				Valid:      true,
+				References: refs,
+				Checks:     []*models.IstioCheck{&check},
+			},
+		})
+	}
+	return validations
+}
+
+func sharesApplyTo(ef, other networking_v1alpha3.EnvoyFilter) bool {
+	for _, cp := range ef.Spec.ConfigPatches {
+		for _, ocp := range other.Spec.ConfigPatches {
+			if cp != nil && ocp != nil && cp.ApplyTo == ocp.ApplyTo {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sharesWorkload(workloads, others map[models.IstioValidationKey]models.WorkloadListItem) bool {
+	for key := range workloads {
+		if _, found := others[key]; found {
+			return true
+		}
+	}
+	return false
+}
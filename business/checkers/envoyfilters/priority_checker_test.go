@@ -0,0 +1,76 @@
+package envoyfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func priorityEnvoyFilter(name, namespace string, priority int32, selector map[string]string, applyTo api_networking_v1alpha3.EnvoyFilter_ApplyTo) networking_v1alpha3.EnvoyFilter {
+	ef := data.AddPatchToEnvoyFilter(
+		data.CreateEnvoyFilterPatch(applyTo, api_networking_v1alpha3.EnvoyFilter_ANY),
+		data.CreateEnvoyFilter(name, namespace))
+	if selector != nil {
+		data.AddSelectorToEnvoyFilter(selector, ef)
+	}
+	ef.Spec.Priority = priority
+	return *ef
+}
+
+func priorityWorkloads() map[string]models.WorkloadList {
+	return data.CreateWorkloadsPerNamespace("bookinfo",
+		data.CreateWorkloadListItem("reviews-v1", map[string]string{"app": "reviews", "version": "v1"}),
+		data.CreateWorkloadListItem("details-v1", map[string]string{"app": "details", "version": "v1"}),
+	)
+}
+
+func TestSamePriorityOnSameWorkload(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals := PriorityChecker{
+		SubjectType: "envoyfilter",
+		EnvoyFilters: []networking_v1alpha3.EnvoyFilter{
+			priorityEnvoyFilter("reviews", "bookinfo", 0, map[string]string{"app": "reviews"}, api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER),
+			priorityEnvoyFilter("all", "bookinfo", 0, nil, api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER),
+			priorityEnvoyFilter("details", "bookinfo", 0, map[string]string{"app": "details"}, api_networking_v1alpha3.EnvoyFilter_CLUSTER),
+		},
+		WorkloadsPerNamespace: priorityWorkloads(),
+	}.Check()
+
+	assert.Len(vals, 2)
+	for _, name := range []string{"reviews", "all"} {
+		validation, ok := vals[models.BuildKey("envoyfilter", name, "bookinfo")]
+		assert.True(ok)
+		assert.True(validation.Valid)
+		assert.Len(validation.Checks, 1)
+		assert.Equal(models.WarningSeverity, validation.Checks[0].Severity)
+		assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.priority.conflict", validation.Checks[0]))
+		assert.Len(validation.References, 1)
+	}
+}
+
+func TestDifferentPriorityOrNamespace(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vals := PriorityChecker{
+		SubjectType: "envoyfilter",
+		EnvoyFilters: []networking_v1alpha3.EnvoyFilter{
+			priorityEnvoyFilter("reviews", "bookinfo", 0, map[string]string{"app": "reviews"}, api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER),
+			priorityEnvoyFilter("all", "bookinfo", 10, nil, api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER),
+			priorityEnvoyFilter("mesh", "istio-system", 0, nil, api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER),
+			priorityEnvoyFilter("details", "bookinfo", 0, map[string]string{"app": "details"}, api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER),
+		},
+		WorkloadsPerNamespace: priorityWorkloads(),
+	}.Check()
+
+	assert.Empty(vals)
+}
@@ -0,0 +1,39 @@
+package envoyfilters
+
+import (
+	"fmt"
+	"regexp"
+
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/kiali/kiali/models"
+)
+
+type ProxyVersionChecker struct {
+	EnvoyFilter networking_v1alpha3.EnvoyFilter
+	// MeshVersion is the Istio version of the running proxies, the version match is not checked when it is unknown
+	MeshVersion string
+}
+
+func (pvc ProxyVersionChecker) Check() ([]*models.IstioCheck, bool) {
+	checks, valid := make([]*models.IstioCheck, 0), true
+
+	for i, cp := range pvc.EnvoyFilter.Spec.ConfigPatches {
+		if cp == nil || cp.Match == nil || cp.Match.Proxy == nil || cp.Match.Proxy.ProxyVersion == "" {
+			continue
+		}
+		path := fmt.Sprintf("spec/configPatches[%d]/match/proxy/proxyVersion", i)
+		re, err := regexp.Compile(cp.Match.Proxy.ProxyVersion)
+		if err != nil {
+			check := models.Build("envoyfilter.proxyversion.invalid", path)
+			checks = append(checks, &check)
+			valid = false
+			continue
+		}
+		if pvc.MeshVersion != "" && pvc.MeshVersion != "Unknown" && !re.MatchString(pvc.MeshVersion) {
+			check := models.Build("envoyfilter.proxyversion.nomatch", path)
+			checks = append(checks, &check)
+		}
+	}
+	return checks, valid
+}
@@ -0,0 +1,70 @@
+package envoyfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func envoyFilterWithProxyVersion(proxyVersion string) *networking_v1alpha3.EnvoyFilter {
+	patch := data.CreateEnvoyFilterPatch(api_networking_v1alpha3.EnvoyFilter_HTTP_FILTER, api_networking_v1alpha3.EnvoyFilter_ANY)
+	patch.Match.Proxy = &api_networking_v1alpha3.EnvoyFilter_ProxyMatch{ProxyVersion: proxyVersion}
+	return data.AddPatchToEnvoyFilter(patch, data.CreateEnvoyFilter("filter", "bookinfo"))
+}
+
+func TestProxyVersionMatchingMesh(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ProxyVersionChecker{
+		EnvoyFilter: *envoyFilterWithProxyVersion(`^1\.11.*`),
+		MeshVersion: "1.11.4",
+	}.Check()
+
+	assert.Empty(vals)
+	assert.True(valid)
+}
+
+func TestProxyVersionNotMatchingMesh(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ProxyVersionChecker{
+		EnvoyFilter: *envoyFilterWithProxyVersion(`^1\.10.*`),
+		MeshVersion: "1.11.4",
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.proxyversion.nomatch", vals[0]))
+	assert.Equal("spec/configPatches[0]/match/proxy/proxyVersion", vals[0].Path)
+}
+
+func TestProxyVersionUnknownMesh(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ProxyVersionChecker{
+		EnvoyFilter: *envoyFilterWithProxyVersion(`^1\.10.*`),
+	}.Check()
+
+	assert.Empty(vals)
+	assert.True(valid)
+}
+
+func TestProxyVersionInvalidRegexp(t *testing.T) {
+	assert := assert.New(t)
+
+	vals, valid := ProxyVersionChecker{
+		EnvoyFilter: *envoyFilterWithProxyVersion(`^1\.(10.*`),
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("envoyfilter.proxyversion.invalid", vals[0]))
+}
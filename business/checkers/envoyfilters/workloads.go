@@ -0,0 +1,46 @@
+package envoyfilters
+
+import (
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+// appliedWorkloads returns the workloads patched by an EnvoyFilter: the workloads of its namespace, or of every
+// namespace when it is in the root namespace, matching its workload selector if any.
+func appliedWorkloads(ef networking_v1alpha3.EnvoyFilter, workloadsPerNamespace map[string]models.WorkloadList) map[models.IstioValidationKey]models.WorkloadListItem {
+	selector := labels.Everything()
+	if ef.Spec.WorkloadSelector != nil {
+		selector = labels.SelectorFromSet(ef.Spec.WorkloadSelector.Labels)
+	}
+	rootNamespace := config.IsRootNamespace(ef.Namespace)
+
+	workloads := map[models.IstioValidationKey]models.WorkloadListItem{}
+	for _, wls := range workloadsPerNamespace {
+		if !rootNamespace && wls.Namespace.Name != ef.Namespace {
+			continue
+		}
+		for _, w := range wls.Workloads {
+			if selector.Matches(labels.Set(w.Labels)) {
+				workloads[models.BuildKey(w.Type, w.Name, wls.Namespace.Name)] = w
+			}
+		}
+	}
+	return workloads
+}
+
+// isGatewayWorkload checks if a workload is selected by any Gateway
+func isGatewayWorkload(w models.WorkloadListItem, gateways []networking_v1beta1.Gateway) bool {
+	for _, gw := range gateways {
+		if len(gw.Spec.Selector) == 0 {
+			continue
+		}
+		if labels.SelectorFromSet(gw.Spec.Selector).Matches(labels.Set(w.Labels)) {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,9 @@ func (g GatewayChecker) Check() models.IstioValidations {
 	validations := gateways.MultiMatchChecker{
 		Gateways: g.Gateways,
 	}.Check()
+	validations.MergeValidations(gateways.TLSModeChecker{
+		Gateways: g.Gateways,
+	}.Check())
 
 	for _, gw := range g.Gateways {
 		validations.MergeValidations(g.runSingleChecks(gw))
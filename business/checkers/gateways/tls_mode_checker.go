@@ -0,0 +1,126 @@
+package gateways
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/models"
+)
+
+// TLSModeChecker detects Gateways binding the same host and port of the same workloads with different TLS modes.
+// Istio merges the servers of the Gateways in creation order, so the server of the oldest Gateway wins and the
+// conflicting servers of the other Gateways are ignored.
+type TLSModeChecker struct {
+	Gateways []networking_v1beta1.Gateway
+}
+
+type tlsHost struct {
+	Host
+	TLSMode string
+}
+
+// Check validates that the Gateways binding the same host+port combination use the same TLS mode
+func (t TLSModeChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	gateways := make([]networking_v1beta1.Gateway, len(t.Gateways))
+	copy(gateways, t.Gateways)
+	sort.SliceStable(gateways, func(i, j int) bool {
+		a, b := gateways[i], gateways[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Namespace < b.Namespace
+	})
+
+	// selector -> hosts of the older Gateways
+	bound := map[string][]tlsHost{}
+	for _, gw := range gateways {
+		selectorString := ""
+		if len(gw.Spec.Selector) > 0 {
+			selectorString = labels.Set(gw.Spec.Selector).String()
+		}
+		for i, server := range gw.Spec.Servers {
+			if server == nil {
+				continue
+			}
+			tlsMode := serverTLSMode(server)
+			for hi, host := range parsePortAndHostnames(server) {
+				host.ServerIndex = i
+				host.HostIndex = hi
+				host.GatewayRuleName = gw.Name
+				host.Namespace = gw.Namespace
+				host.TargetNamespace = targetNamespaceAll
+				if namespaceAndHost := strings.Split(host.Hostname, "/"); len(namespaceAndHost) > 1 {
+					host.Hostname = namespaceAndHost[1]
+					host.TargetNamespace = namespaceAndHost[0]
+					if host.TargetNamespace == targetNamespaceCurrent {
+						host.TargetNamespace = gw.Namespace
+					}
+				}
+				current := tlsHost{Host: host, TLSMode: tlsMode}
+
+				for _, previous := range bound[selectorString] {
+					if previous.Namespace == current.Namespace && previous.GatewayRuleName == current.GatewayRuleName {
+						continue
+					}
+					if previous.TLSMode != current.TLSMode && hostsOverlap(previous.Host, current.Host) {
+						validations.MergeValidations(tlsModeValidation(current.Host, "gateways.tlsmode.shadowed", false, previous.Host))
+						validations.MergeValidations(tlsModeValidation(previous.Host, "gateways.tlsmode.precedence", true, current.Host))
+					}
+				}
+				bound[selectorString] = append(bound[selectorString], current)
+			}
+		}
+	}
+
+	return validations
+}
+
+// serverTLSMode returns the TLS mode of the server, or an empty mode for plain text servers.
+// HTTP servers with TLS settings only redirect to HTTPS, so they are considered plain text.
+func serverTLSMode(server *api_networking_v1beta1.Server) string {
+	if server.Tls == nil || (server.Port != nil && strings.EqualFold(server.Port.Protocol, "http")) {
+		return ""
+	}
+	return server.Tls.Mode.String()
+}
+
+// hostsOverlap returns true when both hosts are bound to the same port and their hostnames match
+func hostsOverlap(a, b Host) bool {
+	if a.Port != b.Port {
+		return false
+	}
+	if a.TargetNamespace != targetNamespaceAll && b.TargetNamespace != targetNamespaceAll && a.TargetNamespace != b.TargetNamespace {
+		return false
+	}
+	if a.Hostname == wildCardMatch || b.Hostname == wildCardMatch {
+		return true
+	}
+	// DNS is case-insensitive
+	aHostname, bHostname := strings.ToLower(a.Hostname), strings.ToLower(b.Hostname)
+	return regexpFromHostname(aHostname).MatchString(bHostname) || regexpFromHostname(bHostname).MatchString(aHostname)
+}
+
+func tlsModeValidation(host Host, code string, valid bool, reference Host) models.IstioValidations {
+	key := models.IstioValidationKey{Name: host.GatewayRuleName, Namespace: host.Namespace, ObjectType: GatewayCheckerType}
+	check := models.Build(code, "spec/servers["+strconv.Itoa(host.ServerIndex)+"]/hosts["+strconv.Itoa(host.HostIndex)+"]")
+	return models.IstioValidations{key: &models.IstioValidation{
+		Name:       host.GatewayRuleName,
+		ObjectType: GatewayCheckerType,
+		Valid:      valid,
+		Checks:     []*models.IstioCheck{&check},
+		References: []models.IstioValidationKey{
+			{Name: reference.GatewayRuleName, Namespace: reference.Namespace, ObjectType: GatewayCheckerType},
+		},
+	}}
+}
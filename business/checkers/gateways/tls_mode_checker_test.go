@@ -0,0 +1,79 @@
+package gateways
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestTLSModeConflict(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	selector := map[string]string{"istio": "ingressgateway"}
+	newer := buildTLSGateway("reviews", "bookinfo", selector, "reviews.example.com", api_networking_v1beta1.ServerTLSSettings_PASSTHROUGH, 1)
+	older := buildTLSGateway("reviews", "team-a", selector, "*.example.com", api_networking_v1beta1.ServerTLSSettings_SIMPLE, 2)
+
+	vals := TLSModeChecker{Gateways: []networking_v1beta1.Gateway{*newer, *older}}.Check()
+	require.Len(vals, 2)
+
+	shadowed := vals[models.BuildKey("gateway", "reviews", "bookinfo")]
+	require.NotNil(shadowed)
+	assert.False(shadowed.Valid)
+	require.Len(shadowed.Checks, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.tlsmode.shadowed", shadowed.Checks[0]))
+	assert.Equal(models.ErrorSeverity, shadowed.Checks[0].Severity)
+	assert.Equal("spec/servers[0]/hosts[0]", shadowed.Checks[0].Path)
+	assert.Equal([]models.IstioValidationKey{models.BuildKey("gateway", "reviews", "team-a")}, shadowed.References)
+
+	owner := vals[models.BuildKey("gateway", "reviews", "team-a")]
+	require.NotNil(owner)
+	assert.True(owner.Valid)
+	assert.NoError(validations.ConfirmIstioCheckMessage("gateways.tlsmode.precedence", owner.Checks[0]))
+	assert.Equal([]models.IstioValidationKey{models.BuildKey("gateway", "reviews", "bookinfo")}, owner.References)
+}
+
+func TestTLSModeNoConflict(t *testing.T) {
+	config.Set(config.NewConfig())
+	selector := map[string]string{"istio": "ingressgateway"}
+
+	// Same TLS mode
+	vals := TLSModeChecker{Gateways: []networking_v1beta1.Gateway{
+		*buildTLSGateway("reviews", "bookinfo", selector, "reviews.example.com", api_networking_v1beta1.ServerTLSSettings_SIMPLE, 1),
+		*buildTLSGateway("reviews", "team-a", selector, "reviews.example.com", api_networking_v1beta1.ServerTLSSettings_SIMPLE, 2),
+	}}.Check()
+	assert.Empty(t, vals)
+
+	// Different workloads
+	vals = TLSModeChecker{Gateways: []networking_v1beta1.Gateway{
+		*buildTLSGateway("reviews", "bookinfo", selector, "reviews.example.com", api_networking_v1beta1.ServerTLSSettings_PASSTHROUGH, 1),
+		*buildTLSGateway("reviews", "team-a", map[string]string{"istio": "team-a-gateway"}, "reviews.example.com", api_networking_v1beta1.ServerTLSSettings_SIMPLE, 2),
+	}}.Check()
+	assert.Empty(t, vals)
+
+	// Different hosts
+	vals = TLSModeChecker{Gateways: []networking_v1beta1.Gateway{
+		*buildTLSGateway("reviews", "bookinfo", selector, "reviews.example.com", api_networking_v1beta1.ServerTLSSettings_PASSTHROUGH, 1),
+		*buildTLSGateway("ratings", "team-a", selector, "ratings.example.com", api_networking_v1beta1.ServerTLSSettings_SIMPLE, 2),
+	}}.Check()
+	assert.Empty(t, vals)
+}
+
+func buildTLSGateway(name, namespace string, selector map[string]string, host string, mode api_networking_v1beta1.ServerTLSSettings_TLSmode, createdHoursAgo int) *networking_v1beta1.Gateway {
+	server := data.CreateServer([]string{host}, 443, "https", "HTTPS")
+	server.Tls = &api_networking_v1beta1.ServerTLSSettings{Mode: mode}
+	gw := data.AddServerToGateway(server, data.CreateEmptyGateway(name, namespace, selector))
+	gw.CreationTimestamp = meta_v1.NewTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Duration(createdHoursAgo) * time.Hour))
+	return gw
+}
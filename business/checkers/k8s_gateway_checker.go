@@ -0,0 +1,29 @@
+package checkers
+
+import (
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/business/checkers/k8sgateways"
+	"github.com/kiali/kiali/models"
+)
+
+const K8sGatewayCheckerType = "k8sgateway"
+
+type K8sGatewayChecker struct {
+	K8sGateways []k8s_networking_v1alpha2.Gateway
+}
+
+// Check runs checks for the all namespaces actions as well as for the single namespace validations
+func (g K8sGatewayChecker) Check() models.IstioValidations {
+	// Multinamespace checkers
+	validations := k8sgateways.MultiMatchChecker{
+		K8sGateways: g.K8sGateways,
+	}.Check()
+
+	// Make sure every K8s gateway gets a validation entry, even the ones without checks
+	for _, gw := range g.K8sGateways {
+		validations.MergeValidations(EmptyValidValidations(gw.Name, gw.Namespace, K8sGatewayCheckerType))
+	}
+
+	return validations
+}
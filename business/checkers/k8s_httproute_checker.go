@@ -0,0 +1,46 @@
+package checkers
+
+import (
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/business/checkers/k8shttproutes"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const K8sHTTPRouteCheckerType = "k8shttproute"
+
+type K8sHTTPRouteChecker struct {
+	K8sHTTPRoutes    []k8s_networking_v1alpha2.HTTPRoute
+	K8sGateways      []k8s_networking_v1alpha2.Gateway
+	Namespaces       models.Namespaces
+	RegistryServices []*kubernetes.RegistryService
+}
+
+// Check runs checks for all the HTTPRoutes
+func (in K8sHTTPRouteChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, route := range in.K8sHTTPRoutes {
+		validations.MergeValidations(in.runChecks(route))
+	}
+
+	return validations
+}
+
+func (in K8sHTTPRouteChecker) runChecks(route k8s_networking_v1alpha2.HTTPRoute) models.IstioValidations {
+	key, validations := EmptyValidValidation(route.Name, route.Namespace, K8sHTTPRouteCheckerType)
+
+	enabledCheckers := []Checker{
+		k8shttproutes.NoK8sGatewayChecker{K8sHTTPRoute: route, K8sGateways: in.K8sGateways, Namespaces: in.Namespaces},
+		k8shttproutes.NoHostChecker{K8sHTTPRoute: route, RegistryServices: in.RegistryServices},
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		validations.Checks = append(validations.Checks, checks...)
+		validations.Valid = validations.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: validations}
+}
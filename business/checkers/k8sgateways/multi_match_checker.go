@@ -0,0 +1,114 @@
+package k8sgateways
+
+import (
+	"fmt"
+	"strings"
+
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	K8sGatewayCheckerType = "k8sgateway"
+	wildCardMatch         = "*"
+)
+
+type MultiMatchChecker struct {
+	K8sGateways []k8s_networking_v1alpha2.Gateway
+}
+
+type listenerHost struct {
+	Port          int
+	Hostname      string
+	GatewayName   string
+	Namespace     string
+	ListenerIndex int
+}
+
+// Check validates that no two K8s gateways of the same class share the same listener host+port combination
+func (m MultiMatchChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+	// Listeners are grouped by GatewayClass, Gateways from different classes are served by different controllers
+	existingList := map[string][]listenerHost{}
+
+	for _, gw := range m.K8sGateways {
+		className := string(gw.Spec.GatewayClassName)
+		for i, listener := range gw.Spec.Listeners {
+			host := listenerHost{
+				Port:          int(listener.Port),
+				Hostname:      wildCardMatch,
+				GatewayName:   gw.Name,
+				Namespace:     gw.Namespace,
+				ListenerIndex: i,
+			}
+			if listener.Hostname != nil && *listener.Hostname != "" {
+				host.Hostname = strings.ToLower(string(*listener.Hostname))
+			}
+
+			duplicates := findMatches(host, existingList[className])
+			if len(duplicates) > 0 {
+				currentHostValidation := createError(host)
+				existingGateways := make(map[string]bool)
+				for _, dh := range duplicates {
+					// skip duplicate references when one gateway has several duplicate listeners
+					if existingGateways[dh.Namespace+"/"+dh.GatewayName] {
+						continue
+					}
+					existingGateways[dh.Namespace+"/"+dh.GatewayName] = true
+					refValidation := createError(dh)
+					refValidation = refValidation.MergeReferences(currentHostValidation)
+					currentHostValidation = currentHostValidation.MergeReferences(refValidation)
+					validations = validations.MergeValidations(refValidation)
+				}
+				validations = validations.MergeValidations(currentHostValidation)
+			}
+			existingList[className] = append(existingList[className], host)
+		}
+	}
+
+	return validations
+}
+
+func findMatches(host listenerHost, hostGroup []listenerHost) []listenerHost {
+	duplicates := make([]listenerHost, 0)
+	for _, h := range hostGroup {
+		// Listeners of the same Gateway are merged by the controller, only conflicts between Gateways are reported
+		if h.Namespace == host.Namespace && h.GatewayName == host.GatewayName {
+			continue
+		}
+		if h.Port == host.Port && hostnamesMatch(h.Hostname, host.Hostname) {
+			duplicates = append(duplicates, h)
+		}
+	}
+	return duplicates
+}
+
+// hostnamesMatch compares listener hostnames, which can be prefixed with a single "*." wildcard label
+func hostnamesMatch(a, b string) bool {
+	if a == wildCardMatch || b == wildCardMatch || a == b {
+		return true
+	}
+	if strings.HasPrefix(a, "*.") && strings.HasSuffix(b, a[1:]) {
+		return true
+	}
+	if strings.HasPrefix(b, "*.") && strings.HasSuffix(a, b[1:]) {
+		return true
+	}
+	return false
+}
+
+func createError(host listenerHost) models.IstioValidations {
+	key := models.IstioValidationKey{Name: host.GatewayName, Namespace: host.Namespace, ObjectType: K8sGatewayCheckerType}
+	checks := models.Build("k8sgateways.multimatch", fmt.Sprintf("spec/listeners[%d]/hostname", host.ListenerIndex))
+	rrValidation := &models.IstioValidation{
+		Name:       host.GatewayName,
+		ObjectType: K8sGatewayCheckerType,
+		Valid:      true,
+		Checks: []*models.IstioCheck{
+			&checks,
+		},
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
@@ -0,0 +1,100 @@
+package k8sgateways
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestCorrectK8sGateways(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gwObject := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "bookinfo.example.com", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("validk8sgateway", "test"))
+	gwObject2 := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "reviews.example.com", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("validk8sgateway2", "test"))
+
+	vals := MultiMatchChecker{
+		K8sGateways: []k8s_networking_v1alpha2.Gateway{*gwObject, *gwObject2},
+	}.Check()
+
+	assert.Empty(vals)
+}
+
+func TestSameHostPortConfigInDifferentK8sGateways(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gwObject := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "bookinfo.example.com", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("validk8sgateway", "test"))
+	gwObject2 := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "*.example.com", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("duplicatek8sgateway", "other"))
+
+	vals := MultiMatchChecker{
+		K8sGateways: []k8s_networking_v1alpha2.Gateway{*gwObject, *gwObject2},
+	}.Check()
+
+	assert.NotEmpty(vals)
+	assert.Equal(2, len(vals))
+	validation, ok := vals[models.IstioValidationKey{ObjectType: "k8sgateway", Namespace: "other", Name: "duplicatek8sgateway"}]
+	assert.True(ok)
+	assert.True(validation.Valid)
+	assert.Equal(1, len(validation.Checks))
+	assert.Equal("spec/listeners[0]/hostname", validation.Checks[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("k8sgateways.multimatch", validation.Checks[0]))
+	assert.Equal(1, len(validation.References))
+	assert.Equal("validk8sgateway", validation.References[0].Name)
+
+	secValidation, ok := vals[models.IstioValidationKey{ObjectType: "k8sgateway", Namespace: "test", Name: "validk8sgateway"}]
+	assert.True(ok)
+	assert.Equal(1, len(secValidation.References))
+	assert.Equal("duplicatek8sgateway", secValidation.References[0].Name)
+}
+
+func TestSameHostDifferentPortK8sGateways(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gwObject := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("validk8sgateway", "test"))
+	gwObject2 := data.AddListenerToK8sGateway(data.CreateK8sListener("https", "bookinfo.example.com", 443, "HTTPS"),
+		data.CreateEmptyK8sGateway("validk8sgateway2", "test"))
+
+	vals := MultiMatchChecker{
+		K8sGateways: []k8s_networking_v1alpha2.Gateway{*gwObject, *gwObject2},
+	}.Check()
+
+	assert.Empty(vals)
+}
+
+func TestSameHostPortDifferentGatewayClass(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gwObject := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "bookinfo.example.com", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("validk8sgateway", "test"))
+	gwObject2 := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "bookinfo.example.com", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("validk8sgateway2", "test"))
+	gwObject2.Spec.GatewayClassName = "other"
+
+	vals := MultiMatchChecker{
+		K8sGateways: []k8s_networking_v1alpha2.Gateway{*gwObject, *gwObject2},
+	}.Check()
+
+	assert.Empty(vals)
+}
@@ -0,0 +1,67 @@
+package k8shttproutes
+
+import (
+	"fmt"
+
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type NoHostChecker struct {
+	K8sHTTPRoute     k8s_networking_v1alpha2.HTTPRoute
+	RegistryServices []*kubernetes.RegistryService
+}
+
+// Check validates that the HTTPRoute backendRefs point to existing Services and ports
+func (n NoHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	for i, rule := range n.K8sHTTPRoute.Spec.Rules {
+		for j, backendRef := range rule.BackendRefs {
+			ref := backendRef.BackendObjectReference
+			// Only Services in the core group can be validated against the registry
+			if (ref.Kind != nil && *ref.Kind != "Service") || (ref.Group != nil && *ref.Group != "") {
+				continue
+			}
+			namespace := n.K8sHTTPRoute.Namespace
+			if ref.Namespace != nil && *ref.Namespace != "" {
+				namespace = string(*ref.Namespace)
+			}
+			service := n.findService(string(ref.Name), namespace)
+			if service == nil {
+				validation := models.Build("k8shttproutes.nohost.namenotfound", fmt.Sprintf("spec/rules[%d]/backendRefs[%d]/name", i, j))
+				validations = append(validations, &validation)
+				valid = false
+				continue
+			}
+			if ref.Port != nil && !hasPort(service, int(*ref.Port)) {
+				validation := models.Build("k8shttproutes.nohost.portnotfound", fmt.Sprintf("spec/rules[%d]/backendRefs[%d]/port", i, j))
+				validations = append(validations, &validation)
+				valid = false
+			}
+		}
+	}
+
+	return validations, valid
+}
+
+func (n NoHostChecker) findService(name, namespace string) *kubernetes.RegistryService {
+	for _, rs := range n.RegistryServices {
+		if rs.Attributes.Name == name && rs.Attributes.Namespace == namespace {
+			return rs
+		}
+	}
+	return nil
+}
+
+func hasPort(service *kubernetes.RegistryService, port int) bool {
+	for _, p := range service.Ports {
+		if p.Port == port {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package k8shttproutes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func fakeRegistryServices() []*kubernetes.RegistryService {
+	registryServices := data.CreateFakeRegistryServices("reviews.bookinfo.svc.cluster.local", "bookinfo", "*")
+	registryServices[0].Ports = append(registryServices[0].Ports, struct {
+		Name     string `json:"name,omitempty"`
+		Port     int    `json:"port"`
+		Protocol string `json:"protocol,omitempty"`
+	}{Name: "http", Port: 9080, Protocol: "HTTP"})
+	return registryServices
+}
+
+func TestValidBackendRef(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := NoHostChecker{
+		K8sHTTPRoute:     *data.AddBackendRefToHTTPRoute("reviews", "", 9080, data.CreateHTTPRoute("route", "bookinfo", "gateway", []string{})),
+		RegistryServices: fakeRegistryServices(),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMissingBackendRefService(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := NoHostChecker{
+		K8sHTTPRoute: *data.AddBackendRefToHTTPRoute("reviews", "other", 9080,
+			data.AddBackendRefToHTTPRoute("ratings", "", 9080, data.CreateHTTPRoute("route", "bookinfo", "gateway", []string{}))),
+		RegistryServices: fakeRegistryServices(),
+	}.Check()
+
+	assert.False(valid)
+	assert.Equal(2, len(vals))
+	assert.NoError(validations.ConfirmIstioCheckMessage("k8shttproutes.nohost.namenotfound", vals[0]))
+	assert.Equal("spec/rules[0]/backendRefs[0]/name", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("k8shttproutes.nohost.namenotfound", vals[1]))
+	assert.Equal("spec/rules[1]/backendRefs[0]/name", vals[1].Path)
+}
+
+func TestMissingBackendRefPort(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := NoHostChecker{
+		K8sHTTPRoute:     *data.AddBackendRefToHTTPRoute("reviews", "bookinfo", 8080, data.CreateHTTPRoute("route", "bookinfo", "gateway", []string{})),
+		RegistryServices: fakeRegistryServices(),
+	}.Check()
+
+	assert.False(valid)
+	assert.Equal(1, len(vals))
+	assert.NoError(validations.ConfirmIstioCheckMessage("k8shttproutes.nohost.portnotfound", vals[0]))
+	assert.Equal("spec/rules[0]/backendRefs[0]/port", vals[0].Path)
+}
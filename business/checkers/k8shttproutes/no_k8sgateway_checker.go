@@ -0,0 +1,120 @@
+package k8shttproutes
+
+import (
+	"fmt"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type NoK8sGatewayChecker struct {
+	K8sHTTPRoute k8s_networking_v1alpha2.HTTPRoute
+	K8sGateways  []k8s_networking_v1alpha2.Gateway
+	Namespaces   models.Namespaces
+}
+
+// Check validates that the HTTPRoute parentRefs point to existing K8s Gateways which allow the route to attach
+func (s NoK8sGatewayChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	for i, parentRef := range s.K8sHTTPRoute.Spec.ParentRefs {
+		if !isGatewayParentRef(parentRef) {
+			continue
+		}
+		namespace := s.K8sHTTPRoute.Namespace
+		if parentRef.Namespace != nil && *parentRef.Namespace != "" {
+			namespace = string(*parentRef.Namespace)
+		}
+		gw := s.findGateway(string(parentRef.Name), namespace)
+		if gw == nil {
+			validation := models.Build("k8shttproutes.nok8sgateway", fmt.Sprintf("spec/parentRefs[%d]/name", i))
+			validations = append(validations, &validation)
+			valid = false
+			continue
+		}
+		if !s.isAllowed(gw, parentRef.SectionName) {
+			validation := models.Build("k8shttproutes.nok8sgateway.notallowed", fmt.Sprintf("spec/parentRefs[%d]", i))
+			validations = append(validations, &validation)
+			valid = false
+		}
+	}
+
+	return validations, valid
+}
+
+func (s NoK8sGatewayChecker) findGateway(name, namespace string) *k8s_networking_v1alpha2.Gateway {
+	for i := range s.K8sGateways {
+		if s.K8sGateways[i].Name == name && s.K8sGateways[i].Namespace == namespace {
+			return &s.K8sGateways[i]
+		}
+	}
+	return nil
+}
+
+// isAllowed checks if any listener of the Gateway (or the one referenced by sectionName) accepts the route namespace and kind
+func (s NoK8sGatewayChecker) isAllowed(gw *k8s_networking_v1alpha2.Gateway, sectionName *k8s_networking_v1alpha2.SectionName) bool {
+	for _, listener := range gw.Spec.Listeners {
+		if sectionName != nil && *sectionName != "" && listener.Name != *sectionName {
+			continue
+		}
+		if s.isNamespaceAllowed(gw.Namespace, listener.AllowedRoutes) && isKindAllowed(listener.AllowedRoutes) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s NoK8sGatewayChecker) isNamespaceAllowed(gwNamespace string, allowedRoutes *k8s_networking_v1alpha2.AllowedRoutes) bool {
+	routeNamespace := s.K8sHTTPRoute.Namespace
+	// Routes are only allowed from the Gateway namespace by default
+	if allowedRoutes == nil || allowedRoutes.Namespaces == nil || allowedRoutes.Namespaces.From == nil {
+		return routeNamespace == gwNamespace
+	}
+	switch *allowedRoutes.Namespaces.From {
+	case k8s_networking_v1alpha2.NamespacesFromAll:
+		return true
+	case k8s_networking_v1alpha2.NamespacesFromSelector:
+		if allowedRoutes.Namespaces.Selector == nil {
+			return false
+		}
+		selector, err := meta_v1.LabelSelectorAsSelector(allowedRoutes.Namespaces.Selector)
+		if err != nil {
+			return false
+		}
+		for _, ns := range s.Namespaces {
+			if ns.Name == routeNamespace {
+				return selector.Matches(labels.Set(ns.Labels))
+			}
+		}
+		return false
+	default:
+		return routeNamespace == gwNamespace
+	}
+}
+
+func isKindAllowed(allowedRoutes *k8s_networking_v1alpha2.AllowedRoutes) bool {
+	if allowedRoutes == nil || len(allowedRoutes.Kinds) == 0 {
+		return true
+	}
+	for _, kind := range allowedRoutes.Kinds {
+		if kind.Kind == kubernetes.K8sActualHTTPRouteType && (kind.Group == nil || string(*kind.Group) == kubernetes.K8sNetworkingGroupVersionV1Alpha2.Group) {
+			return true
+		}
+	}
+	return false
+}
+
+func isGatewayParentRef(parentRef k8s_networking_v1alpha2.ParentRef) bool {
+	if parentRef.Kind != nil && string(*parentRef.Kind) != kubernetes.K8sActualGatewayType {
+		return false
+	}
+	if parentRef.Group != nil && string(*parentRef.Group) != kubernetes.K8sNetworkingGroupVersionV1Alpha2.Group {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package k8shttproutes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestValidK8sGatewayParentRef(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("gateway", "bookinfo"))
+
+	vals, valid := NoK8sGatewayChecker{
+		K8sHTTPRoute: *data.CreateHTTPRoute("route", "bookinfo", "gateway", []string{"bookinfo.example.com"}),
+		K8sGateways:  []k8s_networking_v1alpha2.Gateway{*gw},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestMissingK8sGatewayParentRef(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	gw := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("gateway", "bookinfo"))
+
+	vals, valid := NoK8sGatewayChecker{
+		K8sHTTPRoute: *data.AddParentRefToHTTPRoute("gateway", "istio-system",
+			data.CreateHTTPRoute("route", "bookinfo", "gateway", []string{"bookinfo.example.com"})),
+		K8sGateways: []k8s_networking_v1alpha2.Gateway{*gw},
+	}.Check()
+
+	assert.False(valid)
+	assert.Equal(1, len(vals))
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("k8shttproutes.nok8sgateway", vals[0]))
+	assert.Equal("spec/parentRefs[1]/name", vals[0].Path)
+}
+
+func TestK8sGatewayNotAllowingRouteNamespace(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	// By default only routes from the Gateway namespace are allowed
+	gw := data.AddListenerToK8sGateway(data.CreateK8sListener("http", "", 80, "HTTP"),
+		data.CreateEmptyK8sGateway("gateway", "istio-system"))
+
+	vals, valid := NoK8sGatewayChecker{
+		K8sHTTPRoute: *data.AddParentRefToHTTPRoute("gateway", "istio-system",
+			data.CreateHTTPRoute("route", "bookinfo", "", []string{})),
+		K8sGateways: []k8s_networking_v1alpha2.Gateway{*gw},
+	}.Check()
+
+	assert.False(valid)
+	assert.Equal(1, len(vals))
+	assert.NoError(validations.ConfirmIstioCheckMessage("k8shttproutes.nok8sgateway.notallowed", vals[0]))
+	assert.Equal("spec/parentRefs[0]", vals[0].Path)
+}
+
+func TestK8sGatewayAllowingRouteNamespace(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	route := *data.AddParentRefToHTTPRoute("gateway", "istio-system",
+		data.CreateHTTPRoute("route", "bookinfo", "", []string{}))
+	namespaces := models.Namespaces{
+		{Name: "bookinfo", Labels: map[string]string{"gateway-access": "true"}},
+		{Name: "istio-system"},
+	}
+
+	for _, listener := range []k8s_networking_v1alpha2.Listener{
+		data.AddAllowedRoutesToK8sListener("All", nil, data.CreateK8sListener("http", "", 80, "HTTP")),
+		data.AddAllowedRoutesToK8sListener("Selector", map[string]string{"gateway-access": "true"}, data.CreateK8sListener("http", "", 80, "HTTP")),
+	} {
+		gw := data.AddListenerToK8sGateway(listener, data.CreateEmptyK8sGateway("gateway", "istio-system"))
+		vals, valid := NoK8sGatewayChecker{
+			K8sHTTPRoute: route,
+			K8sGateways:  []k8s_networking_v1alpha2.Gateway{*gw},
+			Namespaces:   namespaces,
+		}.Check()
+
+		assert.True(valid)
+		assert.Empty(vals)
+	}
+
+	gw := data.AddListenerToK8sGateway(
+		data.AddAllowedRoutesToK8sListener("Selector", map[string]string{"gateway-access": "false"}, data.CreateK8sListener("http", "", 80, "HTTP")),
+		data.CreateEmptyK8sGateway("gateway", "istio-system"))
+	vals, valid := NoK8sGatewayChecker{
+		K8sHTTPRoute: route,
+		K8sGateways:  []k8s_networking_v1alpha2.Gateway{*gw},
+		Namespaces:   namespaces,
+	}.Check()
+
+	assert.False(valid)
+	assert.Equal(1, len(vals))
+}
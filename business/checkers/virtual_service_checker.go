@@ -14,6 +14,7 @@ type VirtualServiceChecker struct {
 	Namespaces       models.Namespaces
 	VirtualServices  []networking_v1beta1.VirtualService
 	DestinationRules []networking_v1beta1.DestinationRule
+	Sidecars         []networking_v1beta1.Sidecar
 }
 
 // An Object Checker runs all checkers for an specific object type (i.e.: pod, route rule,...)
@@ -46,6 +47,7 @@ func (in VirtualServiceChecker) runGroupChecks() models.IstioValidations {
 
 	enabledCheckers := []GroupChecker{
 		virtualservices.SingleHostChecker{Namespaces: in.Namespaces, VirtualServices: in.VirtualServices},
+		virtualservices.ShadowedRouteChecker{Namespaces: in.Namespaces, VirtualServices: in.VirtualServices, Sidecars: in.Sidecars},
 	}
 
 	for _, checker := range enabledCheckers {
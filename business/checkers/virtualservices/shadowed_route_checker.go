@@ -0,0 +1,310 @@
+package virtualservices
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const meshGateway = "mesh"
+
+// Visibility of a VirtualService for a client namespace, in precedence order: Istio applies first the VirtualServices
+// exported only to their own namespace, then the ones exported explicitly to the client namespace and, finally, the
+// ones exported to all namespaces. Within each group, the oldest VirtualService comes first.
+const (
+	privateVisibility = iota
+	namespaceVisibility
+	publicVisibility
+	notVisible
+)
+
+// ShadowedRouteChecker detects VirtualServices whose routes for a host are shadowed by a VirtualService of another
+// namespace, considering their exportTo, the egress hosts of the namespace Sidecars and the gateways they are bound to.
+type ShadowedRouteChecker struct {
+	Namespaces      models.Namespaces
+	VirtualServices []networking_v1beta1.VirtualService
+	Sidecars        []networking_v1beta1.Sidecar
+}
+
+// RouteOwnership is the VirtualService that owns the routes of a host for a gateway binding, seen from a client
+// namespace, and the VirtualServices of other namespaces shadowed by it
+type RouteOwnership struct {
+	Host      string
+	Gateway   string
+	Namespace string
+	Owner     RouteOwner
+	Shadowed  []RouteOwner
+}
+
+// RouteOwner is a VirtualService with the index of the host in its spec
+type RouteOwner struct {
+	VirtualService *networking_v1beta1.VirtualService
+	HostIndex      int
+}
+
+type routeCandidate struct {
+	RouteOwner
+	visibility int
+}
+
+func (s ShadowedRouteChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, ownership := range s.Ownerships() {
+		owner := ownership.Owner
+		for _, shadowed := range ownership.Shadowed {
+			validations.MergeValidations(shadowedRouteValidation(shadowed, "virtualservices.routing.shadowed", owner))
+			validations.MergeValidations(shadowedRouteValidation(owner, "virtualservices.routing.precedence", shadowed))
+		}
+	}
+
+	return validations
+}
+
+// Ownerships computes the effective route ownership per host, gateway and client namespace. Only the hosts with
+// VirtualServices shadowed by a VirtualService of another namespace are returned.
+// For sidecars, Istio applies a single VirtualService per host, so any other visible VirtualService is shadowed.
+// For gateways, the VirtualServices are merged, so they are shadowed only when the owner has a catch-all HTTP route.
+func (s ShadowedRouteChecker) Ownerships() []RouteOwnership {
+	// gateway -> host -> VirtualServices
+	bindings := map[string]map[string][]RouteOwner{}
+	nsNames := s.Namespaces.GetNames()
+	for i := range s.VirtualServices {
+		vs := &s.VirtualServices[i]
+		for hi, hostName := range vs.Spec.Hosts {
+			host := kubernetes.GetHost(hostName, vs.Namespace, vs.ClusterName, nsNames).String()
+			for _, gw := range gatewayBindings(vs) {
+				if bindings[gw] == nil {
+					bindings[gw] = map[string][]RouteOwner{}
+				}
+				bindings[gw][host] = append(bindings[gw][host], RouteOwner{VirtualService: vs, HostIndex: hi})
+			}
+		}
+	}
+
+	ownerships := []RouteOwnership{}
+	for _, gw := range sortedKeys(bindings) {
+		clients := s.clientNamespaces()
+		if gw != meshGateway {
+			// Only the gateway namespace consumes the VirtualServices bound to it
+			clients = []string{kubernetes.ParseGatewayAsHost(gw, "", "").Namespace}
+		}
+		for _, host := range sortedRouteOwnerKeys(bindings[gw]) {
+			for _, client := range clients {
+				if ownership, shadowing := s.ownership(host, gw, client, bindings[gw][host]); shadowing {
+					ownerships = append(ownerships, ownership)
+				}
+			}
+		}
+	}
+	return ownerships
+}
+
+func (s ShadowedRouteChecker) ownership(host, gateway, client string, owners []RouteOwner) (RouteOwnership, bool) {
+	ownership := RouteOwnership{Host: host, Gateway: gateway, Namespace: client}
+
+	candidates := make([]routeCandidate, 0, len(owners))
+	for _, owner := range owners {
+		visibility := exportVisibility(owner.VirtualService, client)
+		if visibility == notVisible {
+			continue
+		}
+		if gateway == meshGateway && !s.isImported(client, owner.VirtualService.Namespace, host) {
+			continue
+		}
+		candidates = append(candidates, routeCandidate{RouteOwner: owner, visibility: visibility})
+	}
+	if len(candidates) < 2 {
+		return ownership, false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.visibility != b.visibility {
+			return a.visibility < b.visibility
+		}
+		return isOlder(a.VirtualService, b.VirtualService)
+	})
+
+	ownership.Owner = candidates[0].RouteOwner
+	if gateway != meshGateway && !hasCatchAllRoute(ownership.Owner.VirtualService) {
+		return ownership, false
+	}
+	for _, candidate := range candidates[1:] {
+		// VirtualServices of the same namespace are reported by the SingleHostChecker
+		if candidate.VirtualService.Namespace == ownership.Owner.VirtualService.Namespace {
+			continue
+		}
+		if gateway != meshGateway && len(candidate.VirtualService.Spec.Http) == 0 {
+			continue
+		}
+		ownership.Shadowed = append(ownership.Shadowed, candidate.RouteOwner)
+	}
+	return ownership, len(ownership.Shadowed) > 0
+}
+
+// clientNamespaces returns the namespaces of the mesh and the namespaces of the VirtualServices
+func (s ShadowedRouteChecker) clientNamespaces() []string {
+	clients := map[string]bool{}
+	for _, ns := range s.Namespaces {
+		clients[ns.Name] = true
+	}
+	for _, vs := range s.VirtualServices {
+		clients[vs.Namespace] = true
+	}
+	names := make([]string, 0, len(clients))
+	for ns := range clients {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isImported returns true when the Sidecar scope of the client namespace imports the host from the namespace.
+// The scope is given by the Sidecar without workload selector of the client namespace or, when there isn't any,
+// of the root namespace. Without Sidecars, all the hosts are imported.
+func (s ShadowedRouteChecker) isImported(client, namespace, host string) bool {
+	sidecar := s.namespaceSidecar(client)
+	if sidecar == nil {
+		sidecar = s.namespaceSidecar(config.Get().ExternalServices.Istio.RootNamespace)
+	}
+	if sidecar == nil || len(sidecar.Spec.Egress) == 0 {
+		return true
+	}
+
+	for _, egress := range sidecar.Spec.Egress {
+		if egress == nil {
+			continue
+		}
+		for _, egressHost := range egress.Hosts {
+			hostNs, dnsName := egressHostComponents(egressHost)
+			if hostNs != "*" && hostNs != namespace && !(hostNs == "." && namespace == client) {
+				continue
+			}
+			if dnsName == "*" || kubernetes.HostWithinWildcardHost(host, dnsName) ||
+				kubernetes.ParseHost(dnsName, client, sidecar.ClusterName).String() == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s ShadowedRouteChecker) namespaceSidecar(namespace string) *networking_v1beta1.Sidecar {
+	for i, sc := range s.Sidecars {
+		if sc.Namespace == namespace && (sc.Spec.WorkloadSelector == nil || len(sc.Spec.WorkloadSelector.Labels) == 0) {
+			return &s.Sidecars[i]
+		}
+	}
+	return nil
+}
+
+func exportVisibility(vs *networking_v1beta1.VirtualService, client string) int {
+	// No exportTo means exported to all namespaces
+	if len(vs.Spec.ExportTo) == 0 {
+		return publicVisibility
+	}
+	visibility := notVisible
+	for _, exportTo := range vs.Spec.ExportTo {
+		switch {
+		case exportTo == "." || exportTo == vs.Namespace:
+			if vs.Namespace == client {
+				visibility = privateVisibility
+			}
+		case exportTo == client:
+			if visibility > namespaceVisibility {
+				visibility = namespaceVisibility
+			}
+		case exportTo == "*":
+			if visibility > publicVisibility {
+				visibility = publicVisibility
+			}
+		}
+	}
+	return visibility
+}
+
+// gatewayBindings returns the gateways of the VirtualService as <namespace>/<name>, or mesh for sidecars
+func gatewayBindings(vs *networking_v1beta1.VirtualService) []string {
+	if len(vs.Spec.Gateways) == 0 {
+		return []string{meshGateway}
+	}
+	gateways := make([]string, 0, len(vs.Spec.Gateways))
+	for _, gw := range vs.Spec.Gateways {
+		if gw == meshGateway {
+			gateways = append(gateways, gw)
+			continue
+		}
+		gwHost := kubernetes.ParseGatewayAsHost(gw, vs.Namespace, vs.ClusterName)
+		gateways = append(gateways, gwHost.Namespace+"/"+gwHost.Service)
+	}
+	return gateways
+}
+
+// hasCatchAllRoute returns true when the VirtualService has an HTTP route without match conditions
+func hasCatchAllRoute(vs *networking_v1beta1.VirtualService) bool {
+	for _, route := range vs.Spec.Http {
+		if route != nil && len(route.Match) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isOlder sorts as Istio does: by creation time, name and namespace
+func isOlder(a, b *networking_v1beta1.VirtualService) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return a.Namespace < b.Namespace
+}
+
+func egressHostComponents(host string) (string, string) {
+	parts := strings.SplitN(host, "/", 2)
+	if len(parts) < 2 {
+		return "*", host
+	}
+	return parts[0], parts[1]
+}
+
+func shadowedRouteValidation(owner RouteOwner, code string, reference RouteOwner) models.IstioValidations {
+	vs := owner.VirtualService
+	key := models.IstioValidationKey{Name: vs.Name, Namespace: vs.Namespace, ObjectType: "virtualservice"}
+	check := models.Build(code, fmt.Sprintf("spec/hosts[%d]", owner.HostIndex))
+	return models.IstioValidations{key: &models.IstioValidation{
+		Name:       vs.Name,
+		ObjectType: "virtualservice",
+		Valid:      true,
+		Checks:     []*models.IstioCheck{&check},
+		References: []models.IstioValidationKey{
+			{Name: reference.VirtualService.Name, Namespace: reference.VirtualService.Namespace, ObjectType: "virtualservice"},
+		},
+	}}
+}
+
+func sortedKeys(m map[string]map[string][]RouteOwner) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedRouteOwnerKeys(m map[string][]RouteOwner) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,164 @@
+package virtualservices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+const reviewsHost = "reviews.bookinfo.svc.cluster.local"
+
+func TestPublicVirtualServiceShadowsNewerOne(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	vals := ShadowedRouteChecker{
+		VirtualServices: []networking_v1beta1.VirtualService{
+			*buildOwnedVirtualService("reviews", "bookinfo", "reviews", 1),
+			*buildOwnedVirtualService("reviews-override", "team-a", reviewsHost, 2, "*"),
+		},
+	}.Check()
+
+	require.Len(vals, 2)
+	shadowed := vals[models.BuildKey("virtualservice", "reviews", "bookinfo")]
+	require.NotNil(shadowed)
+	assert.True(shadowed.Valid)
+	require.Len(shadowed.Checks, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.routing.shadowed", shadowed.Checks[0]))
+	assert.Equal(models.WarningSeverity, shadowed.Checks[0].Severity)
+	assert.Equal("spec/hosts[0]", shadowed.Checks[0].Path)
+	assert.Equal([]models.IstioValidationKey{models.BuildKey("virtualservice", "reviews-override", "team-a")}, shadowed.References)
+
+	owner := vals[models.BuildKey("virtualservice", "reviews-override", "team-a")]
+	require.NotNil(owner)
+	require.Len(owner.Checks, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.routing.precedence", owner.Checks[0]))
+	assert.Equal([]models.IstioValidationKey{models.BuildKey("virtualservice", "reviews", "bookinfo")}, owner.References)
+}
+
+func TestPrivateVirtualServiceTakesPrecedence(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	checker := ShadowedRouteChecker{
+		VirtualServices: []networking_v1beta1.VirtualService{
+			*buildOwnedVirtualService("reviews", "bookinfo", "reviews", 1, "."),
+			*buildOwnedVirtualService("reviews-override", "team-a", reviewsHost, 2, "*"),
+		},
+	}
+
+	ownerships := checker.Ownerships()
+	require.Len(ownerships, 1)
+	assert.Equal(reviewsHost, ownerships[0].Host)
+	assert.Equal("mesh", ownerships[0].Gateway)
+	assert.Equal("bookinfo", ownerships[0].Namespace)
+	assert.Equal("reviews", ownerships[0].Owner.VirtualService.Name)
+	require.Len(ownerships[0].Shadowed, 1)
+	assert.Equal("reviews-override", ownerships[0].Shadowed[0].VirtualService.Name)
+
+	vals := checker.Check()
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.routing.shadowed", vals[models.BuildKey("virtualservice", "reviews-override", "team-a")].Checks[0]))
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.routing.precedence", vals[models.BuildKey("virtualservice", "reviews", "bookinfo")].Checks[0]))
+}
+
+func TestNotExportedVirtualServicesDontConflict(t *testing.T) {
+	config.Set(config.NewConfig())
+
+	vals := ShadowedRouteChecker{
+		VirtualServices: []networking_v1beta1.VirtualService{
+			*buildOwnedVirtualService("reviews", "bookinfo", "reviews", 1, "."),
+			*buildOwnedVirtualService("reviews-override", "team-a", reviewsHost, 2, "."),
+		},
+	}.Check()
+
+	assert.Empty(t, vals)
+}
+
+func TestVirtualServicesInSameNamespaceAreSkipped(t *testing.T) {
+	config.Set(config.NewConfig())
+
+	vals := ShadowedRouteChecker{
+		VirtualServices: []networking_v1beta1.VirtualService{
+			*buildOwnedVirtualService("reviews", "bookinfo", "reviews", 1),
+			*buildOwnedVirtualService("reviews-2", "bookinfo", "reviews", 2),
+		},
+	}.Check()
+
+	assert.Empty(t, vals)
+}
+
+func TestSidecarEgressScope(t *testing.T) {
+	config.Set(config.NewConfig())
+
+	vss := []networking_v1beta1.VirtualService{
+		*buildOwnedVirtualService("reviews", "bookinfo", "reviews", 1),
+		*buildOwnedVirtualService("reviews-override", "team-a", reviewsHost, 2),
+	}
+
+	// The default Sidecar only imports the hosts of the own namespace
+	vals := ShadowedRouteChecker{
+		VirtualServices: vss,
+		Sidecars: []networking_v1beta1.Sidecar{
+			*data.AddHostsToSidecar([]string{"./*", "istio-system/*"}, data.CreateSidecar("default", "istio-system")),
+		},
+	}.Check()
+	assert.Empty(t, vals)
+
+	// The Sidecar of bookinfo imports team-a, the Sidecars with selector don't define the namespace scope
+	vals = ShadowedRouteChecker{
+		VirtualServices: vss,
+		Sidecars: []networking_v1beta1.Sidecar{
+			*data.AddHostsToSidecar([]string{"./*", "istio-system/*"}, data.CreateSidecar("default", "istio-system")),
+			*data.AddSelectorToSidecar(map[string]string{"app": "productpage"},
+				data.AddHostsToSidecar([]string{"./*"}, data.CreateSidecar("productpage", "bookinfo"))),
+			*data.AddHostsToSidecar([]string{"./*", "team-a/" + reviewsHost}, data.CreateSidecar("default", "bookinfo")),
+		},
+	}.Check()
+	assert.Len(t, vals, 2)
+	assert.NoError(t, validations.ConfirmIstioCheckMessage("virtualservices.routing.shadowed", vals[models.BuildKey("virtualservice", "reviews", "bookinfo")].Checks[0]))
+}
+
+func TestGatewayCatchAllRouteShadows(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	older := data.AddGatewaysToVirtualService([]string{"istio-system/ingress"}, buildOwnedVirtualService("reviews-ingress", "team-a", "reviews.example.com", 2))
+	newer := data.AddGatewaysToVirtualService([]string{"istio-system/ingress"}, buildOwnedVirtualService("reviews-ingress", "bookinfo", "reviews.example.com", 1))
+	other := data.AddGatewaysToVirtualService([]string{"bookinfo/ingress"}, buildOwnedVirtualService("reviews-bookinfo", "bookinfo", "reviews.example.com", 0))
+
+	vals := ShadowedRouteChecker{
+		VirtualServices: []networking_v1beta1.VirtualService{*older, *newer, *other},
+	}.Check()
+	assert.Len(vals, 2)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.routing.shadowed", vals[models.BuildKey("virtualservice", "reviews-ingress", "bookinfo")].Checks[0]))
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.routing.precedence", vals[models.BuildKey("virtualservice", "reviews-ingress", "team-a")].Checks[0]))
+
+	// Gateway routes are merged: without catch-all route, the routes of the newer VirtualService are reachable
+	older.Spec.Http[0].Match = []*api_networking_v1beta1.HTTPMatchRequest{
+		{Uri: &api_networking_v1beta1.StringMatch{MatchType: &api_networking_v1beta1.StringMatch_Prefix{Prefix: "/api"}}},
+	}
+	vals = ShadowedRouteChecker{
+		VirtualServices: []networking_v1beta1.VirtualService{*older, *newer, *other},
+	}.Check()
+	assert.Empty(vals)
+}
+
+func buildOwnedVirtualService(name, namespace, host string, createdHoursAgo int, exportTo ...string) *networking_v1beta1.VirtualService {
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination(host, "", -1),
+		data.CreateEmptyVirtualService(name, namespace, []string{host}))
+	vs.CreationTimestamp = meta_v1.NewTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Duration(createdHoursAgo) * time.Hour))
+	vs.Spec.ExportTo = exportTo
+	return vs
+}
@@ -0,0 +1,119 @@
+package business
+
+import (
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+)
+
+// customWorkload is a custom controller of one of the types registered in the configuration
+type customWorkload struct {
+	*unstructured.Unstructured
+	Type config.CustomWorkloadType
+}
+
+// customWorkloadTypesOf returns the registered custom workload types controlling the given objects.
+// If name is not empty, only the controllers with that name are considered.
+func customWorkloadTypesOf(name string, objects []meta_v1.Object) []config.CustomWorkloadType {
+	types := []config.CustomWorkloadType{}
+	found := map[string]bool{}
+	for _, o := range objects {
+		ref := meta_v1.GetControllerOf(o)
+		if ref == nil || found[ref.Kind] || (name != "" && ref.Name != name) || !isWorkloadIncluded(ref.Kind) {
+			continue
+		}
+		if wt, ok := kubernetes.GetCustomWorkloadType(ref.Kind); ok {
+			found[ref.Kind] = true
+			types = append(types, wt)
+		}
+	}
+	return types
+}
+
+// fetchCustomWorkloads fetches the custom controllers of the given types.
+// A custom resource not installed in the cluster, or that the user cannot list, is not an error: Kiali falls back to
+// the controllers it owns.
+func fetchCustomWorkloads(layer *Layer, namespace string, types []config.CustomWorkloadType) ([]customWorkload, error) {
+	custom := []customWorkload{}
+	for _, wt := range types {
+		cws, err := layer.k8s.GetCustomWorkloads(namespace, wt)
+		if err != nil {
+			if errors.IsNotFound(err) || errors.IsForbidden(err) {
+				log.Debugf("Custom workload type %s is not available in namespace %s: %s", wt.Kind, namespace, err)
+				continue
+			}
+			log.Errorf("Error fetching %s per namespace %s: %s", wt.Kind, namespace, err)
+			return nil, err
+		}
+		for i := range cws {
+			custom = append(custom, customWorkload{Unstructured: &cws[i], Type: wt})
+		}
+	}
+	return custom, nil
+}
+
+// fetchCustomWorkload fetches a custom controller by name, trying the given types in order.
+// It returns nil when the controller is not found.
+func fetchCustomWorkload(layer *Layer, namespace, name string, types []config.CustomWorkloadType) (*customWorkload, error) {
+	for _, wt := range types {
+		cw, err := layer.k8s.GetCustomWorkload(namespace, name, wt)
+		if err != nil {
+			if errors.IsNotFound(err) || errors.IsForbidden(err) {
+				continue
+			}
+			log.Errorf("Error fetching %s per namespace %s and name %s: %s", wt.Kind, namespace, name, err)
+			return nil, err
+		}
+		return &customWorkload{Unstructured: cw, Type: wt}, nil
+	}
+	return nil, nil
+}
+
+// isControlledByCustomWorkload checks if a controller is itself controlled by one of the custom controllers,
+// i.e. the Deployment of a Knative Revision
+func isControlledByCustomWorkload(controller meta_v1.Object, custom []customWorkload) bool {
+	for _, cw := range custom {
+		if meta_v1.IsControlledBy(controller, cw) {
+			return true
+		}
+	}
+	return false
+}
+
+// customWorkloadPods returns the pods of a custom controller, controlled directly (i.e. OpenKruise CloneSets),
+// through a ReplicaSet (i.e. Argo Rollouts) or through a Deployment and its ReplicaSets (i.e. Knative Revisions)
+func customWorkloadPods(cw customWorkload, dep []apps_v1.Deployment, repset []apps_v1.ReplicaSet, pods []core_v1.Pod) []core_v1.Pod {
+	owners := []meta_v1.Object{cw}
+	for i := range dep {
+		if meta_v1.IsControlledBy(&dep[i], cw) {
+			owners = append(owners, &dep[i])
+		}
+	}
+	for i := range repset {
+		if isControlledByAny(&repset[i], owners) {
+			owners = append(owners, &repset[i])
+		}
+	}
+	cPods := []core_v1.Pod{}
+	for i := range pods {
+		if isControlledByAny(&pods[i], owners) {
+			cPods = append(cPods, pods[i])
+		}
+	}
+	return cPods
+}
+
+func isControlledByAny(obj meta_v1.Object, owners []meta_v1.Object) bool {
+	for _, owner := range owners {
+		if meta_v1.IsControlledBy(obj, owner) {
+			return true
+		}
+	}
+	return false
+}
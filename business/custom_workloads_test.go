@@ -0,0 +1,249 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	osapps_v1 "github.com/openshift/api/apps/v1"
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+// customWorkloadsConfig returns a config registering the Argo Rollout, Knative Revision and OpenKruise CloneSet types
+func customWorkloadsConfig() *config.Config {
+	conf := config.NewConfig()
+	conf.ExternalServices.CustomDashboards.Enabled = false
+	conf.KubernetesConfig.CustomWorkloadTypes = []config.CustomWorkloadType{
+		{
+			Group:                 "argoproj.io",
+			Version:               "v1alpha1",
+			Kind:                  "Rollout",
+			Resource:              "rollouts",
+			TemplatePath:          "spec.template",
+			DesiredReplicasPath:   "spec.replicas",
+			CurrentReplicasPath:   "status.replicas",
+			AvailableReplicasPath: "status.availableReplicas",
+		},
+		{
+			Group:                 "serving.knative.dev",
+			Version:               "v1",
+			Kind:                  "Revision",
+			Resource:              "revisions",
+			DesiredReplicasPath:   "status.desiredReplicas",
+			CurrentReplicasPath:   "status.actualReplicas",
+			AvailableReplicasPath: "status.actualReplicas",
+		},
+		{
+			Group:                 "apps.kruise.io",
+			Version:               "v1alpha1",
+			Kind:                  "CloneSet",
+			Resource:              "clonesets",
+			TemplatePath:          "spec.template",
+			DesiredReplicasPath:   "spec.replicas",
+			CurrentReplicasPath:   "status.replicas",
+			AvailableReplicasPath: "status.availableReplicas",
+		},
+	}
+	return conf
+}
+
+func fakeCustomWorkload(apiVersion, kind, name, uid string, template bool) *unstructured.Unstructured {
+	cw := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "Namespace",
+			"uid":       uid,
+			"labels":    map[string]interface{}{"app": name, "version": "v1"},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(2)},
+		"status": map[string]interface{}{"replicas": int64(2), "availableReplicas": int64(1), "desiredReplicas": int64(2), "actualReplicas": int64(1)},
+	}}
+	if template {
+		_ = unstructured.SetNestedStringMap(cw.Object, map[string]string{"app": name}, "spec", "template", "metadata", "labels")
+	}
+	return cw
+}
+
+func controlledBy(name, uid string, owner *unstructured.Unstructured) v1.ObjectMeta {
+	ref := v1.NewControllerRef(owner, schema.FromAPIVersionAndKind(owner.GetAPIVersion(), owner.GetKind()))
+	return v1.ObjectMeta{Name: name, Namespace: "Namespace", UID: types.UID(uid), OwnerReferences: []v1.OwnerReference{*ref}}
+}
+
+func asOwner(meta v1.ObjectMeta, apiVersion, kind string) *unstructured.Unstructured {
+	owner := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": apiVersion, "kind": kind}}
+	owner.SetName(meta.Name)
+	owner.SetUID(meta.UID)
+	return owner
+}
+
+// fakeCustomWorkloads returns an Argo Rollout controlling a ReplicaSet, an OpenKruise CloneSet controlling its pod and
+// a Knative Revision controlling a Deployment and its ReplicaSet
+func fakeCustomWorkloads() (rollout, cloneSet, revision *unstructured.Unstructured, deps []apps_v1.Deployment, repset []apps_v1.ReplicaSet, pods []core_v1.Pod) {
+	rollout = fakeCustomWorkload("argoproj.io/v1alpha1", "Rollout", "reviews", "rollout-uid", true)
+	cloneSet = fakeCustomWorkload("apps.kruise.io/v1alpha1", "CloneSet", "ratings", "cloneset-uid", true)
+	revision = fakeCustomWorkload("serving.knative.dev/v1", "Revision", "hello-00001", "revision-uid", false)
+
+	rolloutRS := apps_v1.ReplicaSet{ObjectMeta: controlledBy("reviews-5d8c", "reviews-rs-uid", rollout)}
+	revisionDep := apps_v1.Deployment{ObjectMeta: controlledBy("hello-00001-deployment", "hello-dep-uid", revision)}
+	revisionRS := apps_v1.ReplicaSet{ObjectMeta: controlledBy("hello-00001-deployment-7f9c", "hello-rs-uid", asOwner(revisionDep.ObjectMeta, "apps/v1", "Deployment"))}
+
+	deps = []apps_v1.Deployment{revisionDep}
+	repset = []apps_v1.ReplicaSet{rolloutRS, revisionRS}
+	pods = []core_v1.Pod{
+		{ObjectMeta: controlledBy("reviews-5d8c-a", "reviews-pod-uid", asOwner(rolloutRS.ObjectMeta, "apps/v1", "ReplicaSet"))},
+		{ObjectMeta: controlledBy("ratings-b", "ratings-pod-uid", cloneSet)},
+		{ObjectMeta: controlledBy("hello-00001-deployment-7f9c-c", "hello-pod-uid", asOwner(revisionRS.ObjectMeta, "apps/v1", "ReplicaSet"))},
+	}
+	return
+}
+
+func mockCustomWorkloadsNamespace(deps []apps_v1.Deployment, repset []apps_v1.ReplicaSet, pods []core_v1.Pod) *kubetest.K8SClientMock {
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(true)
+	k8s.On("GetProject", mock.AnythingOfType("string")).Return(&osproject_v1.Project{}, nil)
+	k8s.On("GetDeployments", mock.AnythingOfType("string")).Return(deps, nil)
+	k8s.On("GetDeploymentConfigs", mock.AnythingOfType("string")).Return([]osapps_v1.DeploymentConfig{}, nil)
+	k8s.On("GetReplicaSets", mock.AnythingOfType("string")).Return(repset, nil)
+	k8s.On("GetReplicationControllers", mock.AnythingOfType("string")).Return([]core_v1.ReplicationController{}, nil)
+	k8s.On("GetStatefulSets", mock.AnythingOfType("string")).Return([]apps_v1.StatefulSet{}, nil)
+	k8s.On("GetDaemonSets", mock.AnythingOfType("string")).Return([]apps_v1.DaemonSet{}, nil)
+	k8s.On("GetJobs", mock.AnythingOfType("string")).Return([]batch_v1.Job{}, nil)
+	k8s.On("GetCronJobs", mock.AnythingOfType("string")).Return([]batch_v1.CronJob{}, nil)
+	k8s.On("GetPods", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(pods, nil)
+	return k8s
+}
+
+func TestGetWorkloadListFromCustomWorkloads(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(customWorkloadsConfig())
+	excludedWorkloads = nil
+
+	rollout, cloneSet, revision, deps, repset, pods := fakeCustomWorkloads()
+	k8s := mockCustomWorkloadsNamespace(deps, repset, pods)
+	k8s.On("GetCustomWorkloads", "Namespace", "Rollout").Return([]unstructured.Unstructured{*rollout}, nil)
+	k8s.On("GetCustomWorkloads", "Namespace", "CloneSet").Return([]unstructured.Unstructured{*cloneSet}, nil)
+	k8s.On("GetCustomWorkloads", "Namespace", "Revision").Return([]unstructured.Unstructured{*revision}, nil)
+
+	svc := setupWorkloadService(k8s)
+	workloads, err := fetchWorkloads(context.TODO(), svc.businessLayer, "Namespace", "")
+	require.NoError(err)
+
+	// The ReplicaSets and the Deployment of the custom controllers are not workloads
+	require.Len(workloads, 3)
+	assert.Equal("hello-00001", workloads[0].Name)
+	assert.Equal("Revision", workloads[0].Type)
+	assert.Equal(int32(2), workloads[0].DesiredReplicas)
+	assert.Equal(int32(1), workloads[0].AvailableReplicas)
+	assert.True(workloads[0].AppLabel)
+	assert.True(workloads[0].VersionLabel)
+	require.Len(workloads[0].Pods, 1)
+	assert.Equal("hello-00001-deployment-7f9c-c", workloads[0].Pods[0].Name)
+
+	assert.Equal("ratings", workloads[1].Name)
+	assert.Equal("CloneSet", workloads[1].Type)
+	require.Len(workloads[1].Pods, 1)
+	assert.Equal("ratings-b", workloads[1].Pods[0].Name)
+
+	assert.Equal("reviews", workloads[2].Name)
+	assert.Equal("Rollout", workloads[2].Type)
+	assert.Equal(int32(2), workloads[2].DesiredReplicas)
+	assert.Equal(int32(2), workloads[2].CurrentReplicas)
+	assert.Equal(int32(1), workloads[2].AvailableReplicas)
+	// the labels come from the pod template
+	assert.True(workloads[2].AppLabel)
+	assert.False(workloads[2].VersionLabel)
+	require.Len(workloads[2].Pods, 1)
+	assert.Equal("reviews-5d8c-a", workloads[2].Pods[0].Name)
+}
+
+func TestGetWorkloadListCustomResourceNotInstalled(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(customWorkloadsConfig())
+	excludedWorkloads = nil
+
+	_, _, _, _, repset, pods := fakeCustomWorkloads()
+	notfound := errors.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "rollouts"}, "")
+	k8s := mockCustomWorkloadsNamespace([]apps_v1.Deployment{}, repset[:1], pods[:1])
+	k8s.On("GetCustomWorkloads", "Namespace", "Rollout").Return([]unstructured.Unstructured{}, notfound)
+
+	svc := setupWorkloadService(k8s)
+	workloads, err := fetchWorkloads(context.TODO(), svc.businessLayer, "Namespace", "")
+	require.NoError(err)
+
+	// Kiali falls back to the ReplicaSet of the unknown controller
+	require.Len(workloads, 1)
+	assert.Equal("reviews", workloads[0].Name)
+	assert.Equal("Rollout", workloads[0].Type)
+	assert.Len(workloads[0].Pods, 1)
+}
+
+func TestGetWorkloadListCustomResourceForbidden(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(customWorkloadsConfig())
+	excludedWorkloads = nil
+
+	_, _, _, _, repset, pods := fakeCustomWorkloads()
+	forbidden := errors.NewForbidden(schema.GroupResource{Group: "argoproj.io", Resource: "rollouts"}, "", nil)
+	k8s := mockCustomWorkloadsNamespace([]apps_v1.Deployment{}, repset[:1], pods[:1])
+	k8s.On("GetCustomWorkloads", "Namespace", "Rollout").Return([]unstructured.Unstructured{}, forbidden)
+
+	svc := setupWorkloadService(k8s)
+	workloads, err := fetchWorkloads(context.TODO(), svc.businessLayer, "Namespace", "")
+	require.NoError(err)
+
+	// Kiali falls back to the ReplicaSet of the controller it cannot list
+	require.Len(workloads, 1)
+	assert.Equal("reviews", workloads[0].Name)
+	assert.Equal("Rollout", workloads[0].Type)
+	assert.Len(workloads[0].Pods, 1)
+}
+
+func TestGetWorkloadFromCustomWorkload(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(customWorkloadsConfig())
+	excludedWorkloads = nil
+
+	_, _, revision, deps, repset, pods := fakeCustomWorkloads()
+	notfound := errors.NewNotFound(schema.GroupResource{Group: "test-group", Resource: "test-resource"}, "not found")
+	k8s := mockCustomWorkloadsNamespace(deps, repset, pods)
+	k8s.On("GetDeployment", "Namespace", "hello-00001").Return(&apps_v1.Deployment{}, notfound)
+	k8s.On("GetDeploymentConfig", "Namespace", "hello-00001").Return(&osapps_v1.DeploymentConfig{}, notfound)
+	k8s.On("GetStatefulSet", "Namespace", "hello-00001").Return(&apps_v1.StatefulSet{}, notfound)
+	k8s.On("GetDaemonSet", "Namespace", "hello-00001").Return(&apps_v1.DaemonSet{}, notfound)
+	k8s.On("GetCustomWorkload", "Namespace", "hello-00001", "Rollout").Return(&unstructured.Unstructured{}, notfound)
+	k8s.On("GetCustomWorkload", "Namespace", "hello-00001", "Revision").Return(revision, nil)
+
+	svc := setupWorkloadService(k8s)
+
+	// The Revision is found by name, it only controls a Deployment
+	workload, err := fetchWorkload(context.TODO(), svc.businessLayer, WorkloadCriteria{Namespace: "Namespace", WorkloadName: "hello-00001"})
+	require.NoError(err)
+	assert.Equal("Revision", workload.Type)
+	require.Len(workload.Pods, 1)
+	assert.Equal("hello-00001-deployment-7f9c-c", workload.Pods[0].Name)
+
+	workload, err = fetchWorkload(context.TODO(), svc.businessLayer, WorkloadCriteria{Namespace: "Namespace", WorkloadName: "hello-00001", WorkloadType: "Revision"})
+	require.NoError(err)
+	assert.Equal("Revision", workload.Type)
+	assert.Len(workload.Pods, 1)
+	k8s.AssertNotCalled(t, "GetCustomWorkload", "Namespace", "hello-00001", "CloneSet")
+}
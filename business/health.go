@@ -21,7 +21,7 @@ type HealthService struct {
 }
 
 // Annotation Filter for Health
-var HealthAnnotation = []models.AnnotationKey{models.RateHealthAnnotation}
+var HealthAnnotation = models.GetHealthConfigAnnotation()
 
 // GetServiceHealth returns a service health (service request error rate)
 func (in *HealthService) GetServiceHealth(ctx context.Context, namespace, service, rateInterval string, queryTime time.Time, svc *models.Service) (models.ServiceHealth, error) {
@@ -76,6 +76,13 @@ func (in *HealthService) getAppHealth(namespace, app, rateInterval string, query
 
 	// Deployment status
 	health.WorkloadStatuses = ws.CastWorkloadStatuses()
+	if errRate == nil && hasRestartsTolerance(namespace, "app", app, health.Requests.HealthAnnotations) {
+		restarts := make([]workloadRestarts, 0, len(ws))
+		for i, w := range ws {
+			restarts = append(restarts, workloadRestarts{status: health.WorkloadStatuses[i], pods: w.Pods})
+		}
+		errRate = in.fillPodRestarts(namespace, restarts, rateInterval, queryTime)
+	}
 
 	return health, errRate
 }
@@ -92,20 +99,27 @@ func (in *HealthService) GetWorkloadHealth(ctx context.Context, namespace, workl
 	)
 	defer end()
 
+	health := models.WorkloadHealth{
+		WorkloadStatus: w.CastWorkloadStatus(),
+		Requests:       models.NewEmptyRequestHealth(),
+	}
+	annotations := models.GetHealthAnnotation(w.HealthAnnotations, HealthAnnotation)
+	if hasRestartsTolerance(namespace, "workload", workload, annotations) {
+		restarts := []workloadRestarts{{status: health.WorkloadStatus, pods: w.Pods}}
+		if err := in.fillPodRestarts(namespace, restarts, rateInterval, queryTime); err != nil {
+			return health, err
+		}
+	}
+
 	// Perf: do not bother fetching request rate if workload has no sidecar
 	if !w.IstioSidecar {
-		return models.WorkloadHealth{
-			WorkloadStatus: w.CastWorkloadStatus(),
-			Requests:       models.NewEmptyRequestHealth(),
-		}, nil
+		return health, nil
 	}
 
 	// Add Telemetry info
-	rate, err := in.getWorkloadRequestsHealth(namespace, workload, rateInterval, queryTime, w)
-	return models.WorkloadHealth{
-		WorkloadStatus: w.CastWorkloadStatus(),
-		Requests:       rate,
-	}, err
+	var err error
+	health.Requests, err = in.getWorkloadRequestsHealth(namespace, workload, rateInterval, queryTime, w)
+	return health, err
 }
 
 // GetNamespaceAppHealth returns a health for all apps in given Namespace (thus, it fetches data from K8S and Prometheus)
@@ -134,22 +148,30 @@ func (in *HealthService) getNamespaceAppHealth(namespace string, appEntities nam
 	sidecarPresent := false
 
 	// Prepare all data
+	restarts := []workloadRestarts{}
 	for app, entities := range appEntities {
 		if app != "" {
 			h := models.EmptyAppHealth()
 			allHealth[app] = &h
 			if entities != nil {
 				h.WorkloadStatuses = entities.Workloads.CastWorkloadStatuses()
-				for _, w := range entities.Workloads {
+				withRestarts := hasRestartsTolerance(namespace, "app", app, h.Requests.HealthAnnotations)
+				for i, w := range entities.Workloads {
 					if w.IstioSidecar {
 						sidecarPresent = true
-						break
+					}
+					if withRestarts {
+						restarts = append(restarts, workloadRestarts{status: h.WorkloadStatuses[i], pods: w.Pods})
 					}
 				}
 			}
 		}
 	}
 
+	if err := in.fillPodRestarts(namespace, restarts, rateInterval, queryTime); err != nil {
+		return allHealth, err
+	}
+
 	if sidecarPresent {
 		// Fetch services requests rates
 		rates, err := in.prom.GetAllRequestRates(namespace, rateInterval, queryTime)
@@ -158,6 +180,14 @@ func (in *HealthService) getNamespaceAppHealth(namespace string, appEntities nam
 		}
 		// Fill with collected request rates
 		fillAppRequestRates(allHealth, rates)
+
+		requests := make(map[string]*models.RequestHealth, len(allHealth))
+		for app, h := range allHealth {
+			requests[app] = &h.Requests
+		}
+		if err := in.fillHealthIndicators(namespace, "app", "", requests, rateInterval, queryTime); err != nil {
+			return allHealth, err
+		}
 	}
 
 	return allHealth, nil
@@ -192,10 +222,10 @@ func (in *HealthService) GetNamespaceServiceHealth(ctx context.Context, namespac
 	if err != nil {
 		return nil, err
 	}
-	return in.getNamespaceServiceHealth(namespace, services, rateInterval, queryTime), nil
+	return in.getNamespaceServiceHealth(namespace, services, rateInterval, queryTime)
 }
 
-func (in *HealthService) getNamespaceServiceHealth(namespace string, services *models.ServiceList, rateInterval string, queryTime time.Time) models.NamespaceServiceHealth {
+func (in *HealthService) getNamespaceServiceHealth(namespace string, services *models.ServiceList, rateInterval string, queryTime time.Time) (models.NamespaceServiceHealth, error) {
 	allHealth := make(models.NamespaceServiceHealth)
 
 	// Prepare all data (note that it's important to provide data for all services, even those which may not have any health, for overview cards)
@@ -220,7 +250,15 @@ func (in *HealthService) getNamespaceServiceHealth(namespace string, services *m
 	for _, health := range allHealth {
 		health.Requests.CombineReporters()
 	}
-	return allHealth
+
+	requests := make(map[string]*models.RequestHealth, len(allHealth))
+	for service, h := range allHealth {
+		requests[service] = &h.Requests
+	}
+	if err := in.fillHealthIndicators(namespace, "service", "", requests, rateInterval, queryTime); err != nil {
+		return allHealth, err
+	}
+	return allHealth, nil
 }
 
 // GetNamespaceWorkloadHealth returns a health for all workloads in given Namespace (thus, it fetches data from K8S and Prometheus)
@@ -247,6 +285,7 @@ func (in *HealthService) getNamespaceWorkloadHealth(namespace string, ws models.
 	hasSidecar := false
 
 	allHealth := make(models.NamespaceWorkloadHealth)
+	restarts := []workloadRestarts{}
 	for _, w := range ws {
 		allHealth[w.Name] = models.EmptyWorkloadHealth()
 		allHealth[w.Name].Requests.HealthAnnotations = models.GetHealthAnnotation(w.HealthAnnotations, HealthAnnotation)
@@ -254,6 +293,13 @@ func (in *HealthService) getNamespaceWorkloadHealth(namespace string, ws models.
 		if w.IstioSidecar {
 			hasSidecar = true
 		}
+		if hasRestartsTolerance(namespace, "workload", w.Name, allHealth[w.Name].Requests.HealthAnnotations) {
+			restarts = append(restarts, workloadRestarts{status: allHealth[w.Name].WorkloadStatus, pods: w.Pods})
+		}
+	}
+
+	if err := in.fillPodRestarts(namespace, restarts, rateInterval, queryTime); err != nil {
+		return allHealth, err
 	}
 
 	if hasSidecar {
@@ -264,6 +310,14 @@ func (in *HealthService) getNamespaceWorkloadHealth(namespace string, ws models.
 		}
 		// Fill with collected request rates
 		fillWorkloadRequestRates(allHealth, rates)
+
+		requests := make(map[string]*models.RequestHealth, len(allHealth))
+		for workload, h := range allHealth {
+			requests[workload] = &h.Requests
+		}
+		if err := in.fillHealthIndicators(namespace, "workload", "", requests, rateInterval, queryTime); err != nil {
+			return allHealth, err
+		}
 	}
 
 	return allHealth, nil
@@ -324,7 +378,8 @@ func (in *HealthService) getServiceRequestsHealth(namespace, service, rateInterv
 	}
 	rqHealth.HealthAnnotations = svc.HealthAnnotations
 	rqHealth.CombineReporters()
-	return rqHealth, nil
+	err = in.fillHealthIndicators(namespace, "service", service, map[string]*models.RequestHealth{service: &rqHealth}, rateInterval, queryTime)
+	return rqHealth, err
 }
 
 func (in *HealthService) getAppRequestsHealth(namespace, app, rateInterval string, queryTime time.Time) (models.RequestHealth, error) {
@@ -341,7 +396,8 @@ func (in *HealthService) getAppRequestsHealth(namespace, app, rateInterval strin
 		rqHealth.AggregateOutbound(sample)
 	}
 	rqHealth.CombineReporters()
-	return rqHealth, nil
+	err = in.fillHealthIndicators(namespace, "app", app, map[string]*models.RequestHealth{app: &rqHealth}, rateInterval, queryTime)
+	return rqHealth, err
 }
 
 func (in *HealthService) getWorkloadRequestsHealth(namespace, workload, rateInterval string, queryTime time.Time, w *models.Workload) (models.RequestHealth, error) {
@@ -360,5 +416,6 @@ func (in *HealthService) getWorkloadRequestsHealth(namespace, workload, rateInte
 		rqHealth.HealthAnnotations = models.GetHealthAnnotation(w.HealthAnnotations, HealthAnnotation)
 	}
 	rqHealth.CombineReporters()
+	err = in.fillHealthIndicators(namespace, "workload", workload, map[string]*models.RequestHealth{workload: &rqHealth}, rateInterval, queryTime)
 	return rqHealth, err
 }
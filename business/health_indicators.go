@@ -0,0 +1,150 @@
+package business
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/models"
+)
+
+// healthIndicatorLabels are the telemetry labels identifying the entities of a kind, for a direction
+type healthIndicatorLabels struct {
+	reporter  string
+	namespace string
+	name      string
+}
+
+// healthIndicatorLabelsByKind lists, per health_config kind, the directions of the latency and TCP connection
+// indicators and their labels. Services only have inbound traffic.
+var healthIndicatorLabelsByKind = map[string]map[string]healthIndicatorLabels{
+	"app": {
+		"inbound":  {reporter: "destination", namespace: "destination_workload_namespace", name: "destination_canonical_service"},
+		"outbound": {reporter: "source", namespace: "source_workload_namespace", name: "source_canonical_service"},
+	},
+	"service": {
+		"inbound": {reporter: "destination", namespace: "destination_service_namespace", name: "destination_service_name"},
+	},
+	"workload": {
+		"inbound":  {reporter: "destination", namespace: "destination_workload_namespace", name: "destination_workload"},
+		"outbound": {reporter: "source", namespace: "source_workload_namespace", name: "source_workload"},
+	},
+}
+
+// percentileQuantiles maps the latency percentiles supported in the health_config to Prometheus quantiles
+var percentileQuantiles = map[string]string{
+	"p95": "0.95",
+	"p99": "0.99",
+}
+
+// fillHealthIndicators fetches the request latencies and the TCP connection rates of the entities of a kind, and stores
+// them in their request health. Only the indicators required by the health_config rates (or the health annotations) of
+// the entities are fetched, so nothing is queried with the default health_config.
+// When name is not empty, only the indicators of that entity are fetched, otherwise all the entities of the namespace.
+func (in *HealthService) fillHealthIndicators(namespace, kind, name string, healths map[string]*models.RequestHealth, rateInterval string, queryTime time.Time) error {
+	quantiles := map[string]bool{}
+	connections := false
+	for n, h := range healths {
+		rate := models.GetRateHealthConfigWithAnnotations(namespace, kind, n, h.HealthAnnotations)
+		if rate == nil {
+			continue
+		}
+		for _, lt := range rate.Latency {
+			if q, ok := percentileQuantiles[lt.Percentile]; ok {
+				quantiles[q] = true
+			}
+		}
+		connections = connections || len(rate.Connection) > 0
+	}
+	if len(quantiles) == 0 && !connections {
+		return nil
+	}
+
+	for direction, lbl := range healthIndicatorLabelsByKind[kind] {
+		labels := fmt.Sprintf(`reporter="%s",%s="%s"`, lbl.reporter, lbl.namespace, namespace)
+		if name != "" {
+			labels += fmt.Sprintf(`,%s="%s"`, lbl.name, name)
+		}
+		labels = "{" + labels + "}"
+		lblName := model.LabelName(lbl.name)
+
+		if len(quantiles) > 0 {
+			qs := make([]string, 0, len(quantiles))
+			for q := range quantiles {
+				qs = append(qs, q)
+			}
+			histo, err := in.prom.FetchHistogramValues("istio_request_duration_milliseconds", labels, lbl.name+",request_protocol", rateInterval, false, qs, queryTime)
+			if err != nil {
+				return errors.NewServiceUnavailable(err.Error())
+			}
+			for quantile, vector := range histo {
+				percentile := "p" + strings.TrimPrefix(quantile, "0.")
+				for _, sample := range vector {
+					if math.IsNaN(float64(sample.Value)) {
+						continue
+					}
+					if h, ok := healths[string(sample.Metric[lblName])]; ok {
+						h.AddLatency(direction, string(sample.Metric["request_protocol"]), percentile, float64(sample.Value))
+					}
+				}
+			}
+		}
+
+		if connections {
+			opened, failed, err := in.prom.GetTCPConnectionRates(labels, lbl.name, rateInterval, queryTime)
+			if err != nil {
+				return errors.NewServiceUnavailable(err.Error())
+			}
+			for state, vector := range map[string]model.Vector{"opened": opened, "failed": failed} {
+				for _, sample := range vector {
+					if h, ok := healths[string(sample.Metric[lblName])]; ok {
+						h.AddTCPConnections(direction, state, float64(sample.Value))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// workloadRestarts links the status of a workload to its pods, to fill the pod restarts of the status
+type workloadRestarts struct {
+	status *models.WorkloadStatus
+	pods   models.Pods
+}
+
+// hasRestartsTolerance checks if the health_config rate (or the health annotations) of an entity has a restarts tolerance
+func hasRestartsTolerance(namespace, kind, name string, annotations map[string]string) bool {
+	rate := models.GetRateHealthConfigWithAnnotations(namespace, kind, name, annotations)
+	return rate != nil && rate.Restarts != nil
+}
+
+// fillPodRestarts sets the pod restarts of the workload statuses to the highest increase of the container restarts
+// of their pods over the rate interval. Nothing is queried when there are no workloads, callers only pass the workloads
+// of the entities with a restarts tolerance.
+func (in *HealthService) fillPodRestarts(namespace string, workloads []workloadRestarts, rateInterval string, queryTime time.Time) error {
+	if len(workloads) == 0 {
+		return nil
+	}
+	vector, err := in.prom.GetPodRestarts(namespace, rateInterval, queryTime)
+	if err != nil {
+		return errors.NewServiceUnavailable(err.Error())
+	}
+	restarts := make(map[string]int32, len(vector))
+	for _, sample := range vector {
+		// increase() extrapolates the counter over the interval
+		restarts[string(sample.Metric["pod"])] = int32(math.Round(float64(sample.Value)))
+	}
+	for _, w := range workloads {
+		for _, pod := range w.pods {
+			if r := restarts[pod.Name]; r > w.status.PodRestarts {
+				w.status.PodRestarts = r
+			}
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package business
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -218,6 +219,121 @@ func TestGetWorkloadHealthWithoutIstio(t *testing.T) {
 	assert.Equal(emptyResult, health.Requests.Outbound)
 }
 
+func TestGetWorkloadHealthWithLatencyAndConnections(t *testing.T) {
+	assert := assert.New(t)
+
+	// Setup mocks
+	k8s := new(kubetest.K8SClientMock)
+	prom := new(prometheustest.PromClientMock)
+	conf := config.NewConfig()
+	conf.HealthConfig.Rate = []config.Rate{
+		{
+			Kind:       "workload",
+			Latency:    []config.LatencyTolerance{{Percentile: "p99", Degraded: 200, Failure: 500, Direction: "inbound"}},
+			Connection: []config.ConnectionTolerance{{Degraded: 5, Failure: 10, Direction: "inbound"}},
+		},
+	}
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	queryTime := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	prom.MockWorkloadRequestRates("ns", "reviews-v1", model.Vector{}, model.Vector{})
+	inLabels := `{reporter="destination",destination_workload_namespace="ns",destination_workload="reviews-v1"}`
+	outLabels := `{reporter="source",source_workload_namespace="ns",source_workload="reviews-v1"}`
+	prom.On("FetchHistogramValues", "istio_request_duration_milliseconds", inLabels, "destination_workload,request_protocol", "1m", false, []string{"0.99"}, queryTime).
+		Return(map[string]model.Vector{"0.99": {
+			&model.Sample{Metric: model.Metric{"destination_workload": "reviews-v1", "request_protocol": "http"}, Value: 320},
+		}}, nil)
+	prom.On("FetchHistogramValues", "istio_request_duration_milliseconds", outLabels, "source_workload,request_protocol", "1m", false, []string{"0.99"}, queryTime).
+		Return(map[string]model.Vector{"0.99": {}}, nil)
+	prom.On("GetTCPConnectionRates", inLabels, "destination_workload", "1m", queryTime).
+		Return(model.Vector{&model.Sample{Metric: model.Metric{"destination_workload": "reviews-v1"}, Value: 10}},
+			model.Vector{&model.Sample{Metric: model.Metric{"destination_workload": "reviews-v1"}, Value: 0.2}}, nil)
+	prom.On("GetTCPConnectionRates", outLabels, "source_workload", "1m", queryTime).Return(model.Vector{}, model.Vector{}, nil)
+
+	k8s.On("IsOpenShift").Return(true)
+	hs := HealthService{k8s: k8s, prom: prom, businessLayer: NewWithBackends(k8s, prom, nil)}
+
+	mockWorkload := models.Workload{}
+	mockWorkload.Name = "reviews-v1"
+	mockWorkload.IstioSidecar = true
+
+	health, err := hs.GetWorkloadHealth(context.TODO(), "ns", "reviews-v1", "1m", queryTime, &mockWorkload)
+	assert.NoError(err)
+
+	prom.AssertNumberOfCalls(t, "FetchHistogramValues", 2)
+	prom.AssertNumberOfCalls(t, "GetTCPConnectionRates", 2)
+	assert.Equal(map[string]map[string]map[string]float64{"inbound": {"http": {"p99": 320}}}, health.Requests.Latency)
+	assert.Equal(map[string]map[string]float64{"inbound": {"opened": 10, "failed": 0.2}}, health.Requests.TCPConnections)
+	assert.Equal(models.HealthStatusDegraded, health.Requests.Status("ns", "workload", "reviews-v1"))
+}
+
+func TestGetWorkloadHealthWithPodRestarts(t *testing.T) {
+	assert := assert.New(t)
+
+	// Setup mocks
+	k8s := new(kubetest.K8SClientMock)
+	prom := new(prometheustest.PromClientMock)
+	conf := config.NewConfig()
+	conf.HealthConfig.Rate = []config.Rate{{Kind: "workload", Restarts: &config.RestartTolerance{Degraded: 1, Failure: 5}}}
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	queryTime := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	// increase() extrapolates the restarts counter, the cumulative restarts of the pods are not used
+	prom.On("GetPodRestarts", "ns", "1m", queryTime).Return(model.Vector{
+		&model.Sample{Metric: model.Metric{"pod": "reviews-v1-a"}, Value: 0.9},
+		&model.Sample{Metric: model.Metric{"pod": "reviews-v1-b"}, Value: 2.1},
+		&model.Sample{Metric: model.Metric{"pod": "ratings-v1-a"}, Value: 8},
+	}, nil)
+
+	k8s.On("IsOpenShift").Return(true)
+	hs := HealthService{k8s: k8s, prom: prom, businessLayer: NewWithBackends(k8s, prom, nil)}
+
+	mockWorkload := models.Workload{}
+	mockWorkload.Name = "reviews-v1"
+	mockWorkload.DesiredReplicas = 2
+	mockWorkload.AvailableReplicas = 2
+	mockWorkload.Pods = models.Pods{{Name: "reviews-v1-a", Restarts: 40}, {Name: "reviews-v1-b", Restarts: 3}}
+
+	health, err := hs.GetWorkloadHealth(context.TODO(), "ns", "reviews-v1", "1m", queryTime, &mockWorkload)
+	assert.NoError(err)
+
+	prom.AssertNumberOfCalls(t, "GetPodRestarts", 1)
+	assert.Equal(int32(2), health.WorkloadStatus.PodRestarts)
+	assert.Equal(models.HealthStatusDegraded, health.Status("ns", "reviews-v1"))
+}
+
+func TestGetWorkloadHealthSkipsUnconfiguredIndicators(t *testing.T) {
+	assert := assert.New(t)
+
+	// Setup mocks
+	k8s := new(kubetest.K8SClientMock)
+	prom := new(prometheustest.PromClientMock)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	queryTime := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	prom.MockWorkloadRequestRates("ns", "reviews-v1", otherRatesIn, otherRatesOut)
+
+	k8s.On("IsOpenShift").Return(true)
+	hs := HealthService{k8s: k8s, prom: prom, businessLayer: NewWithBackends(k8s, prom, nil)}
+
+	mockWorkload := models.Workload{}
+	mockWorkload.Name = "reviews-v1"
+	mockWorkload.IstioSidecar = true
+
+	health, err := hs.GetWorkloadHealth(context.TODO(), "ns", "reviews-v1", "1m", queryTime, &mockWorkload)
+	assert.NoError(err)
+
+	prom.AssertNotCalled(t, "FetchHistogramValues")
+	prom.AssertNotCalled(t, "GetTCPConnectionRates")
+	prom.AssertNotCalled(t, "GetPodRestarts")
+	assert.Nil(health.Requests.Latency)
+	assert.Nil(health.Requests.TCPConnections)
+}
+
 func TestGetNamespaceAppHealthWithoutIstio(t *testing.T) {
 	// Setup mocks
 	k8s := new(kubetest.K8SClientMock)
@@ -240,6 +356,30 @@ func TestGetNamespaceAppHealthWithoutIstio(t *testing.T) {
 	prom.AssertNumberOfCalls(t, "GetAllRequestRates", 0)
 }
 
+func TestGetNamespaceServiceHealthIndicatorsError(t *testing.T) {
+	assert := assert.New(t)
+
+	// Setup mocks
+	k8s := new(kubetest.K8SClientMock)
+	prom := new(prometheustest.PromClientMock)
+	conf := config.NewConfig()
+	conf.HealthConfig.Rate = []config.Rate{{Kind: "service", Connection: []config.ConnectionTolerance{{Degraded: 5, Failure: 10}}}}
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	k8s.On("IsOpenShift").Return(true)
+	prom.On("GetNamespaceServicesRequestRates", "tutorial", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(serviceRates, nil)
+	prom.On("GetTCPConnectionRates", mock.AnythingOfType("string"), "destination_service_name", "1m", mock.AnythingOfType("time.Time")).
+		Return(model.Vector{}, model.Vector{}, errors.New("prometheus is down"))
+
+	hs := HealthService{k8s: k8s, prom: prom, businessLayer: NewWithBackends(k8s, prom, nil)}
+	services := &models.ServiceList{Services: []models.ServiceOverview{{Name: "reviews"}, {Name: "httpbin"}}}
+
+	// As for apps and workloads, the health indicators errors are not ignored
+	_, err := hs.getNamespaceServiceHealth("tutorial", services, "1m", time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC))
+	assert.Error(err)
+}
+
 func TestGetNamespaceServiceHealthWithNA(t *testing.T) {
 	assert := assert.New(t)
 
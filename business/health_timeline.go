@@ -0,0 +1,132 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// GetAppHealthTimeline returns the request health of an app evaluated at every step of the query range
+func (in *HealthService) GetAppHealthTimeline(ctx context.Context, namespace, app string, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+	var end observability.EndFunc
+	_, end = observability.StartSpan(ctx, "GetAppHealthTimeline",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("app", app),
+	)
+	defer end()
+
+	inLabels := fmt.Sprintf(`destination_workload_namespace="%s",destination_app="%s"`, namespace, app)
+	outLabels := fmt.Sprintf(`source_workload_namespace="%s",source_app="%s"`, namespace, app)
+	return in.getHealthTimeline(namespace, "app", app, map[string]string{}, inLabels, outLabels, q)
+}
+
+// GetServiceHealthTimeline returns the request health of a service evaluated at every step of the query range
+func (in *HealthService) GetServiceHealthTimeline(ctx context.Context, namespace, service string, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetServiceHealthTimeline",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("service", service),
+	)
+	defer end()
+
+	svc, err := in.businessLayer.Svc.GetService(ctx, namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	telemetryNamespace := namespace
+	if svc.Type == "External" {
+		// ServiceEntry from Istio Registry
+		// Telemetry doesn't collect a namespace
+		telemetryNamespace = "unknown"
+	}
+	inLabels := fmt.Sprintf(`destination_service_name="%s",destination_service_namespace="%s"`, service, telemetryNamespace)
+	return in.getHealthTimeline(namespace, "service", service, svc.HealthAnnotations, inLabels, "", q)
+}
+
+// GetWorkloadHealthTimeline returns the request health of a workload evaluated at every step of the query range
+func (in *HealthService) GetWorkloadHealthTimeline(ctx context.Context, namespace, workload string, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetWorkloadHealthTimeline",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("workload", workload),
+	)
+	defer end()
+
+	w, err := fetchWorkload(ctx, in.businessLayer, WorkloadCriteria{Namespace: namespace, WorkloadName: workload})
+	if err != nil {
+		return nil, err
+	}
+	inLabels := fmt.Sprintf(`destination_workload_namespace="%s",destination_workload="%s"`, namespace, workload)
+	outLabels := fmt.Sprintf(`source_workload_namespace="%s",source_workload="%s"`, namespace, workload)
+	return in.getHealthTimeline(namespace, "workload", workload, models.GetHealthAnnotation(w.HealthAnnotations, HealthAnnotation), inLabels, outLabels, q)
+}
+
+// getHealthTimeline fetches the inbound (and outbound, when outLabels is not empty) request rates of an entity over the
+// query range and evaluates them at every step, with the same aggregation and tolerances as the current health.
+// Steps without requests are NA.
+func (in *HealthService) getHealthTimeline(namespace, kind, name string, annotations map[string]string, inLabels, outLabels string, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+	inbound, err := in.prom.GetRequestRatesRange(inLabels, q.RateInterval, q.Range)
+	if err != nil {
+		return nil, err
+	}
+	outbound := model.Matrix{}
+	if outLabels != "" {
+		if outbound, err = in.prom.GetRequestRatesRange(outLabels, q.RateInterval, q.Range); err != nil {
+			return nil, err
+		}
+	}
+
+	// Prometheus range queries return the samples at Start + k * Step
+	steps := map[int64]*models.RequestHealth{}
+	timestamps := []int64{}
+	for t := q.Start; !t.After(q.End); t = t.Add(q.Step) {
+		rqHealth := models.NewEmptyRequestHealth()
+		rqHealth.HealthAnnotations = annotations
+		steps[t.Unix()] = &rqHealth
+		timestamps = append(timestamps, t.Unix())
+	}
+	for _, series := range inbound {
+		for _, sample := range series.Values {
+			if rqHealth, ok := steps[sample.Timestamp.Unix()]; ok {
+				rqHealth.AggregateInbound(&model.Sample{Metric: series.Metric, Value: sample.Value, Timestamp: sample.Timestamp})
+			}
+		}
+	}
+	for _, series := range outbound {
+		for _, sample := range series.Values {
+			if rqHealth, ok := steps[sample.Timestamp.Unix()]; ok {
+				rqHealth.AggregateOutbound(&model.Sample{Metric: series.Metric, Value: sample.Value, Timestamp: sample.Timestamp})
+			}
+		}
+	}
+
+	timeline := &models.HealthTimeline{
+		Namespace:    namespace,
+		Kind:         kind,
+		Name:         name,
+		RateInterval: q.RateInterval,
+		Step:         int64(q.Step / time.Second),
+		Points:       make([]models.HealthTimelinePoint, 0, len(timestamps)),
+	}
+	for _, ts := range timestamps {
+		rqHealth := steps[ts]
+		rqHealth.CombineReporters()
+		timeline.Points = append(timeline.Points, models.HealthTimelinePoint{
+			Timestamp:   ts,
+			Status:      rqHealth.Status(namespace, kind, name),
+			ErrorRatios: rqHealth.ErrorRatios(namespace, kind, name),
+			Inbound:     rqHealth.Inbound,
+			Outbound:    rqHealth.Outbound,
+		})
+	}
+	return timeline, nil
+}
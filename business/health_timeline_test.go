@@ -0,0 +1,76 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestGetAppHealthTimeline(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	start := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	q := prometheus.RangeQuery{Range: prom_v1.Range{Start: start, End: start.Add(3 * time.Minute), Step: time.Minute}, RateInterval: "1m"}
+	ts := func(step int) model.Time { return model.TimeFromUnix(start.Add(time.Duration(step) * time.Minute).Unix()) }
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("GetRequestRatesRange", `destination_workload_namespace="ns",destination_app="reviews"`, "1m", q.Range).Return(model.Matrix{
+		{
+			Metric: model.Metric{"reporter": "destination", "request_protocol": "http", "response_code": "200"},
+			Values: []model.SamplePair{{Timestamp: ts(0), Value: 10}, {Timestamp: ts(1), Value: 9}, {Timestamp: ts(2), Value: 5}},
+		},
+		{
+			Metric: model.Metric{"reporter": "destination", "request_protocol": "http", "response_code": "503"},
+			Values: []model.SamplePair{{Timestamp: ts(1), Value: 1}, {Timestamp: ts(2), Value: 5}},
+		},
+	}, nil)
+	prom.On("GetRequestRatesRange", `source_workload_namespace="ns",source_app="reviews"`, "1m", q.Range).Return(model.Matrix{
+		{
+			// Outbound requests are only aggregated from the source reporter
+			Metric: model.Metric{"reporter": "destination", "request_protocol": "http", "response_code": "500"},
+			Values: []model.SamplePair{{Timestamp: ts(0), Value: 10}},
+		},
+	}, nil)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	layer := NewWithBackends(k8s, prom, nil)
+
+	timeline, err := layer.Health.GetAppHealthTimeline(context.TODO(), "ns", "reviews", q)
+	require.NoError(err)
+
+	assert.Equal("app", timeline.Kind)
+	assert.Equal(int64(60), timeline.Step)
+	require.Len(timeline.Points, 4)
+
+	assert.Equal(start.Unix(), timeline.Points[0].Timestamp)
+	assert.Equal(models.HealthStatusHealthy, timeline.Points[0].Status)
+	assert.Empty(timeline.Points[0].ErrorRatios)
+	assert.Empty(timeline.Points[0].Outbound)
+
+	assert.Equal(models.HealthStatusFailure, timeline.Points[1].Status)
+	assert.Equal(map[string]map[string]map[string]float64{"inbound": {"http": {"5XX": 10}}}, timeline.Points[1].ErrorRatios)
+
+	assert.Equal(models.HealthStatusFailure, timeline.Points[2].Status)
+	assert.Equal(map[string]map[string]float64{"http": {"200": 5, "503": 5}}, timeline.Points[2].Inbound)
+	assert.Equal(map[string]map[string]map[string]float64{"inbound": {"http": {"5XX": 50}}}, timeline.Points[2].ErrorRatios)
+
+	// No requests in the last step
+	assert.Equal(start.Add(3*time.Minute).Unix(), timeline.Points[3].Timestamp)
+	assert.Equal(models.HealthStatusNA, timeline.Points[3].Status)
+}
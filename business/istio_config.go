@@ -8,12 +8,16 @@ import (
 	"strings"
 	"sync"
 
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
 	api_errors "k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	api_types "k8s.io/apimachinery/pkg/types"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
@@ -47,6 +51,12 @@ type IstioConfigCriteria struct {
 	IncludeWorkloadGroups         bool
 	IncludeRequestAuthentications bool
 	IncludeEnvoyFilters           bool
+	IncludeK8sGateways            bool
+	IncludeK8sHTTPRoutes          bool
+	IncludeK8sTCPRoutes           bool
+	IncludeK8sGRPCRoutes          bool
+	IncludeTelemetries            bool
+	IncludeWasmPlugins            bool
 	LabelSelector                 string
 	WorkloadSelector              string
 }
@@ -77,6 +87,18 @@ func (icc IstioConfigCriteria) Include(resource string) bool {
 		return icc.IncludeRequestAuthentications
 	case kubernetes.EnvoyFilters:
 		return icc.IncludeEnvoyFilters
+	case kubernetes.K8sGateways:
+		return icc.IncludeK8sGateways && !isWorkloadSelector
+	case kubernetes.K8sHTTPRoutes:
+		return icc.IncludeK8sHTTPRoutes && !isWorkloadSelector
+	case kubernetes.K8sTCPRoutes:
+		return icc.IncludeK8sTCPRoutes && !isWorkloadSelector
+	case kubernetes.K8sGRPCRoutes:
+		return icc.IncludeK8sGRPCRoutes && !isWorkloadSelector
+	case kubernetes.Telemetries:
+		return icc.IncludeTelemetries
+	case kubernetes.WasmPlugins:
+		return icc.IncludeWasmPlugins
 	}
 	return false
 }
@@ -89,6 +111,14 @@ var newNetworkingConfigTypes = []string{
 	kubernetes.ServiceEntries,
 }
 
+// gateway.networking.k8s.io
+var newK8sNetworkingConfigTypes = []string{
+	kubernetes.K8sGateways,
+	kubernetes.K8sHTTPRoutes,
+	kubernetes.K8sTCPRoutes,
+	kubernetes.K8sGRPCRoutes,
+}
+
 // security.istio.io
 var newSecurityConfigTypes = []string{
 	kubernetes.AuthorizationPolicies,
@@ -120,9 +150,17 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, criteria I
 		WorkloadEntries:  []networking_v1beta1.WorkloadEntry{},
 		WorkloadGroups:   []networking_v1beta1.WorkloadGroup{},
 
+		K8sGateways:   []k8s_networking_v1alpha2.Gateway{},
+		K8sHTTPRoutes: []k8s_networking_v1alpha2.HTTPRoute{},
+		K8sTCPRoutes:  []k8s_networking_v1alpha2.TCPRoute{},
+		K8sGRPCRoutes: []unstructured.Unstructured{},
+
 		AuthorizationPolicies:  []security_v1beta1.AuthorizationPolicy{},
 		PeerAuthentications:    []security_v1beta1.PeerAuthentication{},
 		RequestAuthentications: []security_v1beta1.RequestAuthentication{},
+
+		Telemetries: []telemetry_v1alpha1.Telemetry{},
+		WasmPlugins: []extensions_v1alpha1.WasmPlugin{},
 	}
 
 	// Use the Istio Registry when AllNamespaces is present
@@ -148,9 +186,15 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, criteria I
 		istioConfigList.Sidecars = registryConfiguration.Sidecars
 		istioConfigList.WorkloadEntries = registryConfiguration.WorkloadEntries
 		istioConfigList.WorkloadGroups = registryConfiguration.WorkloadGroups
+		istioConfigList.K8sGateways = registryConfiguration.K8sGateways
+		istioConfigList.K8sHTTPRoutes = registryConfiguration.K8sHTTPRoutes
+		istioConfigList.K8sTCPRoutes = registryConfiguration.K8sTCPRoutes
+		istioConfigList.K8sGRPCRoutes = registryConfiguration.K8sGRPCRoutes
 		istioConfigList.AuthorizationPolicies = registryConfiguration.AuthorizationPolicies
 		istioConfigList.PeerAuthentications = registryConfiguration.PeerAuthentications
 		istioConfigList.RequestAuthentications = registryConfiguration.RequestAuthentications
+		istioConfigList.Telemetries = registryConfiguration.Telemetries
+		istioConfigList.WasmPlugins = registryConfiguration.WasmPlugins
 
 		return istioConfigList, nil
 	}
@@ -167,10 +211,10 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, criteria I
 		workloadSelector = criteria.WorkloadSelector
 	}
 
-	errChan := make(chan error, 11)
+	errChan := make(chan error, 17)
 
 	var wg sync.WaitGroup
-	wg.Add(11)
+	wg.Add(17)
 
 	listOpts := meta_v1.ListOptions{LabelSelector: criteria.LabelSelector}
 
@@ -326,6 +370,83 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, criteria I
 		}
 	}(ctx, errChan)
 
+	// Gateway API resources are only queried when the CRDs are present in the cluster
+	isGatewayAPI := in.k8s.IsGatewayAPI()
+
+	go func(ctx context.Context, errChan chan error) {
+		defer wg.Done()
+		if isGatewayAPI && criteria.Include(kubernetes.K8sGateways) {
+			var err error
+			if IsResourceCached(criteria.Namespace, kubernetes.K8sGateways) {
+				istioConfigList.K8sGateways, err = kialiCache.GetK8sGateways(criteria.Namespace, criteria.LabelSelector)
+			} else {
+				gwl, e := in.k8s.GatewayAPI().GatewayV1alpha2().Gateways(criteria.Namespace).List(ctx, listOpts)
+				istioConfigList.K8sGateways = gwl.Items
+				err = e
+			}
+			if err != nil {
+				errChan <- err
+			}
+		}
+	}(ctx, errChan)
+
+	go func(ctx context.Context, errChan chan error) {
+		defer wg.Done()
+		if isGatewayAPI && criteria.Include(kubernetes.K8sHTTPRoutes) {
+			var err error
+			if IsResourceCached(criteria.Namespace, kubernetes.K8sHTTPRoutes) {
+				istioConfigList.K8sHTTPRoutes, err = kialiCache.GetK8sHTTPRoutes(criteria.Namespace, criteria.LabelSelector)
+			} else {
+				rl, e := in.k8s.GatewayAPI().GatewayV1alpha2().HTTPRoutes(criteria.Namespace).List(ctx, listOpts)
+				istioConfigList.K8sHTTPRoutes = rl.Items
+				err = e
+			}
+			if err != nil {
+				errChan <- err
+			}
+		}
+	}(ctx, errChan)
+
+	// TCPRoutes and GRPCRoutes belong to the experimental channel, their CRDs can be missing when the other Gateway API CRDs are present
+	isTCPRouteAPI := isGatewayAPI && in.k8s.IsTCPRouteAPI()
+	isGRPCRouteAPI := isGatewayAPI && in.k8s.IsGRPCRouteAPI()
+
+	go func(ctx context.Context, errChan chan error) {
+		defer wg.Done()
+		if isTCPRouteAPI && criteria.Include(kubernetes.K8sTCPRoutes) {
+			var err error
+			if IsResourceCached(criteria.Namespace, kubernetes.K8sTCPRoutes) {
+				istioConfigList.K8sTCPRoutes, err = kialiCache.GetK8sTCPRoutes(criteria.Namespace, criteria.LabelSelector)
+			} else {
+				rl, e := in.k8s.GatewayAPI().GatewayV1alpha2().TCPRoutes(criteria.Namespace).List(ctx, listOpts)
+				istioConfigList.K8sTCPRoutes = rl.Items
+				err = e
+			}
+			if err != nil {
+				errChan <- err
+			}
+		}
+	}(ctx, errChan)
+
+	go func(ctx context.Context, errChan chan error) {
+		defer wg.Done()
+		if isGRPCRouteAPI && criteria.Include(kubernetes.K8sGRPCRoutes) {
+			var err error
+			if IsResourceCached(criteria.Namespace, kubernetes.K8sGRPCRoutes) {
+				istioConfigList.K8sGRPCRoutes, err = kialiCache.GetK8sGRPCRoutes(criteria.Namespace, criteria.LabelSelector)
+			} else {
+				rl, e := in.k8s.Dynamic().Resource(kubernetes.K8sGRPCRouteGroupVersionResource).Namespace(criteria.Namespace).List(ctx, listOpts)
+				if e == nil {
+					istioConfigList.K8sGRPCRoutes = rl.Items
+				}
+				err = e
+			}
+			if err != nil {
+				errChan <- err
+			}
+		}
+	}(ctx, errChan)
+
 	go func(ctx context.Context, errChan chan error) {
 		defer wg.Done()
 		if criteria.Include(kubernetes.AuthorizationPolicies) {
@@ -389,6 +510,48 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, criteria I
 		}
 	}(ctx, errChan)
 
+	go func(ctx context.Context, errChan chan error) {
+		defer wg.Done()
+		if criteria.Include(kubernetes.Telemetries) {
+			var err error
+			if IsResourceCached(criteria.Namespace, kubernetes.Telemetries) {
+				istioConfigList.Telemetries, err = kialiCache.GetTelemetries(criteria.Namespace, criteria.LabelSelector)
+			} else {
+				tl, e := in.k8s.Istio().TelemetryV1alpha1().Telemetries(criteria.Namespace).List(ctx, listOpts)
+				istioConfigList.Telemetries = tl.Items
+				err = e
+			}
+			if err == nil {
+				if isWorkloadSelector {
+					istioConfigList.Telemetries = kubernetes.FilterTelemetriesBySelector(workloadSelector, istioConfigList.Telemetries)
+				}
+			} else {
+				errChan <- err
+			}
+		}
+	}(ctx, errChan)
+
+	go func(ctx context.Context, errChan chan error) {
+		defer wg.Done()
+		if criteria.Include(kubernetes.WasmPlugins) {
+			var err error
+			if IsResourceCached(criteria.Namespace, kubernetes.WasmPlugins) {
+				istioConfigList.WasmPlugins, err = kialiCache.GetWasmPlugins(criteria.Namespace, criteria.LabelSelector)
+			} else {
+				wpl, e := in.k8s.Istio().ExtensionsV1alpha1().WasmPlugins(criteria.Namespace).List(ctx, listOpts)
+				istioConfigList.WasmPlugins = wpl.Items
+				err = e
+			}
+			if err == nil {
+				if isWorkloadSelector {
+					istioConfigList.WasmPlugins = kubernetes.FilterWasmPluginsBySelector(workloadSelector, istioConfigList.WasmPlugins)
+				}
+			} else {
+				errChan <- err
+			}
+		}
+	}(ctx, errChan)
+
 	wg.Wait()
 
 	close(errChan)
@@ -442,82 +605,126 @@ func (in *IstioConfigService) GetIstioConfigDetails(ctx context.Context, namespa
 		}
 	}(ctx)
 
+	err = in.getIstioObject(ctx, &istioConfigDetail, namespace, objectType, object)
+
+	wg.Wait()
+
+	return istioConfigDetail, err
+}
+
+// getIstioObject gets the Istio object from the API server and sets it in the details
+func (in *IstioConfigService) getIstioObject(ctx context.Context, details *models.IstioConfigDetails, namespace, objectType, object string) error {
+	var err error
 	getOpts := meta_v1.GetOptions{}
 
 	switch objectType {
 	case kubernetes.DestinationRules:
-		istioConfigDetail.DestinationRule, err = in.k8s.Istio().NetworkingV1beta1().DestinationRules(namespace).Get(ctx, object, getOpts)
+		details.DestinationRule, err = in.k8s.Istio().NetworkingV1beta1().DestinationRules(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.DestinationRule.Kind = kubernetes.DestinationRuleType
-			istioConfigDetail.DestinationRule.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.DestinationRule.Kind = kubernetes.DestinationRuleType
+			details.DestinationRule.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
 		}
 	case kubernetes.EnvoyFilters:
-		istioConfigDetail.EnvoyFilter, err = in.k8s.Istio().NetworkingV1alpha3().EnvoyFilters(namespace).Get(ctx, object, getOpts)
+		details.EnvoyFilter, err = in.k8s.Istio().NetworkingV1alpha3().EnvoyFilters(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.EnvoyFilter.Kind = kubernetes.EnvoyFilterType
-			istioConfigDetail.EnvoyFilter.APIVersion = kubernetes.ApiNetworkingVersionV1Alpha3
+			details.EnvoyFilter.Kind = kubernetes.EnvoyFilterType
+			details.EnvoyFilter.APIVersion = kubernetes.ApiNetworkingVersionV1Alpha3
 		}
 	case kubernetes.Gateways:
-		istioConfigDetail.Gateway, err = in.k8s.Istio().NetworkingV1beta1().Gateways(namespace).Get(ctx, object, getOpts)
+		details.Gateway, err = in.k8s.Istio().NetworkingV1beta1().Gateways(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.Gateway.Kind = kubernetes.GatewayType
-			istioConfigDetail.Gateway.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.Gateway.Kind = kubernetes.GatewayType
+			details.Gateway.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
 		}
 	case kubernetes.ServiceEntries:
-		istioConfigDetail.ServiceEntry, err = in.k8s.Istio().NetworkingV1beta1().ServiceEntries(namespace).Get(ctx, object, getOpts)
+		details.ServiceEntry, err = in.k8s.Istio().NetworkingV1beta1().ServiceEntries(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.ServiceEntry.Kind = kubernetes.ServiceEntryType
-			istioConfigDetail.ServiceEntry.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.ServiceEntry.Kind = kubernetes.ServiceEntryType
+			details.ServiceEntry.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
 		}
 	case kubernetes.Sidecars:
-		istioConfigDetail.Sidecar, err = in.k8s.Istio().NetworkingV1beta1().Sidecars(namespace).Get(ctx, object, getOpts)
+		details.Sidecar, err = in.k8s.Istio().NetworkingV1beta1().Sidecars(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.Sidecar.Kind = kubernetes.SidecarType
-			istioConfigDetail.Sidecar.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.Sidecar.Kind = kubernetes.SidecarType
+			details.Sidecar.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
 		}
 	case kubernetes.VirtualServices:
-		istioConfigDetail.VirtualService, err = in.k8s.Istio().NetworkingV1beta1().VirtualServices(namespace).Get(ctx, object, getOpts)
+		details.VirtualService, err = in.k8s.Istio().NetworkingV1beta1().VirtualServices(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.VirtualService.Kind = kubernetes.VirtualServiceType
-			istioConfigDetail.VirtualService.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.VirtualService.Kind = kubernetes.VirtualServiceType
+			details.VirtualService.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
 		}
 	case kubernetes.WorkloadEntries:
-		istioConfigDetail.WorkloadEntry, err = in.k8s.Istio().NetworkingV1beta1().WorkloadEntries(namespace).Get(ctx, object, getOpts)
+		details.WorkloadEntry, err = in.k8s.Istio().NetworkingV1beta1().WorkloadEntries(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.WorkloadEntry.Kind = kubernetes.WorkloadEntryType
-			istioConfigDetail.WorkloadEntry.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.WorkloadEntry.Kind = kubernetes.WorkloadEntryType
+			details.WorkloadEntry.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
 		}
 	case kubernetes.WorkloadGroups:
-		istioConfigDetail.WorkloadGroup, err = in.k8s.Istio().NetworkingV1beta1().WorkloadGroups(namespace).Get(ctx, object, getOpts)
+		details.WorkloadGroup, err = in.k8s.Istio().NetworkingV1beta1().WorkloadGroups(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			details.WorkloadGroup.Kind = kubernetes.WorkloadGroupType
+			details.WorkloadGroup.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+		}
+	case kubernetes.K8sGateways:
+		details.K8sGateway, err = in.k8s.GatewayAPI().GatewayV1alpha2().Gateways(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			details.K8sGateway.Kind = kubernetes.K8sActualGatewayType
+			details.K8sGateway.APIVersion = kubernetes.K8sApiNetworkingVersionV1Alpha2
+		}
+	case kubernetes.K8sHTTPRoutes:
+		details.K8sHTTPRoute, err = in.k8s.GatewayAPI().GatewayV1alpha2().HTTPRoutes(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			details.K8sHTTPRoute.Kind = kubernetes.K8sActualHTTPRouteType
+			details.K8sHTTPRoute.APIVersion = kubernetes.K8sApiNetworkingVersionV1Alpha2
+		}
+	case kubernetes.K8sTCPRoutes:
+		details.K8sTCPRoute, err = in.k8s.GatewayAPI().GatewayV1alpha2().TCPRoutes(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			details.K8sTCPRoute.Kind = kubernetes.K8sActualTCPRouteType
+			details.K8sTCPRoute.APIVersion = kubernetes.K8sApiNetworkingVersionV1Alpha2
+		}
+	case kubernetes.K8sGRPCRoutes:
+		details.K8sGRPCRoute, err = in.k8s.Dynamic().Resource(kubernetes.K8sGRPCRouteGroupVersionResource).Namespace(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.WorkloadGroup.Kind = kubernetes.WorkloadGroupType
-			istioConfigDetail.WorkloadGroup.APIVersion = kubernetes.ApiNetworkingVersionV1Beta1
+			details.K8sGRPCRoute.SetKind(kubernetes.K8sActualGRPCRouteType)
+			details.K8sGRPCRoute.SetAPIVersion(kubernetes.K8sApiNetworkingVersionV1Alpha2)
 		}
 	case kubernetes.AuthorizationPolicies:
-		istioConfigDetail.AuthorizationPolicy, err = in.k8s.Istio().SecurityV1beta1().AuthorizationPolicies(namespace).Get(ctx, object, getOpts)
+		details.AuthorizationPolicy, err = in.k8s.Istio().SecurityV1beta1().AuthorizationPolicies(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.AuthorizationPolicy.Kind = kubernetes.AuthorizationPoliciesType
-			istioConfigDetail.AuthorizationPolicy.APIVersion = kubernetes.ApiSecurityVersion
+			details.AuthorizationPolicy.Kind = kubernetes.AuthorizationPoliciesType
+			details.AuthorizationPolicy.APIVersion = kubernetes.ApiSecurityVersion
 		}
 	case kubernetes.PeerAuthentications:
-		istioConfigDetail.PeerAuthentication, err = in.k8s.Istio().SecurityV1beta1().PeerAuthentications(namespace).Get(ctx, object, getOpts)
+		details.PeerAuthentication, err = in.k8s.Istio().SecurityV1beta1().PeerAuthentications(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.PeerAuthentication.Kind = kubernetes.PeerAuthenticationsType
-			istioConfigDetail.PeerAuthentication.APIVersion = kubernetes.ApiSecurityVersion
+			details.PeerAuthentication.Kind = kubernetes.PeerAuthenticationsType
+			details.PeerAuthentication.APIVersion = kubernetes.ApiSecurityVersion
 		}
 	case kubernetes.RequestAuthentications:
-		istioConfigDetail.RequestAuthentication, err = in.k8s.Istio().SecurityV1beta1().RequestAuthentications(namespace).Get(ctx, object, getOpts)
+		details.RequestAuthentication, err = in.k8s.Istio().SecurityV1beta1().RequestAuthentications(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			details.RequestAuthentication.Kind = kubernetes.RequestAuthenticationsType
+			details.RequestAuthentication.APIVersion = kubernetes.ApiSecurityVersion
+		}
+	case kubernetes.Telemetries:
+		details.Telemetry, err = in.k8s.Istio().TelemetryV1alpha1().Telemetries(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			details.Telemetry.Kind = kubernetes.TelemetryType
+			details.Telemetry.APIVersion = kubernetes.ApiTelemetryVersionV1Alpha1
+		}
+	case kubernetes.WasmPlugins:
+		details.WasmPlugin, err = in.k8s.Istio().ExtensionsV1alpha1().WasmPlugins(namespace).Get(ctx, object, getOpts)
 		if err == nil {
-			istioConfigDetail.RequestAuthentication.Kind = kubernetes.RequestAuthenticationsType
-			istioConfigDetail.RequestAuthentication.APIVersion = kubernetes.ApiSecurityVersion
+			details.WasmPlugin.Kind = kubernetes.WasmPluginType
+			details.WasmPlugin.APIVersion = kubernetes.ApiExtensionsVersionV1Alpha1
 		}
 	default:
 		err = fmt.Errorf("object type not found: %v", objectType)
 	}
 
-	wg.Wait()
-
-	return istioConfigDetail, err
+	return err
 }
 
 // GetIstioAPI provides the Kubernetes API that manages this Istio resource type
@@ -531,6 +738,8 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(namespace, resourceType, n
 	var err error
 	delOpts := meta_v1.DeleteOptions{}
 	ctx := context.TODO()
+	revision := in.currentRevision(ctx, namespace, resourceType, name, models.IstioConfigRevisionDelete)
+
 	switch resourceType {
 	case kubernetes.DestinationRules:
 		err = in.k8s.Istio().NetworkingV1beta1().DestinationRules(namespace).Delete(ctx, name, delOpts)
@@ -548,16 +757,32 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(namespace, resourceType, n
 		err = in.k8s.Istio().NetworkingV1beta1().WorkloadEntries(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.WorkloadGroups:
 		err = in.k8s.Istio().NetworkingV1beta1().WorkloadGroups(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.K8sGateways:
+		err = in.k8s.GatewayAPI().GatewayV1alpha2().Gateways(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.K8sHTTPRoutes:
+		err = in.k8s.GatewayAPI().GatewayV1alpha2().HTTPRoutes(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.K8sTCPRoutes:
+		err = in.k8s.GatewayAPI().GatewayV1alpha2().TCPRoutes(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.K8sGRPCRoutes:
+		err = in.k8s.Dynamic().Resource(kubernetes.K8sGRPCRouteGroupVersionResource).Namespace(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.AuthorizationPolicies:
 		err = in.k8s.Istio().SecurityV1beta1().AuthorizationPolicies(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.PeerAuthentications:
 		err = in.k8s.Istio().SecurityV1beta1().PeerAuthentications(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.RequestAuthentications:
 		err = in.k8s.Istio().SecurityV1beta1().RequestAuthentications(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.Telemetries:
+		err = in.k8s.Istio().TelemetryV1alpha1().Telemetries(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.WasmPlugins:
+		err = in.k8s.Istio().ExtensionsV1alpha1().WasmPlugins(namespace).Delete(ctx, name, delOpts)
 	default:
 		err = fmt.Errorf("object type not found: %v", resourceType)
 	}
 
+	if err == nil {
+		recordRevision(revision)
+	}
+
 	// Cache is stopped after a Create/Update/Delete operation to force a refresh
 	if kialiCache != nil && err == nil {
 		kialiCache.RefreshNamespace(namespace)
@@ -566,12 +791,22 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(namespace, resourceType, n
 }
 
 func (in *IstioConfigService) UpdateIstioConfigDetail(namespace, resourceType, name, jsonPatch string) (models.IstioConfigDetails, error) {
+	return in.updateIstioConfigDetail(namespace, resourceType, name, jsonPatch, false)
+}
+
+// updateIstioConfigDetail patches the Istio resource. On dry-run, the patch is submitted with the server-side
+// dry-run option: it is validated and the patched resource is returned, but nothing is persisted.
+func (in *IstioConfigService) updateIstioConfigDetail(namespace, resourceType, name, jsonPatch string, dryRun bool) (models.IstioConfigDetails, error) {
 	istioConfigDetail := models.IstioConfigDetails{}
 	istioConfigDetail.Namespace = models.Namespace{Name: namespace}
 	istioConfigDetail.ObjectType = resourceType
 
-	patchOpts := meta_v1.PatchOptions{}
+	patchOpts := meta_v1.PatchOptions{DryRun: dryRunOption(dryRun)}
 	ctx := context.TODO()
+	var revision *models.IstioConfigRevision
+	if !dryRun {
+		revision = in.currentRevision(ctx, namespace, resourceType, name, models.IstioConfigRevisionUpdate)
+	}
 	patchType := api_types.MergePatchType
 	bytePatch := []byte(jsonPatch)
 
@@ -601,6 +836,17 @@ func (in *IstioConfigService) UpdateIstioConfigDetail(namespace, resourceType, n
 	case kubernetes.WorkloadGroups:
 		istioConfigDetail.WorkloadGroup = &networking_v1beta1.WorkloadGroup{}
 		istioConfigDetail.WorkloadGroup, err = in.k8s.Istio().NetworkingV1beta1().WorkloadGroups(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.K8sGateways:
+		istioConfigDetail.K8sGateway = &k8s_networking_v1alpha2.Gateway{}
+		istioConfigDetail.K8sGateway, err = in.k8s.GatewayAPI().GatewayV1alpha2().Gateways(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.K8sHTTPRoutes:
+		istioConfigDetail.K8sHTTPRoute = &k8s_networking_v1alpha2.HTTPRoute{}
+		istioConfigDetail.K8sHTTPRoute, err = in.k8s.GatewayAPI().GatewayV1alpha2().HTTPRoutes(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.K8sTCPRoutes:
+		istioConfigDetail.K8sTCPRoute = &k8s_networking_v1alpha2.TCPRoute{}
+		istioConfigDetail.K8sTCPRoute, err = in.k8s.GatewayAPI().GatewayV1alpha2().TCPRoutes(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.K8sGRPCRoutes:
+		istioConfigDetail.K8sGRPCRoute, err = in.k8s.Dynamic().Resource(kubernetes.K8sGRPCRouteGroupVersionResource).Namespace(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
 	case kubernetes.AuthorizationPolicies:
 		istioConfigDetail.AuthorizationPolicy = &security_v1beta1.AuthorizationPolicy{}
 		istioConfigDetail.AuthorizationPolicy, err = in.k8s.Istio().SecurityV1beta1().AuthorizationPolicies(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
@@ -610,23 +856,39 @@ func (in *IstioConfigService) UpdateIstioConfigDetail(namespace, resourceType, n
 	case kubernetes.RequestAuthentications:
 		istioConfigDetail.RequestAuthentication = &security_v1beta1.RequestAuthentication{}
 		istioConfigDetail.RequestAuthentication, err = in.k8s.Istio().SecurityV1beta1().RequestAuthentications(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.Telemetries:
+		istioConfigDetail.Telemetry = &telemetry_v1alpha1.Telemetry{}
+		istioConfigDetail.Telemetry, err = in.k8s.Istio().TelemetryV1alpha1().Telemetries(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.WasmPlugins:
+		istioConfigDetail.WasmPlugin = &extensions_v1alpha1.WasmPlugin{}
+		istioConfigDetail.WasmPlugin, err = in.k8s.Istio().ExtensionsV1alpha1().WasmPlugins(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
 	default:
 		err = fmt.Errorf("object type not found: %v", resourceType)
 	}
 
+	if err == nil {
+		recordRevision(revision)
+	}
+
 	// Cache is stopped after a Create/Update/Delete operation to force a refresh
-	if kialiCache != nil && err == nil {
+	if kialiCache != nil && err == nil && !dryRun {
 		kialiCache.RefreshNamespace(namespace)
 	}
 	return istioConfigDetail, err
 }
 
 func (in *IstioConfigService) CreateIstioConfigDetail(namespace, resourceType string, body []byte) (models.IstioConfigDetails, error) {
+	return in.createIstioConfigDetail(namespace, resourceType, body, false)
+}
+
+// createIstioConfigDetail creates the Istio resource. On dry-run, the resource is submitted with the server-side
+// dry-run option: it is validated and the resource as it would be created is returned, but nothing is persisted.
+func (in *IstioConfigService) createIstioConfigDetail(namespace, resourceType string, body []byte, dryRun bool) (models.IstioConfigDetails, error) {
 	istioConfigDetail := models.IstioConfigDetails{}
 	istioConfigDetail.Namespace = models.Namespace{Name: namespace}
 	istioConfigDetail.ObjectType = resourceType
 
-	createOpts := meta_v1.CreateOptions{}
+	createOpts := meta_v1.CreateOptions{DryRun: dryRunOption(dryRun)}
 	ctx := context.TODO()
 
 	var err error
@@ -687,6 +949,36 @@ func (in *IstioConfigService) CreateIstioConfigDetail(namespace, resourceType st
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.WorkloadGroup, err = in.k8s.Istio().NetworkingV1beta1().WorkloadGroups(namespace).Create(ctx, istioConfigDetail.WorkloadGroup, createOpts)
+	case kubernetes.K8sGateways:
+		istioConfigDetail.K8sGateway = &k8s_networking_v1alpha2.Gateway{}
+		err = json.Unmarshal(body, istioConfigDetail.K8sGateway)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		istioConfigDetail.K8sGateway, err = in.k8s.GatewayAPI().GatewayV1alpha2().Gateways(namespace).Create(ctx, istioConfigDetail.K8sGateway, createOpts)
+	case kubernetes.K8sHTTPRoutes:
+		istioConfigDetail.K8sHTTPRoute = &k8s_networking_v1alpha2.HTTPRoute{}
+		err = json.Unmarshal(body, istioConfigDetail.K8sHTTPRoute)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		istioConfigDetail.K8sHTTPRoute, err = in.k8s.GatewayAPI().GatewayV1alpha2().HTTPRoutes(namespace).Create(ctx, istioConfigDetail.K8sHTTPRoute, createOpts)
+	case kubernetes.K8sTCPRoutes:
+		istioConfigDetail.K8sTCPRoute = &k8s_networking_v1alpha2.TCPRoute{}
+		err = json.Unmarshal(body, istioConfigDetail.K8sTCPRoute)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		istioConfigDetail.K8sTCPRoute, err = in.k8s.GatewayAPI().GatewayV1alpha2().TCPRoutes(namespace).Create(ctx, istioConfigDetail.K8sTCPRoute, createOpts)
+	case kubernetes.K8sGRPCRoutes:
+		istioConfigDetail.K8sGRPCRoute = &unstructured.Unstructured{}
+		err = istioConfigDetail.K8sGRPCRoute.UnmarshalJSON(body)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		// The dynamic client sends the object as is, the GroupVersionKind is mandatory
+		istioConfigDetail.K8sGRPCRoute.SetGroupVersionKind(kubernetes.K8sNetworkingGroupVersionV1Alpha2.WithKind(kubernetes.K8sActualGRPCRouteType))
+		istioConfigDetail.K8sGRPCRoute, err = in.k8s.Dynamic().Resource(kubernetes.K8sGRPCRouteGroupVersionResource).Namespace(namespace).Create(ctx, istioConfigDetail.K8sGRPCRoute, createOpts)
 	case kubernetes.AuthorizationPolicies:
 		istioConfigDetail.AuthorizationPolicy = &security_v1beta1.AuthorizationPolicy{}
 		err = json.Unmarshal(body, istioConfigDetail.AuthorizationPolicy)
@@ -708,16 +1000,37 @@ func (in *IstioConfigService) CreateIstioConfigDetail(namespace, resourceType st
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.RequestAuthentication, err = in.k8s.Istio().SecurityV1beta1().RequestAuthentications(namespace).Create(ctx, istioConfigDetail.RequestAuthentication, createOpts)
+	case kubernetes.Telemetries:
+		istioConfigDetail.Telemetry = &telemetry_v1alpha1.Telemetry{}
+		err = json.Unmarshal(body, istioConfigDetail.Telemetry)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		istioConfigDetail.Telemetry, err = in.k8s.Istio().TelemetryV1alpha1().Telemetries(namespace).Create(ctx, istioConfigDetail.Telemetry, createOpts)
+	case kubernetes.WasmPlugins:
+		istioConfigDetail.WasmPlugin = &extensions_v1alpha1.WasmPlugin{}
+		err = json.Unmarshal(body, istioConfigDetail.WasmPlugin)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		istioConfigDetail.WasmPlugin, err = in.k8s.Istio().ExtensionsV1alpha1().WasmPlugins(namespace).Create(ctx, istioConfigDetail.WasmPlugin, createOpts)
 	default:
 		err = fmt.Errorf("object type not found: %v", resourceType)
 	}
 	// Cache is stopped after a Create/Update/Delete operation to force a refresh
-	if kialiCache != nil && err == nil {
+	if kialiCache != nil && err == nil && !dryRun {
 		kialiCache.RefreshNamespace(namespace)
 	}
 	return istioConfigDetail, err
 }
 
+func dryRunOption(dryRun bool) []string {
+	if dryRun {
+		return []string{meta_v1.DryRunAll}
+	}
+	return nil
+}
+
 func (in *IstioConfigService) GetIstioConfigPermissions(ctx context.Context, namespaces []string) models.IstioConfigPermissions {
 	var end observability.EndFunc
 	ctx, end = observability.StartSpan(ctx, "GetIstioConfigPermissions",
@@ -731,15 +1044,18 @@ func (in *IstioConfigService) GetIstioConfigPermissions(ctx context.Context, nam
 	if len(namespaces) > 0 {
 		networkingPermissions := make(models.IstioConfigPermissions, len(namespaces))
 		securityPermissions := make(models.IstioConfigPermissions, len(namespaces))
+		k8sNetworkingPermissions := make(models.IstioConfigPermissions, len(namespaces))
 
 		wg := sync.WaitGroup{}
-		// We will query 2 times per namespace (networking.istio.io and security.istio.io)
-		wg.Add(len(namespaces) * 2)
+		// We will query 3 times per namespace (networking.istio.io, security.istio.io and gateway.networking.k8s.io)
+		wg.Add(len(namespaces) * 3)
 		for _, ns := range namespaces {
 			networkingRP := make(models.ResourcesPermissions, len(newNetworkingConfigTypes))
 			securityRP := make(models.ResourcesPermissions, len(newSecurityConfigTypes))
+			k8sNetworkingRP := make(models.ResourcesPermissions, len(newK8sNetworkingConfigTypes))
 			networkingPermissions[ns] = &networkingRP
 			securityPermissions[ns] = &securityRP
+			k8sNetworkingPermissions[ns] = &k8sNetworkingRP
 			/*
 				We can optimize this logic.
 				Instead of query all editable objects of networking.istio.io and security.istio.io we can query
@@ -771,12 +1087,27 @@ func (in *IstioConfigService) GetIstioConfigPermissions(ctx context.Context, nam
 					}
 				}
 			}(ctx, ns, &wg, &securityRP)
+
+			go func(ctx context.Context, namespace string, wg *sync.WaitGroup, k8sNetworkingPermissions *models.ResourcesPermissions) {
+				defer wg.Done()
+				canCreate, canUpdate, canDelete := false, false, false
+				if in.k8s.IsGatewayAPI() {
+					canCreate, canUpdate, canDelete = getPermissionsApi(ctx, in.k8s, namespace, kubernetes.K8sNetworkingGroupVersionV1Alpha2.Group, allResources)
+				}
+				for _, rs := range newK8sNetworkingConfigTypes {
+					k8sNetworkingRP[rs] = &models.ResourcePermissions{
+						Create: canCreate,
+						Update: canUpdate,
+						Delete: canDelete,
+					}
+				}
+			}(ctx, ns, &wg, &k8sNetworkingRP)
 		}
 		wg.Wait()
 
 		// Join networking and security permissions into a single result
 		for _, ns := range namespaces {
-			allRP := make(models.ResourcesPermissions, len(newNetworkingConfigTypes)+len(newSecurityConfigTypes))
+			allRP := make(models.ResourcesPermissions, len(newNetworkingConfigTypes)+len(newSecurityConfigTypes)+len(newK8sNetworkingConfigTypes))
 			istioConfigPermissions[ns] = &allRP
 			for resource, permissions := range *networkingPermissions[ns] {
 				(*istioConfigPermissions[ns])[resource] = permissions
@@ -784,6 +1115,9 @@ func (in *IstioConfigService) GetIstioConfigPermissions(ctx context.Context, nam
 			for resource, permissions := range *securityPermissions[ns] {
 				(*istioConfigPermissions[ns])[resource] = permissions
 			}
+			for resource, permissions := range *k8sNetworkingPermissions[ns] {
+				(*istioConfigPermissions[ns])[resource] = permissions
+			}
 		}
 	}
 	return istioConfigPermissions
@@ -794,6 +1128,10 @@ func getPermissions(ctx context.Context, k8s kubernetes.ClientInterface, namespa
 
 	if api, ok := kubernetes.ResourceTypesToAPI[objectType]; ok {
 		resourceType := objectType
+		if api == kubernetes.K8sNetworkingGroupVersionV1Alpha2.Group {
+			// Gateway API resources are exposed with a "k8s" prefix to avoid clashing with Istio types
+			resourceType = strings.TrimPrefix(objectType, "k8s")
+		}
 		return getPermissionsApi(ctx, k8s, namespace, api, resourceType)
 	}
 	return canCreate, canPatch, canDelete
@@ -859,6 +1197,12 @@ func ParseIstioConfigCriteria(namespace, objects, labelSelector, workloadSelecto
 	criteria.IncludeWorkloadGroups = defaultInclude
 	criteria.IncludeRequestAuthentications = defaultInclude
 	criteria.IncludeEnvoyFilters = defaultInclude
+	criteria.IncludeK8sGateways = defaultInclude
+	criteria.IncludeK8sHTTPRoutes = defaultInclude
+	criteria.IncludeK8sTCPRoutes = defaultInclude
+	criteria.IncludeK8sGRPCRoutes = defaultInclude
+	criteria.IncludeTelemetries = defaultInclude
+	criteria.IncludeWasmPlugins = defaultInclude
 	criteria.LabelSelector = labelSelector
 	criteria.WorkloadSelector = workloadSelector
 
@@ -906,5 +1250,23 @@ func ParseIstioConfigCriteria(namespace, objects, labelSelector, workloadSelecto
 	if checkType(types, kubernetes.EnvoyFilters) {
 		criteria.IncludeEnvoyFilters = true
 	}
+	if checkType(types, kubernetes.K8sGateways) {
+		criteria.IncludeK8sGateways = true
+	}
+	if checkType(types, kubernetes.K8sHTTPRoutes) {
+		criteria.IncludeK8sHTTPRoutes = true
+	}
+	if checkType(types, kubernetes.K8sTCPRoutes) {
+		criteria.IncludeK8sTCPRoutes = true
+	}
+	if checkType(types, kubernetes.K8sGRPCRoutes) {
+		criteria.IncludeK8sGRPCRoutes = true
+	}
+	if checkType(types, kubernetes.Telemetries) {
+		criteria.IncludeTelemetries = true
+	}
+	if checkType(types, kubernetes.WasmPlugins) {
+		criteria.IncludeWasmPlugins = true
+	}
 	return criteria
 }
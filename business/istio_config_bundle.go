@@ -0,0 +1,564 @@
+package business
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s_yaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+)
+
+// lastAppliedConfigAnnotation is set by kubectl apply, it is not part of an exported object
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// istioResourceTypeMeta is the type meta of the objects of each Istio resource type
+var istioResourceTypeMeta = map[string]meta_v1.TypeMeta{
+	kubernetes.AuthorizationPolicies:  {APIVersion: kubernetes.ApiSecurityVersion, Kind: kubernetes.AuthorizationPoliciesType},
+	kubernetes.DestinationRules:       {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.DestinationRuleType},
+	kubernetes.EnvoyFilters:           {APIVersion: kubernetes.ApiNetworkingVersionV1Alpha3, Kind: kubernetes.EnvoyFilterType},
+	kubernetes.Gateways:               {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.GatewayType},
+	kubernetes.K8sGateways:            {APIVersion: kubernetes.K8sApiNetworkingVersionV1Alpha2, Kind: kubernetes.K8sActualGatewayType},
+	kubernetes.K8sHTTPRoutes:          {APIVersion: kubernetes.K8sApiNetworkingVersionV1Alpha2, Kind: kubernetes.K8sActualHTTPRouteType},
+	kubernetes.K8sTCPRoutes:           {APIVersion: kubernetes.K8sApiNetworkingVersionV1Alpha2, Kind: kubernetes.K8sActualTCPRouteType},
+	kubernetes.K8sGRPCRoutes:          {APIVersion: kubernetes.K8sApiNetworkingVersionV1Alpha2, Kind: kubernetes.K8sActualGRPCRouteType},
+	kubernetes.PeerAuthentications:    {APIVersion: kubernetes.ApiSecurityVersion, Kind: kubernetes.PeerAuthenticationsType},
+	kubernetes.RequestAuthentications: {APIVersion: kubernetes.ApiSecurityVersion, Kind: kubernetes.RequestAuthenticationsType},
+	kubernetes.ServiceEntries:         {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.ServiceEntryType},
+	kubernetes.Sidecars:               {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.SidecarType},
+	kubernetes.Telemetries:            {APIVersion: kubernetes.ApiTelemetryVersionV1Alpha1, Kind: kubernetes.TelemetryType},
+	kubernetes.VirtualServices:        {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.VirtualServiceType},
+	kubernetes.WasmPlugins:            {APIVersion: kubernetes.ApiExtensionsVersionV1Alpha1, Kind: kubernetes.WasmPluginType},
+	kubernetes.WorkloadEntries:        {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.WorkloadEntryType},
+	kubernetes.WorkloadGroups:         {APIVersion: kubernetes.ApiNetworkingVersionV1Beta1, Kind: kubernetes.WorkloadGroupType},
+}
+
+// istioConfigBundleObject is an object of an imported bundle, with the state needed to apply it and to restore it
+type istioConfigBundleObject struct {
+	body         []byte
+	current      *models.IstioConfigDetails // nil if the object doesn't exist
+	jsonPatch    string                     // the patch replacing the current object
+	name         string
+	namespace    string
+	resourceType string
+	result       *models.IstioConfigImportObjectResult
+}
+
+// istioResourceType returns the Istio resource type of the objects of the given API version and kind. The API
+// version is only checked for its group, so manifests of other versions of the same resources are accepted.
+func istioResourceType(apiVersion, kind string) (string, bool) {
+	group := strings.Split(apiVersion, "/")[0]
+	for resourceType, typeMeta := range istioResourceTypeMeta {
+		if typeMeta.Kind == kind && strings.Split(typeMeta.APIVersion, "/")[0] == group {
+			return resourceType, true
+		}
+	}
+	return "", false
+}
+
+// manifestObject is an object of a manifest, of any type
+type manifestObject struct {
+	apiVersion string
+	body       []byte
+	document   int
+	kind       string
+	name       string
+	namespace  string
+	source     string
+}
+
+// parseIstioConfigBundle parses a bundle of Istio objects: a multi-document YAML or JSON, or a tar archive, optionally
+// gzipped, of such manifests. The objects without namespace are set in the default namespace.
+func parseIstioConfigBundle(data []byte, defaultNamespace string) ([]*istioConfigBundleObject, error) {
+	manifestObjects, err := parseManifestBundle(data, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []*istioConfigBundleObject{}
+	for _, o := range manifestObjects {
+		resourceType, found := istioResourceType(o.apiVersion, o.kind)
+		if !found {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Document %d of %s: unsupported object type [%s] [%s]", o.document, o.source, o.apiVersion, o.kind))
+		}
+		if o.namespace == "" {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Document %d of %s: object [%s] without namespace", o.document, o.source, o.name))
+		}
+		objects = append(objects, &istioConfigBundleObject{
+			body:         o.body,
+			name:         o.name,
+			namespace:    o.namespace,
+			resourceType: resourceType,
+			result: &models.IstioConfigImportObjectResult{
+				Namespace:  o.namespace,
+				ObjectType: resourceType,
+				Name:       o.name,
+				Findings:   []models.ValidationFinding{},
+			},
+		})
+	}
+	return objects, nil
+}
+
+// parseManifestBundle parses the objects of a multi-document YAML or JSON, or of a tar archive, optionally gzipped, of
+// such manifests. The objects without namespace are set in the default namespace, if any. Bundles exceeding
+// MaxBundleSize, compressed or not, are rejected with a RequestEntityTooLarge error.
+func parseManifestBundle(data []byte, defaultNamespace string) ([]*manifestObject, error) {
+	if int64(len(data)) > MaxBundleSize() {
+		return nil, bundleTooLargeError()
+	}
+
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, api_errors.NewBadRequest("Invalid gzip bundle: " + err.Error())
+		}
+		if data, err = ioutil.ReadAll(io.LimitReader(gz, MaxBundleSize()+1)); err != nil {
+			return nil, api_errors.NewBadRequest("Invalid gzip bundle: " + err.Error())
+		}
+		if int64(len(data)) > MaxBundleSize() {
+			return nil, bundleTooLargeError()
+		}
+	}
+
+	// tar archives have the "ustar" magic at offset 257
+	if len(data) > 262 && string(data[257:262]) == "ustar" {
+		objects := []*manifestObject{}
+		archive := tar.NewReader(bytes.NewReader(data))
+		remaining := MaxBundleSize()
+		for {
+			header, err := archive.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, api_errors.NewBadRequest("Invalid tar bundle: " + err.Error())
+			}
+			if header.Typeflag != tar.TypeReg || !isManifestFile(header.Name) {
+				continue
+			}
+			manifest, err := ioutil.ReadAll(io.LimitReader(archive, remaining+1))
+			if err != nil {
+				return nil, api_errors.NewBadRequest("Invalid tar bundle: " + err.Error())
+			}
+			if remaining -= int64(len(manifest)); remaining < 0 {
+				return nil, bundleTooLargeError()
+			}
+			fileObjects, err := parseManifest(manifest, header.Name, defaultNamespace)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, fileObjects...)
+		}
+		return objects, nil
+	}
+
+	return parseManifest(data, "bundle", defaultNamespace)
+}
+
+// MaxBundleSize returns the maximum size in bytes of a manifest bundle, before and after decompression
+func MaxBundleSize() int64 {
+	return int64(config.Get().Server.MaxBundleSize) * 1024 * 1024
+}
+
+func bundleTooLargeError() error {
+	return api_errors.NewRequestEntityTooLargeError(fmt.Sprintf("The bundle exceeds the maximum size of %d MB", config.Get().Server.MaxBundleSize))
+}
+
+func isManifestFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".yaml" || ext == ".yml" || ext == ".json"
+}
+
+// parseManifest parses the objects of a multi-document YAML or JSON manifest
+func parseManifest(manifest []byte, source, defaultNamespace string) ([]*manifestObject, error) {
+	objects := []*manifestObject{}
+	reader := k8s_yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifest)))
+	for i := 1; ; i++ {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Invalid document %d of %s: %v", i, source, err))
+		}
+		body, err := k8s_yaml.ToJSON(document)
+		if err != nil {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Invalid document %d of %s: %v", i, source, err))
+		}
+		var object map[string]interface{}
+		if err := json.Unmarshal(body, &object); err != nil {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Invalid document %d of %s: %v", i, source, err))
+		}
+		if len(object) == 0 {
+			// empty document
+			continue
+		}
+
+		apiVersion, _ := object["apiVersion"].(string)
+		kind, _ := object["kind"].(string)
+		metadata, _ := object["metadata"].(map[string]interface{})
+		name, _ := metadata["name"].(string)
+		if name == "" {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Document %d of %s: object without name", i, source))
+		}
+		namespace, _ := metadata["namespace"].(string)
+		if namespace == "" && defaultNamespace != "" && kind != "Namespace" {
+			namespace = defaultNamespace
+			metadata["namespace"] = namespace
+			if body, err = json.Marshal(object); err != nil {
+				return nil, err
+			}
+		}
+
+		objects = append(objects, &manifestObject{
+			apiVersion: apiVersion,
+			body:       body,
+			document:   i,
+			kind:       kind,
+			name:       name,
+			namespace:  namespace,
+			source:     source,
+		})
+	}
+	return objects, nil
+}
+
+// ImportIstioConfig creates or replaces the Istio objects of a bundle. Every object is submitted with the server-side
+// dry-run option first, and the objects are validated as a set with all the checkers. The bundle is only applied if
+// all the objects pass the dry-run, and if it doesn't introduce validation errors unless forced. The objects are
+// applied in the bundle order: on failure, the objects already applied are restored, the created ones are deleted.
+func (in *IstioConfigService) ImportIstioConfig(ctx context.Context, bundle []byte, defaultNamespace string, dryRun, force bool) (models.IstioConfigImportResult, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "ImportIstioConfig",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", defaultNamespace),
+		observability.Attribute("dryRun", dryRun),
+	)
+	defer end()
+
+	result := models.IstioConfigImportResult{DryRun: dryRun, Objects: []models.IstioConfigImportObjectResult{}, NewFindings: []models.ValidationFinding{}}
+
+	objects, err := parseIstioConfigBundle(bundle, defaultNamespace)
+	if err != nil {
+		return result, err
+	}
+	if len(objects) == 0 {
+		return result, api_errors.NewBadRequest("The bundle has no Istio objects")
+	}
+
+	namespaces := map[string]bool{}
+	keys := map[string]bool{}
+	for _, o := range objects {
+		key := istioConfigHistoryKey(o.namespace, o.resourceType, o.name)
+		if keys[key] {
+			return result, api_errors.NewBadRequest(fmt.Sprintf("The bundle has more than one %s [%s] in namespace [%s]", kubernetes.PluralType[o.resourceType], o.name, o.namespace))
+		}
+		keys[key] = true
+
+		if !namespaces[o.namespace] {
+			// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+			// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+			if _, err := in.businessLayer.Namespace.GetNamespace(ctx, o.namespace); err != nil {
+				return result, err
+			}
+			namespaces[o.namespace] = true
+		}
+	}
+
+	proposed, valid, err := in.dryRunIstioConfigBundle(ctx, objects)
+	if err != nil {
+		return result, err
+	}
+
+	validationsNamespace := ""
+	if len(namespaces) == 1 {
+		validationsNamespace = objects[0].namespace
+	}
+	before, after, err := in.businessLayer.Validations.GetIstioConfigChangeValidations(ctx, validationsNamespace, proposed)
+	if err != nil {
+		return result, err
+	}
+	newFindings, _ := diffFindings(before, after)
+	result.NewFindings = newFindings
+
+	for _, o := range objects {
+		key := models.BuildKey(models.ObjectTypeSingular[o.resourceType], o.name, o.namespace)
+		if validation, ok := after[key]; ok {
+			for _, check := range validation.Checks {
+				o.result.Findings = append(o.result.Findings, models.ValidationFinding{IstioValidationKey: key, IstioCheck: *check})
+			}
+		}
+		if force || o.result.Status == models.IstioConfigImportInvalid {
+			continue
+		}
+		for _, finding := range newFindings {
+			if finding.IstioValidationKey == key && finding.Severity == models.ErrorSeverity && !finding.Suppressed {
+				o.result.Status = models.IstioConfigImportInvalid
+				o.result.Error = "The object would introduce validation errors"
+				valid = false
+				break
+			}
+		}
+	}
+	if !force {
+		for _, finding := range newFindings {
+			if finding.Severity == models.ErrorSeverity && !finding.Suppressed {
+				valid = false
+			}
+		}
+	}
+
+	if dryRun || !valid {
+		for _, o := range objects {
+			if o.result.Status == "" {
+				if dryRun && valid {
+					o.result.Status = models.IstioConfigImportValid
+				} else {
+					o.result.Status = models.IstioConfigImportSkipped
+				}
+			}
+			result.Objects = append(result.Objects, *o.result)
+		}
+		return result, nil
+	}
+
+	result.Applied = in.applyIstioConfigBundle(objects)
+	for _, o := range objects {
+		result.Objects = append(result.Objects, *o.result)
+	}
+	return result, nil
+}
+
+// dryRunIstioConfigBundle reads the current state of the objects of the bundle and submits them with the server-side
+// dry-run option. It returns the objects as they would be stored, and false if any of them failed the dry-run.
+func (in *IstioConfigService) dryRunIstioConfigBundle(ctx context.Context, objects []*istioConfigBundleObject) ([]models.IstioConfigDetails, bool, error) {
+	proposed := []models.IstioConfigDetails{}
+	valid := true
+	for _, o := range objects {
+		current := models.IstioConfigDetails{ObjectType: o.resourceType}
+		err := in.getIstioObject(ctx, &current, o.namespace, o.resourceType, o.name)
+		switch {
+		case err == nil:
+			o.current = &current
+			o.result.Action = models.IstioConfigImportUpdate
+			if o.jsonPatch, err = replacePatch(istioObject(&current), o.body); err != nil {
+				return nil, false, err
+			}
+		case api_errors.IsNotFound(err):
+			o.result.Action = models.IstioConfigImportCreate
+		default:
+			return nil, false, err
+		}
+
+		var details models.IstioConfigDetails
+		if o.current == nil {
+			details, err = in.createIstioConfigDetail(o.namespace, o.resourceType, o.body, true)
+		} else {
+			details, err = in.updateIstioConfigDetail(o.namespace, o.resourceType, o.name, o.jsonPatch, true)
+		}
+		if err != nil {
+			o.result.Status = models.IstioConfigImportInvalid
+			o.result.Error = err.Error()
+			valid = false
+			continue
+		}
+		proposed = append(proposed, details)
+	}
+	return proposed, valid, nil
+}
+
+// applyIstioConfigBundle applies the objects in order. On failure, the applied objects are restored in reverse order
+// and the remaining ones are skipped. It returns true if all the objects were applied.
+func (in *IstioConfigService) applyIstioConfigBundle(objects []*istioConfigBundleObject) bool {
+	for i, o := range objects {
+		var err error
+		if o.current == nil {
+			_, err = in.CreateIstioConfigDetail(o.namespace, o.resourceType, o.body)
+		} else {
+			_, err = in.UpdateIstioConfigDetail(o.namespace, o.resourceType, o.name, o.jsonPatch)
+		}
+		if err == nil {
+			o.result.Status = models.IstioConfigImportApplied
+			continue
+		}
+
+		log.Warningf("Import of [%s] [%s] [%s] failed, restoring the %d objects already imported: %v", o.namespace, o.resourceType, o.name, i, err)
+		o.result.Status = models.IstioConfigImportFailed
+		o.result.Error = err.Error()
+		for _, skipped := range objects[i+1:] {
+			skipped.result.Status = models.IstioConfigImportSkipped
+		}
+		for j := i - 1; j >= 0; j-- {
+			in.restoreIstioConfigBundleObject(objects[j])
+		}
+		return false
+	}
+	return true
+}
+
+// restoreIstioConfigBundleObject deletes an imported object that was created, or restores the previous state of an
+// imported object that was updated
+func (in *IstioConfigService) restoreIstioConfigBundleObject(o *istioConfigBundleObject) {
+	var err error
+	if o.current == nil {
+		err = in.DeleteIstioConfigDetail(o.namespace, o.resourceType, o.name)
+	} else {
+		var previous []byte
+		if previous, err = json.Marshal(istioObject(o.current)); err == nil {
+			applied := models.IstioConfigDetails{ObjectType: o.resourceType}
+			if err = in.getIstioObject(context.TODO(), &applied, o.namespace, o.resourceType, o.name); err == nil {
+				var jsonPatch string
+				if jsonPatch, err = replacePatch(istioObject(&applied), previous); err == nil {
+					_, err = in.UpdateIstioConfigDetail(o.namespace, o.resourceType, o.name, jsonPatch)
+				}
+			}
+		}
+	}
+
+	if err != nil {
+		log.Errorf("Imported [%s] [%s] [%s] could not be restored: %v", o.namespace, o.resourceType, o.name, err)
+		o.result.Status = models.IstioConfigImportRollbackFailed
+		o.result.Error = err.Error()
+		return
+	}
+	o.result.Status = models.IstioConfigImportRolledBack
+}
+
+// ExportIstioConfig returns the Istio objects matching the criteria, in the given namespaces or in all the accessible
+// namespaces, as a multi-document YAML. The objects are ready to be applied: the status, the metadata set by the API
+// server and the last applied configuration annotation are removed.
+func (in *IstioConfigService) ExportIstioConfig(ctx context.Context, namespaces []string, criteria IstioConfigCriteria) ([]byte, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "ExportIstioConfig",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespaces", strings.Join(namespaces, ",")),
+	)
+	defer end()
+
+	if len(namespaces) == 0 {
+		nss, err := in.businessLayer.Namespace.GetNamespaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range nss {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+
+	var bundle bytes.Buffer
+	for _, namespace := range namespaces {
+		criteria.AllNamespaces = false
+		criteria.Namespace = namespace
+		istioConfigList, err := in.GetIstioConfigList(ctx, criteria)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range istioConfigListObjects(istioConfigList) {
+			document, err := exportIstioObject(o.resourceType, o.object)
+			if err != nil {
+				return nil, err
+			}
+			bundle.WriteString("---\n")
+			bundle.Write(document)
+		}
+	}
+	return bundle.Bytes(), nil
+}
+
+func exportIstioObject(resourceType string, object meta_v1.Object) ([]byte, error) {
+	m, err := toDiffableJSON(object)
+	if err != nil {
+		return nil, err
+	}
+	if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, lastAppliedConfigAnnotation)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	typeMeta := istioResourceTypeMeta[resourceType]
+	m["apiVersion"] = typeMeta.APIVersion
+	m["kind"] = typeMeta.Kind
+	return yaml.Marshal(m)
+}
+
+type istioConfigListObject struct {
+	object       meta_v1.Object
+	resourceType string
+}
+
+// istioConfigListObjects returns the objects of the list, grouped by type
+func istioConfigListObjects(list models.IstioConfigList) []istioConfigListObject {
+	objects := []istioConfigListObject{}
+	for i := range list.AuthorizationPolicies {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.AuthorizationPolicies, object: &list.AuthorizationPolicies[i]})
+	}
+	for i := range list.DestinationRules {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.DestinationRules, object: &list.DestinationRules[i]})
+	}
+	for i := range list.EnvoyFilters {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.EnvoyFilters, object: &list.EnvoyFilters[i]})
+	}
+	for i := range list.Gateways {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.Gateways, object: &list.Gateways[i]})
+	}
+	for i := range list.K8sGateways {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.K8sGateways, object: &list.K8sGateways[i]})
+	}
+	for i := range list.K8sHTTPRoutes {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.K8sHTTPRoutes, object: &list.K8sHTTPRoutes[i]})
+	}
+	for i := range list.K8sTCPRoutes {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.K8sTCPRoutes, object: &list.K8sTCPRoutes[i]})
+	}
+	for i := range list.K8sGRPCRoutes {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.K8sGRPCRoutes, object: &list.K8sGRPCRoutes[i]})
+	}
+	for i := range list.PeerAuthentications {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.PeerAuthentications, object: &list.PeerAuthentications[i]})
+	}
+	for i := range list.RequestAuthentications {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.RequestAuthentications, object: &list.RequestAuthentications[i]})
+	}
+	for i := range list.ServiceEntries {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.ServiceEntries, object: &list.ServiceEntries[i]})
+	}
+	for i := range list.Sidecars {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.Sidecars, object: &list.Sidecars[i]})
+	}
+	for i := range list.Telemetries {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.Telemetries, object: &list.Telemetries[i]})
+	}
+	for i := range list.VirtualServices {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.VirtualServices, object: &list.VirtualServices[i]})
+	}
+	for i := range list.WasmPlugins {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.WasmPlugins, object: &list.WasmPlugins[i]})
+	}
+	for i := range list.WorkloadEntries {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.WorkloadEntries, object: &list.WorkloadEntries[i]})
+	}
+	for i := range list.WorkloadGroups {
+		objects = append(objects, istioConfigListObject{resourceType: kubernetes.WorkloadGroups, object: &list.WorkloadGroups[i]})
+	}
+	return objects
+}
@@ -0,0 +1,146 @@
+package business
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+)
+
+const bundleManifest = `apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+---
+# comment only document
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: reviews
+  namespace: other
+spec:
+  host: reviews
+`
+
+func TestParseIstioConfigBundle(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	objects, err := parseIstioConfigBundle([]byte(bundleManifest), "bookinfo")
+	require.NoError(err)
+	require.Len(objects, 2)
+
+	assert.Equal(kubernetes.VirtualServices, objects[0].resourceType)
+	assert.Equal("reviews", objects[0].name)
+	assert.Equal("bookinfo", objects[0].namespace)
+	assert.Contains(string(objects[0].body), `"namespace":"bookinfo"`)
+
+	// other versions of the same resources are accepted
+	assert.Equal(kubernetes.DestinationRules, objects[1].resourceType)
+	assert.Equal("other", objects[1].namespace)
+}
+
+func TestParseIstioConfigBundleTar(t *testing.T) {
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	var archive bytes.Buffer
+	writer := tar.NewWriter(&archive)
+	for name, content := range map[string]string{"bookinfo/reviews.yaml": bundleManifest, "README.md": "not a manifest"} {
+		require.NoError(writer.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := writer.Write([]byte(content))
+		require.NoError(err)
+	}
+	require.NoError(writer.Close())
+
+	objects, err := parseIstioConfigBundle(archive.Bytes(), "bookinfo")
+	require.NoError(err)
+	require.Len(objects, 2)
+}
+
+func TestParseIstioConfigBundleErrors(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	_, err := parseIstioConfigBundle([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n"), "bookinfo")
+	assert.Error(err)
+	assert.Contains(err.Error(), "unsupported object type")
+
+	_, err = parseIstioConfigBundle([]byte("apiVersion: networking.istio.io/v1beta1\nkind: Gateway\nmetadata:\n  namespace: bookinfo\n"), "")
+	assert.Error(err)
+	assert.Contains(err.Error(), "without name")
+
+	_, err = parseIstioConfigBundle([]byte(bundleManifest), "")
+	assert.Error(err)
+	assert.Contains(err.Error(), "without namespace")
+}
+
+func TestParseIstioConfigBundleTooLarge(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.Server.MaxBundleSize = 1
+	config.Set(conf)
+	defer config.Set(config.NewConfig())
+
+	large := bundleManifest + "---\n" + strings.Repeat("#\n", 1024*1024)
+	_, err := parseIstioConfigBundle([]byte(large), "bookinfo")
+	assert.True(api_errors.IsRequestEntityTooLargeError(err))
+
+	// the limit applies to the decompressed bundle
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err = gz.Write([]byte(large))
+	require.NoError(err)
+	require.NoError(gz.Close())
+	require.Less(compressed.Len(), 1024*1024)
+	_, err = parseIstioConfigBundle(compressed.Bytes(), "bookinfo")
+	assert.True(api_errors.IsRequestEntityTooLargeError(err))
+}
+
+func TestExportIstioObject(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})
+	vs.ClusterName = ""
+	vs.ResourceVersion = "42"
+	vs.UID = "9f2b5f1e"
+	vs.ManagedFields = []meta_v1.ManagedFieldsEntry{{Manager: "kubectl"}}
+	vs.Annotations = map[string]string{lastAppliedConfigAnnotation: "{}"}
+
+	document, err := exportIstioObject(kubernetes.VirtualServices, vs)
+	require.NoError(err)
+
+	var exported map[string]interface{}
+	require.NoError(yaml.Unmarshal(document, &exported))
+	assert.Equal(kubernetes.ApiNetworkingVersionV1Beta1, exported["apiVersion"])
+	assert.Equal(kubernetes.VirtualServiceType, exported["kind"])
+	assert.NotContains(exported, "status")
+	assert.Equal(map[string]interface{}{"name": "reviews", "namespace": "bookinfo"}, exported["metadata"])
+
+	// the exported object can be imported back
+	objects, err := parseIstioConfigBundle(document, "")
+	require.NoError(err)
+	if assert.Len(objects, 1) {
+		assert.Equal(kubernetes.VirtualServices, objects[0].resourceType)
+		assert.True(strings.Contains(string(objects[0].body), `"hosts":["reviews"]`))
+	}
+}
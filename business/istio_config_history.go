@@ -0,0 +1,312 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+)
+
+type (
+	// IstioConfigHistory keeps the latest revisions of the Istio objects updated or deleted through Kiali.
+	IstioConfigHistory interface {
+		// Add stores the revision, numbered after the last revision of the same object, and returns it.
+		Add(revision models.IstioConfigRevision) models.IstioConfigRevision
+		// Get returns the given revision of an object, if it is still kept.
+		Get(namespace, objectType, name string, revision int) (models.IstioConfigRevision, bool)
+		// List returns the revisions kept for an object, from the newest to the oldest.
+		List(namespace, objectType, name string) []models.IstioConfigRevision
+	}
+
+	istioConfigHistoryImpl struct {
+		changes       int64 // number of revisions added, used to order the changes of the objects
+		lastChanges   map[string]int64
+		lastRevisions map[string]int
+		lock          sync.RWMutex
+		maxObjects    int
+		maxRevisions  int
+		revisions     map[string][]models.IstioConfigRevision
+	}
+)
+
+var (
+	istioConfigHistory     IstioConfigHistory
+	istioConfigHistoryOnce sync.Once
+)
+
+// NewIstioConfigHistory returns an in-memory IstioConfigHistory keeping up to maxRevisions revisions per object,
+// for up to maxObjects objects. When an object beyond maxObjects is changed, the history of the least recently
+// changed object is dropped, and its revisions are numbered from 1 again if it is changed later.
+// The revisions are lost when Kiali restarts and are not shared across replicas.
+func NewIstioConfigHistory(maxRevisions, maxObjects int) IstioConfigHistory {
+	return &istioConfigHistoryImpl{
+		lastChanges:   make(map[string]int64),
+		lastRevisions: make(map[string]int),
+		maxObjects:    maxObjects,
+		maxRevisions:  maxRevisions,
+		revisions:     make(map[string][]models.IstioConfigRevision),
+	}
+}
+
+func getIstioConfigHistory() IstioConfigHistory {
+	istioConfigHistoryOnce.Do(func() {
+		conf := config.Get().KialiFeatureFlags.IstioConfigHistory
+		istioConfigHistory = NewIstioConfigHistory(conf.MaxRevisions, conf.MaxObjects)
+	})
+	return istioConfigHistory
+}
+
+func istioConfigHistoryKey(namespace, objectType, name string) string {
+	return namespace + "/" + objectType + "/" + name
+}
+
+func (h *istioConfigHistoryImpl) Add(revision models.IstioConfigRevision) models.IstioConfigRevision {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	key := istioConfigHistoryKey(revision.Namespace, revision.ObjectType, revision.Name)
+	if _, found := h.revisions[key]; !found && h.maxObjects > 0 && len(h.revisions) >= h.maxObjects {
+		h.evict()
+	}
+	h.changes++
+	h.lastChanges[key] = h.changes
+	h.lastRevisions[key]++
+	revision.Revision = h.lastRevisions[key]
+
+	revisions := append(h.revisions[key], revision)
+	if len(revisions) > h.maxRevisions {
+		revisions = append([]models.IstioConfigRevision{}, revisions[len(revisions)-h.maxRevisions:]...)
+	}
+	h.revisions[key] = revisions
+	return revision
+}
+
+// evict drops the history of the least recently changed object. Must be called holding the lock.
+func (h *istioConfigHistoryImpl) evict() {
+	oldest := ""
+	for key, change := range h.lastChanges {
+		if oldest == "" || change < h.lastChanges[oldest] {
+			oldest = key
+		}
+	}
+	log.Debugf("Istio config history is full, dropping the revisions of [%s]", oldest)
+	delete(h.lastChanges, oldest)
+	delete(h.lastRevisions, oldest)
+	delete(h.revisions, oldest)
+}
+
+func (h *istioConfigHistoryImpl) Get(namespace, objectType, name string, revision int) (models.IstioConfigRevision, bool) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	for _, r := range h.revisions[istioConfigHistoryKey(namespace, objectType, name)] {
+		if r.Revision == revision {
+			return r, true
+		}
+	}
+	return models.IstioConfigRevision{}, false
+}
+
+func (h *istioConfigHistoryImpl) List(namespace, objectType, name string) []models.IstioConfigRevision {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	revisions := h.revisions[istioConfigHistoryKey(namespace, objectType, name)]
+	list := make([]models.IstioConfigRevision, 0, len(revisions))
+	for i := len(revisions) - 1; i >= 0; i-- {
+		list = append(list, revisions[i])
+	}
+	return list
+}
+
+// currentRevision returns the current state of the Istio object, to be recorded in the history once it is changed.
+// It returns nil when the history is disabled or the state can't be read: failures are logged, as they must not
+// prevent the change.
+func (in *IstioConfigService) currentRevision(ctx context.Context, namespace, resourceType, name, operation string) *models.IstioConfigRevision {
+	if config.Get().KialiFeatureFlags.IstioConfigHistory.MaxRevisions <= 0 {
+		return nil
+	}
+
+	details := models.IstioConfigDetails{ObjectType: resourceType}
+	err := in.getIstioObject(ctx, &details, namespace, resourceType, name)
+	var raw []byte
+	if err == nil {
+		var object map[string]interface{}
+		if object, err = toDiffableJSON(istioObject(&details)); err == nil {
+			raw, err = json.Marshal(object)
+		}
+	}
+	if err != nil {
+		log.Warningf("Revision of [%s] [%s] [%s] not recorded: %v", namespace, resourceType, name, err)
+		return nil
+	}
+
+	return &models.IstioConfigRevision{
+		Operation:  operation,
+		Namespace:  namespace,
+		ObjectType: resourceType,
+		Name:       name,
+		Object:     raw,
+	}
+}
+
+// recordRevision adds the revision, if any, to the history
+func recordRevision(revision *models.IstioConfigRevision) {
+	if revision != nil {
+		revision.Timestamp = time.Now()
+		getIstioConfigHistory().Add(*revision)
+	}
+}
+
+// GetIstioConfigRevisions returns the revisions kept for the Istio object, from the newest to the oldest, without
+// their objects.
+func (in *IstioConfigService) GetIstioConfigRevisions(ctx context.Context, namespace, resourceType, name string) ([]models.IstioConfigRevision, error) {
+	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+	if _, err := in.businessLayer.Namespace.GetNamespace(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	revisions := getIstioConfigHistory().List(namespace, resourceType, name)
+	for i := range revisions {
+		revisions[i].Object = nil
+	}
+	return revisions, nil
+}
+
+// GetIstioConfigRevision returns a revision of the Istio object.
+func (in *IstioConfigService) GetIstioConfigRevision(ctx context.Context, namespace, resourceType, name string, revision int) (models.IstioConfigRevision, error) {
+	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+	if _, err := in.businessLayer.Namespace.GetNamespace(ctx, namespace); err != nil {
+		return models.IstioConfigRevision{}, err
+	}
+
+	r, found := getIstioConfigHistory().Get(namespace, resourceType, name, revision)
+	if !found {
+		return r, api_errors.NewNotFound(schema.GroupResource{Resource: resourceType}, fmt.Sprintf("%s revision %d", name, revision))
+	}
+	return r, nil
+}
+
+// RollbackIstioConfig re-applies a revision of the Istio object: the object is updated to the revision labels,
+// annotations and spec, or created again if it was deleted. The rollback is validated first with a dry-run, and it is
+// rejected if it would introduce validation errors, unless forced. The state replaced by the rollback is recorded in
+// the history as well, so a rollback can be rolled back.
+func (in *IstioConfigService) RollbackIstioConfig(ctx context.Context, namespace, resourceType, name string, revision int, force bool) (models.IstioConfigDetails, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "RollbackIstioConfig",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("objectType", resourceType),
+		observability.Attribute("object", name),
+		observability.Attribute("revision", revision),
+	)
+	defer end()
+
+	r, err := in.GetIstioConfigRevision(ctx, namespace, resourceType, name, revision)
+	if err != nil {
+		return models.IstioConfigDetails{}, err
+	}
+
+	current := models.IstioConfigDetails{ObjectType: resourceType}
+	err = in.getIstioObject(ctx, &current, namespace, resourceType, name)
+	if err != nil && !api_errors.IsNotFound(err) {
+		return models.IstioConfigDetails{}, err
+	}
+	deleted := err != nil
+
+	var jsonPatch string
+	var preview models.IstioConfigPreview
+	if deleted {
+		preview, err = in.PreviewIstioConfigCreate(ctx, namespace, resourceType, r.Object)
+	} else {
+		if jsonPatch, err = replacePatch(istioObject(&current), r.Object); err != nil {
+			return models.IstioConfigDetails{}, err
+		}
+		preview, err = in.PreviewIstioConfigUpdate(ctx, namespace, resourceType, name, jsonPatch)
+	}
+	if err != nil {
+		return models.IstioConfigDetails{}, err
+	}
+
+	if !force {
+		errors := []string{}
+		for _, finding := range preview.NewFindings {
+			if finding.Severity == models.ErrorSeverity && !finding.Suppressed {
+				errors = append(errors, fmt.Sprintf("%s on %s %s/%s", finding.GetFullMessage(), finding.ObjectType, finding.Namespace, finding.Name))
+			}
+		}
+		if len(errors) > 0 {
+			return models.IstioConfigDetails{}, api_errors.NewBadRequest(fmt.Sprintf("Rollback to revision %d would introduce validation errors: %s", revision, strings.Join(errors, ", ")))
+		}
+	}
+
+	if deleted {
+		return in.CreateIstioConfigDetail(namespace, resourceType, r.Object)
+	}
+	return in.UpdateIstioConfigDetail(namespace, resourceType, name, jsonPatch)
+}
+
+// replacePatch returns the JSON merge patch replacing the labels, annotations and spec of the current object with
+// the ones of the given object
+func replacePatch(current interface{}, object json.RawMessage) (string, error) {
+	b, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+	var from, to map[string]interface{}
+	if err := json.Unmarshal(b, &from); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(object, &to); err != nil {
+		return "", err
+	}
+
+	fromMetadata, _ := from["metadata"].(map[string]interface{})
+	toMetadata, _ := to["metadata"].(map[string]interface{})
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": mergePatch(fromMetadata["annotations"], toMetadata["annotations"]),
+			"labels":      mergePatch(fromMetadata["labels"], toMetadata["labels"]),
+		},
+		"spec": mergePatch(from["spec"], to["spec"]),
+	}
+	b, err = json.Marshal(patch)
+	return string(b), err
+}
+
+// mergePatch returns the JSON merge patch (RFC 7386) value turning the from value into the to value
+func mergePatch(from, to interface{}) interface{} {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if !fromIsMap || !toIsMap {
+		return to
+	}
+
+	patch := map[string]interface{}{}
+	for k, fromValue := range fromMap {
+		if _, found := toMap[k]; !found {
+			patch[k] = nil
+		} else if !reflect.DeepEqual(fromValue, toMap[k]) {
+			patch[k] = mergePatch(fromValue, toMap[k])
+		}
+	}
+	for k, toValue := range toMap {
+		if _, found := fromMap[k]; !found {
+			patch[k] = toValue
+		}
+	}
+	return patch
+}
@@ -0,0 +1,149 @@
+package business
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func setupTestIstioConfigHistory(maxRevisions int) {
+	conf := config.NewConfig()
+	conf.KialiFeatureFlags.IstioConfigHistory.MaxRevisions = maxRevisions
+	config.Set(conf)
+
+	istioConfigHistoryOnce.Do(func() {})
+	istioConfigHistory = NewIstioConfigHistory(maxRevisions, 10)
+}
+
+func TestIstioConfigHistoryKeepsLatestRevisions(t *testing.T) {
+	assert := assert.New(t)
+
+	history := NewIstioConfigHistory(2, 10)
+	for i := 0; i < 3; i++ {
+		history.Add(models.IstioConfigRevision{Namespace: "bookinfo", ObjectType: kubernetes.VirtualServices, Name: "reviews", Operation: models.IstioConfigRevisionUpdate})
+	}
+	other := history.Add(models.IstioConfigRevision{Namespace: "bookinfo", ObjectType: kubernetes.VirtualServices, Name: "ratings", Operation: models.IstioConfigRevisionDelete})
+	assert.Equal(1, other.Revision)
+
+	revisions := history.List("bookinfo", kubernetes.VirtualServices, "reviews")
+	if assert.Len(revisions, 2) {
+		assert.Equal(3, revisions[0].Revision)
+		assert.Equal(2, revisions[1].Revision)
+	}
+
+	_, found := history.Get("bookinfo", kubernetes.VirtualServices, "reviews", 1)
+	assert.False(found)
+	revision, found := history.Get("bookinfo", kubernetes.VirtualServices, "reviews", 2)
+	assert.True(found)
+	assert.Equal(2, revision.Revision)
+
+	assert.Empty(history.List("bookinfo", kubernetes.DestinationRules, "reviews"))
+}
+
+func TestIstioConfigHistoryDropsLeastRecentlyChangedObject(t *testing.T) {
+	assert := assert.New(t)
+
+	history := NewIstioConfigHistory(2, 2)
+	for _, name := range []string{"reviews", "ratings", "reviews", "details"} {
+		history.Add(models.IstioConfigRevision{Namespace: "bookinfo", ObjectType: kubernetes.VirtualServices, Name: name, Operation: models.IstioConfigRevisionUpdate})
+	}
+
+	assert.Len(history.List("bookinfo", kubernetes.VirtualServices, "reviews"), 2)
+	assert.Len(history.List("bookinfo", kubernetes.VirtualServices, "details"), 1)
+	assert.Empty(history.List("bookinfo", kubernetes.VirtualServices, "ratings"))
+
+	// the revisions of a dropped object are numbered again
+	ratings := history.Add(models.IstioConfigRevision{Namespace: "bookinfo", ObjectType: kubernetes.VirtualServices, Name: "ratings", Operation: models.IstioConfigRevisionUpdate})
+	assert.Equal(1, ratings.Revision)
+	assert.Empty(history.List("bookinfo", kubernetes.VirtualServices, "reviews"))
+	assert.Len(history.List("bookinfo", kubernetes.VirtualServices, "details"), 1)
+}
+
+func TestUpdateIstioConfigDetailRecordsRevision(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	setupTestIstioConfigHistory(5)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.MockIstio(data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}))
+	configService := IstioConfigService{k8s: k8s}
+
+	_, err := configService.UpdateIstioConfigDetail("test", kubernetes.VirtualServices, "reviews", `{"spec":{"hosts":["reviews.test.svc.cluster.local"]}}`)
+	require.NoError(err)
+	require.NoError(configService.DeleteIstioConfigDetail("test", kubernetes.VirtualServices, "reviews"))
+
+	revisions := getIstioConfigHistory().List("test", kubernetes.VirtualServices, "reviews")
+	require.Len(revisions, 2)
+	assert.Equal(models.IstioConfigRevisionDelete, revisions[0].Operation)
+	assert.Equal(models.IstioConfigRevisionUpdate, revisions[1].Operation)
+
+	var updated, original map[string]interface{}
+	require.NoError(json.Unmarshal(revisions[0].Object, &updated))
+	require.NoError(json.Unmarshal(revisions[1].Object, &original))
+	assert.Equal([]interface{}{"reviews.test.svc.cluster.local"}, updated["spec"].(map[string]interface{})["hosts"])
+	assert.Equal([]interface{}{"reviews"}, original["spec"].(map[string]interface{})["hosts"])
+	assert.NotContains(original["metadata"], "resourceVersion")
+}
+
+func TestUpdateIstioConfigDetailWithoutHistory(t *testing.T) {
+	require := require.New(t)
+	setupTestIstioConfigHistory(0)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.MockIstio(data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}))
+	configService := IstioConfigService{k8s: k8s}
+
+	_, err := configService.UpdateIstioConfigDetail("test", kubernetes.VirtualServices, "reviews", "{}")
+	require.NoError(err)
+	require.Empty(getIstioConfigHistory().List("test", kubernetes.VirtualServices, "reviews"))
+}
+
+func TestReplacePatch(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	revision := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}))
+	revision.Labels = map[string]string{"app": "reviews"}
+	revisionObject, err := json.Marshal(revision)
+	require.NoError(err)
+
+	current := revision.DeepCopy()
+	current.ResourceVersion = "42"
+	current.Labels = map[string]string{"app": "reviews", "team": "b"}
+	current.Spec.Gateways = []string{"bookinfo-gateway"}
+	current.Spec.Http[0].Route[0].Weight = 50
+
+	jsonPatch, err := replacePatch(current, revisionObject)
+	require.NoError(err)
+
+	var patch map[string]interface{}
+	require.NoError(json.Unmarshal([]byte(jsonPatch), &patch))
+	assert.Equal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": nil,
+			"labels":      map[string]interface{}{"team": nil},
+		},
+		"spec": map[string]interface{}{
+			"gateways": nil,
+			"http": []interface{}{
+				map[string]interface{}{
+					"route": []interface{}{
+						map[string]interface{}{
+							"destination": map[string]interface{}{"host": "reviews", "subset": "v1"},
+							"weight":      float64(100),
+						},
+					},
+				},
+			},
+		},
+	}, patch)
+}
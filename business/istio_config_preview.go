@@ -0,0 +1,263 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+)
+
+// previewIgnoredMetadata are the metadata fields set by the API server, not relevant in an object diff
+var previewIgnoredMetadata = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"}
+
+// PreviewIstioConfigUpdate submits the patch of an Istio object with the server-side dry-run option, and returns
+// the changes on the object and the validation findings the update would introduce or resolve. Nothing is persisted.
+func (in *IstioConfigService) PreviewIstioConfigUpdate(ctx context.Context, namespace, resourceType, name, jsonPatch string) (models.IstioConfigPreview, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "PreviewIstioConfigUpdate",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("objectType", resourceType),
+		observability.Attribute("object", name),
+	)
+	defer end()
+
+	current, err := in.GetIstioConfigDetails(ctx, namespace, resourceType, name)
+	if err != nil {
+		return models.IstioConfigPreview{}, err
+	}
+	proposed, err := in.updateIstioConfigDetail(namespace, resourceType, name, jsonPatch, true)
+	if err != nil {
+		return models.IstioConfigPreview{}, err
+	}
+	return in.preview(ctx, &current, proposed)
+}
+
+// PreviewIstioConfigCreate submits the creation of an Istio object with the server-side dry-run option, and returns
+// the validation findings the creation would introduce or resolve. Nothing is persisted.
+func (in *IstioConfigService) PreviewIstioConfigCreate(ctx context.Context, namespace, resourceType string, body []byte) (models.IstioConfigPreview, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "PreviewIstioConfigCreate",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("objectType", resourceType),
+	)
+	defer end()
+
+	// Check if user has access to the namespace (RBAC) in cache scenarios and/or
+	// if namespace is accessible from Kiali (Deployment.AccessibleNamespaces)
+	if _, err := in.businessLayer.Namespace.GetNamespace(ctx, namespace); err != nil {
+		return models.IstioConfigPreview{}, err
+	}
+	proposed, err := in.createIstioConfigDetail(namespace, resourceType, body, true)
+	if err != nil {
+		return models.IstioConfigPreview{}, err
+	}
+	return in.preview(ctx, nil, proposed)
+}
+
+// preview compares the current object, nil on creations, with the object returned by the dry-run
+func (in *IstioConfigService) preview(ctx context.Context, current *models.IstioConfigDetails, proposed models.IstioConfigDetails) (models.IstioConfigPreview, error) {
+	proposedObject := istioObject(&proposed)
+	if proposedObject == nil {
+		return models.IstioConfigPreview{}, fmt.Errorf("object type not found: %v", proposed.ObjectType)
+	}
+
+	preview := models.IstioConfigPreview{Object: proposed, Diff: []models.ObjectChange{}}
+	if current != nil {
+		preview.Object.Permissions = current.Permissions
+		diff, err := diffIstioObjects(istioObject(current), proposedObject)
+		if err != nil {
+			return preview, err
+		}
+		preview.Diff = diff
+	}
+
+	before, after, err := in.businessLayer.Validations.GetIstioConfigChangeValidations(ctx, proposed.Namespace.Name, []models.IstioConfigDetails{proposed})
+	if err != nil {
+		return preview, err
+	}
+	preview.NewFindings, preview.ResolvedFindings = diffFindings(before, after)
+	preview.Validations = after.FilterByKey(models.ObjectTypeSingular[proposed.ObjectType], proposedObject.GetName())
+	return preview, nil
+}
+
+// istioObject returns the object held by the details, or nil if the details don't hold an object of their type
+func istioObject(details *models.IstioConfigDetails) meta_v1.Object {
+	switch {
+	case details.ObjectType == kubernetes.AuthorizationPolicies && details.AuthorizationPolicy != nil:
+		return details.AuthorizationPolicy
+	case details.ObjectType == kubernetes.DestinationRules && details.DestinationRule != nil:
+		return details.DestinationRule
+	case details.ObjectType == kubernetes.EnvoyFilters && details.EnvoyFilter != nil:
+		return details.EnvoyFilter
+	case details.ObjectType == kubernetes.Gateways && details.Gateway != nil:
+		return details.Gateway
+	case details.ObjectType == kubernetes.PeerAuthentications && details.PeerAuthentication != nil:
+		return details.PeerAuthentication
+	case details.ObjectType == kubernetes.RequestAuthentications && details.RequestAuthentication != nil:
+		return details.RequestAuthentication
+	case details.ObjectType == kubernetes.ServiceEntries && details.ServiceEntry != nil:
+		return details.ServiceEntry
+	case details.ObjectType == kubernetes.Sidecars && details.Sidecar != nil:
+		return details.Sidecar
+	case details.ObjectType == kubernetes.VirtualServices && details.VirtualService != nil:
+		return details.VirtualService
+	case details.ObjectType == kubernetes.WorkloadEntries && details.WorkloadEntry != nil:
+		return details.WorkloadEntry
+	case details.ObjectType == kubernetes.WorkloadGroups && details.WorkloadGroup != nil:
+		return details.WorkloadGroup
+	case details.ObjectType == kubernetes.K8sGateways && details.K8sGateway != nil:
+		return details.K8sGateway
+	case details.ObjectType == kubernetes.K8sHTTPRoutes && details.K8sHTTPRoute != nil:
+		return details.K8sHTTPRoute
+	case details.ObjectType == kubernetes.K8sTCPRoutes && details.K8sTCPRoute != nil:
+		return details.K8sTCPRoute
+	case details.ObjectType == kubernetes.K8sGRPCRoutes && details.K8sGRPCRoute != nil:
+		return details.K8sGRPCRoute
+	case details.ObjectType == kubernetes.Telemetries && details.Telemetry != nil:
+		return details.Telemetry
+	case details.ObjectType == kubernetes.WasmPlugins && details.WasmPlugin != nil:
+		return details.WasmPlugin
+	}
+	return nil
+}
+
+// diffIstioObjects returns the changes between the JSON representations of the objects. The type meta, the status
+// and the metadata fields set by the API server are ignored.
+func diffIstioObjects(from, to meta_v1.Object) ([]models.ObjectChange, error) {
+	fromJSON, err := toDiffableJSON(from)
+	if err != nil {
+		return nil, err
+	}
+	toJSON, err := toDiffableJSON(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []models.ObjectChange{}
+	diffJSON("", fromJSON, toJSON, &changes)
+	return changes, nil
+}
+
+func toDiffableJSON(object meta_v1.Object) (map[string]interface{}, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	delete(m, "apiVersion")
+	delete(m, "kind")
+	delete(m, "status")
+	if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+		for _, field := range previewIgnoredMetadata {
+			delete(metadata, field)
+		}
+	}
+	return m, nil
+}
+
+// diffJSON appends the changes between two unmarshalled JSON values, identified by JSON pointers from the path.
+// Objects are compared field by field and arrays item by item.
+func diffJSON(path string, from, to interface{}, changes *[]models.ObjectChange) {
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(fromValue)+len(toValue))
+			for k := range fromValue {
+				keys = append(keys, k)
+			}
+			for k := range toValue {
+				if _, found := fromValue[k]; !found {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+
+			for _, k := range keys {
+				fieldPath := path + "/" + escapeJSONPointer(k)
+				fromField, inFrom := fromValue[k]
+				toField, inTo := toValue[k]
+				switch {
+				case !inTo:
+					*changes = append(*changes, models.ObjectChange{Op: models.ObjectChangeRemove, Path: fieldPath, From: fromField})
+				case !inFrom:
+					*changes = append(*changes, models.ObjectChange{Op: models.ObjectChangeAdd, Path: fieldPath, To: toField})
+				default:
+					diffJSON(fieldPath, fromField, toField, changes)
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok {
+			for i := 0; i < len(fromValue) || i < len(toValue); i++ {
+				itemPath := path + "/" + strconv.Itoa(i)
+				switch {
+				case i >= len(toValue):
+					*changes = append(*changes, models.ObjectChange{Op: models.ObjectChangeRemove, Path: itemPath, From: fromValue[i]})
+				case i >= len(fromValue):
+					*changes = append(*changes, models.ObjectChange{Op: models.ObjectChangeAdd, Path: itemPath, To: toValue[i]})
+				default:
+					diffJSON(itemPath, fromValue[i], toValue[i], changes)
+				}
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, models.ObjectChange{Op: models.ObjectChangeReplace, Path: path, From: from, To: to})
+	}
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// diffFindings returns the findings present only in the after validations, and the ones present only in the before
+// validations
+func diffFindings(before, after models.IstioValidations) (newFindings, resolvedFindings []models.ValidationFinding) {
+	beforeFindings := validationFindings(before)
+	afterFindings := validationFindings(after)
+
+	newFindings = []models.ValidationFinding{}
+	for finding := range afterFindings {
+		if !beforeFindings[finding] {
+			newFindings = append(newFindings, finding)
+		}
+	}
+	resolvedFindings = []models.ValidationFinding{}
+	for finding := range beforeFindings {
+		if !afterFindings[finding] {
+			resolvedFindings = append(resolvedFindings, finding)
+		}
+	}
+
+	models.SortValidationFindings(newFindings)
+	models.SortValidationFindings(resolvedFindings)
+	return newFindings, resolvedFindings
+}
+
+func validationFindings(validations models.IstioValidations) map[models.ValidationFinding]bool {
+	findings := make(map[models.ValidationFinding]bool)
+	for key, validation := range validations {
+		for _, check := range validation.Checks {
+			findings[models.ValidationFinding{IstioValidationKey: key, IstioCheck: *check}] = true
+		}
+	}
+	return findings
+}
@@ -0,0 +1,119 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestDiffIstioObjects(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	current := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}))
+	current.ResourceVersion = "1"
+
+	proposed := current.DeepCopy()
+	proposed.ResourceVersion = "2"
+	proposed.Labels = map[string]string{"app": "reviews"}
+	proposed.Spec.Http[0].Route[0].Weight = 80
+	proposed.Spec.Http[0].Route = append(proposed.Spec.Http[0].Route, data.CreateHttpRouteDestination("reviews", "v2", 20))
+	proposed.Spec.Hosts = nil
+
+	diff, err := diffIstioObjects(current, proposed)
+	require.NoError(err)
+
+	assert.Equal([]models.ObjectChange{
+		{Op: models.ObjectChangeAdd, Path: "/metadata/labels", To: map[string]interface{}{"app": "reviews"}},
+		{Op: models.ObjectChangeRemove, Path: "/spec/hosts", From: []interface{}{"reviews"}},
+		{Op: models.ObjectChangeReplace, Path: "/spec/http/0/route/0/weight", From: float64(100), To: float64(80)},
+		{Op: models.ObjectChangeAdd, Path: "/spec/http/0/route/1", To: map[string]interface{}{
+			"destination": map[string]interface{}{"host": "reviews", "subset": "v2"},
+			"weight":      float64(20),
+		}},
+	}, diff)
+}
+
+func TestDiffIstioObjectsUnchanged(t *testing.T) {
+	current := data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})
+	proposed := current.DeepCopy()
+	proposed.Kind = kubernetes.VirtualServiceType
+	proposed.Generation = 2
+
+	diff, err := diffIstioObjects(current, proposed)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestDiffFindings(t *testing.T) {
+	assert := assert.New(t)
+
+	vsKey := models.BuildKey("virtualservice", "reviews", "bookinfo")
+	drKey := models.BuildKey("destinationrule", "reviews", "bookinfo")
+	weightCheck := models.Build("virtualservices.route.singleweight", "spec/http[0]/route")
+	subsetCheck := models.Build("virtualservices.nohost.hostnotfound", "spec/http[0]/route[0]/destination")
+	hostCheck := models.Build("destinationrules.nodest.matchingregistry", "spec/host")
+
+	before := models.IstioValidations{
+		vsKey: &models.IstioValidation{Name: "reviews", ObjectType: "virtualservice", Checks: []*models.IstioCheck{&weightCheck, &subsetCheck}},
+	}
+	after := models.IstioValidations{
+		vsKey: &models.IstioValidation{Name: "reviews", ObjectType: "virtualservice", Checks: []*models.IstioCheck{&subsetCheck}},
+		drKey: &models.IstioValidation{Name: "reviews", ObjectType: "destinationrule", Checks: []*models.IstioCheck{&hostCheck}},
+	}
+
+	newFindings, resolvedFindings := diffFindings(before, after)
+	assert.Equal([]models.ValidationFinding{{IstioValidationKey: drKey, IstioCheck: hostCheck}}, newFindings)
+	assert.Equal([]models.ValidationFinding{{IstioValidationKey: vsKey, IstioCheck: weightCheck}}, resolvedFindings)
+}
+
+func TestWithProposedObject(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	reviews := data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})
+	ratings := data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings"})
+	istioConfigList := models.IstioConfigList{VirtualServices: []networking_v1beta1.VirtualService{*reviews, *ratings}}
+
+	updated := reviews.DeepCopy()
+	updated.Spec.Hosts = []string{"reviews.bookinfo.svc.cluster.local"}
+	proposedList, _, _ := withProposedObject(istioConfigList, kubernetes.MTLSDetails{}, kubernetes.RBACDetails{}, models.IstioConfigDetails{ObjectType: kubernetes.VirtualServices, VirtualService: updated})
+
+	if assert.Len(proposedList.VirtualServices, 2) {
+		assert.Equal([]string{"reviews.bookinfo.svc.cluster.local"}, proposedList.VirtualServices[0].Spec.Hosts)
+		assert.Equal("ratings", proposedList.VirtualServices[1].Name)
+	}
+	// the current config is not modified
+	assert.Equal([]string{"reviews"}, istioConfigList.VirtualServices[0].Spec.Hosts)
+
+	created := data.CreateEmptyVirtualService("details", "bookinfo", []string{"details"})
+	proposedList, _, _ = withProposedObject(istioConfigList, kubernetes.MTLSDetails{}, kubernetes.RBACDetails{}, models.IstioConfigDetails{ObjectType: kubernetes.VirtualServices, VirtualService: created})
+	if assert.Len(proposedList.VirtualServices, 3) {
+		assert.Equal("details", proposedList.VirtualServices[2].Name)
+	}
+	assert.Len(istioConfigList.VirtualServices, 2)
+}
+
+func TestWithProposedDestinationRuleUpdatesMTLSDetails(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")
+	mtlsDetails := kubernetes.MTLSDetails{DestinationRules: []networking_v1beta1.DestinationRule{*dr}}
+	istioConfigList := models.IstioConfigList{DestinationRules: []networking_v1beta1.DestinationRule{*dr}}
+
+	updated := data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(), dr.DeepCopy())
+	proposedList, proposedMtls, _ := withProposedObject(istioConfigList, mtlsDetails, kubernetes.RBACDetails{}, models.IstioConfigDetails{ObjectType: kubernetes.DestinationRules, DestinationRule: updated})
+
+	assert.NotNil(proposedList.DestinationRules[0].Spec.TrafficPolicy)
+	assert.NotNil(proposedMtls.DestinationRules[0].Spec.TrafficPolicy)
+	assert.Nil(mtlsDetails.DestinationRules[0].Spec.TrafficPolicy)
+}
@@ -11,7 +11,10 @@ import (
 	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	auth_v1 "k8s.io/api/authorization/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
@@ -212,6 +215,50 @@ func TestGetIstioConfigDetails(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestGetIstioConfigListK8sGatewayAPI(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(true)
+	k8s.On("GetProject", mock.AnythingOfType("string")).Return(&osproject_v1.Project{}, nil)
+	k8s.MockIstio(
+		&k8s_networking_v1alpha2.Gateway{ObjectMeta: meta_v1.ObjectMeta{Name: "gw", Namespace: "test"}},
+		&k8s_networking_v1alpha2.HTTPRoute{ObjectMeta: meta_v1.ObjectMeta{Name: "route", Namespace: "test"}},
+		&k8s_networking_v1alpha2.TCPRoute{ObjectMeta: meta_v1.ObjectMeta{Name: "tcp-route", Namespace: "test"}},
+		&unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": kubernetes.K8sApiNetworkingVersionV1Alpha2,
+			"kind":       kubernetes.K8sActualGRPCRouteType,
+			"metadata":   map[string]interface{}{"name": "grpc-route", "namespace": "test"},
+		}},
+	)
+	k8s.On("GetSelfSubjectAccessReview", mock.Anything, "test", "gateway.networking.k8s.io", "httproutes", []string{"create", "patch", "delete"}).Return(fakeGetSelfSubjectAccessReview(), nil)
+	k8s.On("GetSelfSubjectAccessReview", mock.Anything, "test", "gateway.networking.k8s.io", "grpcroutes", []string{"create", "patch", "delete"}).Return(fakeGetSelfSubjectAccessReview(), nil)
+	configService := IstioConfigService{k8s: k8s, businessLayer: NewWithBackends(k8s, nil, nil)}
+
+	criteria := ParseIstioConfigCriteria("test", "k8sgateways,k8shttproutes,k8stcproutes,k8sgrpcroutes", "", "", false)
+	istioconfigList, err := configService.GetIstioConfigList(context.TODO(), criteria)
+	assert.Nil(err)
+	assert.Equal(1, len(istioconfigList.K8sGateways))
+	assert.Equal(1, len(istioconfigList.K8sHTTPRoutes))
+	assert.Equal(1, len(istioconfigList.K8sTCPRoutes))
+	assert.Equal(1, len(istioconfigList.K8sGRPCRoutes))
+	assert.Equal(0, len(istioconfigList.VirtualServices))
+
+	istioConfigDetails, err := configService.GetIstioConfigDetails(context.TODO(), "test", kubernetes.K8sHTTPRoutes, "route")
+	assert.Nil(err)
+	assert.Equal("route", istioConfigDetails.K8sHTTPRoute.Name)
+	assert.Equal("HTTPRoute", istioConfigDetails.K8sHTTPRoute.Kind)
+	assert.Equal("gateway.networking.k8s.io/v1alpha2", istioConfigDetails.K8sHTTPRoute.APIVersion)
+
+	istioConfigDetails, err = configService.GetIstioConfigDetails(context.TODO(), "test", kubernetes.K8sGRPCRoutes, "grpc-route")
+	assert.Nil(err)
+	assert.Equal("grpc-route", istioConfigDetails.K8sGRPCRoute.GetName())
+	assert.Equal("GRPCRoute", istioConfigDetails.K8sGRPCRoute.GetKind())
+	assert.Equal("gateway.networking.k8s.io/v1alpha2", istioConfigDetails.K8sGRPCRoute.GetAPIVersion())
+}
+
 func mockGetIstioConfigList() IstioConfigService {
 	k8s := new(kubetest.K8SClientMock)
 	k8s.On("IsOpenShift").Return(true)
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta "istio.io/client-go/pkg/apis/security/v1beta1"
 	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kiali/kiali/business/checkers"
 	"github.com/kiali/kiali/business/references"
@@ -17,6 +21,7 @@ import (
 	"github.com/kiali/kiali/models"
 	"github.com/kiali/kiali/observability"
 	"github.com/kiali/kiali/prometheus/internalmetrics"
+	"github.com/kiali/kiali/status"
 )
 
 type IstioValidationsService struct {
@@ -121,6 +126,7 @@ func (in *IstioValidationsService) GetValidations(ctx context.Context, namespace
 		validations.MergeValidations(workloadList.Validations)
 		validations = validations.FilterBySingleType("workload", workload)
 	}
+	suppressValidationChecks(validations, istioConfigList, mtlsDetails, rbacDetails)
 
 	return validations, nil
 }
@@ -128,7 +134,7 @@ func (in *IstioValidationsService) GetValidations(ctx context.Context, namespace
 func (in *IstioValidationsService) getAllObjectCheckers(istioConfigList models.IstioConfigList, workloadsPerNamespace map[string]models.WorkloadList, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails, namespaces []models.Namespace, registryServices []*kubernetes.RegistryService) []ObjectChecker {
 	return []ObjectChecker{
 		checkers.NoServiceChecker{Namespaces: namespaces, IstioConfigList: &istioConfigList, WorkloadsPerNamespace: workloadsPerNamespace, AuthorizationDetails: &rbacDetails, RegistryServices: registryServices},
-		checkers.VirtualServiceChecker{Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, DestinationRules: istioConfigList.DestinationRules},
+		checkers.VirtualServiceChecker{Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, DestinationRules: istioConfigList.DestinationRules, Sidecars: istioConfigList.Sidecars},
 		checkers.DestinationRulesChecker{Namespaces: namespaces, DestinationRules: istioConfigList.DestinationRules, MTLSDetails: mtlsDetails, ServiceEntries: istioConfigList.ServiceEntries},
 		checkers.GatewayChecker{Gateways: istioConfigList.Gateways, WorkloadsPerNamespace: workloadsPerNamespace, IsGatewayToNamespace: in.isGatewayToNamespace()},
 		checkers.PeerAuthenticationChecker{PeerAuthentications: mtlsDetails.PeerAuthentications, MTLSDetails: mtlsDetails, WorkloadsPerNamespace: workloadsPerNamespace},
@@ -137,6 +143,24 @@ func (in *IstioValidationsService) getAllObjectCheckers(istioConfigList models.I
 		checkers.SidecarChecker{Sidecars: istioConfigList.Sidecars, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace, ServiceEntries: istioConfigList.ServiceEntries, RegistryServices: registryServices},
 		checkers.RequestAuthenticationChecker{RequestAuthentications: istioConfigList.RequestAuthentications, WorkloadsPerNamespace: workloadsPerNamespace},
 		checkers.WorkloadChecker{AuthorizationPolicies: rbacDetails.AuthorizationPolicies, WorkloadsPerNamespace: workloadsPerNamespace},
+		checkers.K8sGatewayChecker{K8sGateways: istioConfigList.K8sGateways},
+		checkers.K8sHTTPRouteChecker{K8sHTTPRoutes: istioConfigList.K8sHTTPRoutes, K8sGateways: istioConfigList.K8sGateways, Namespaces: namespaces, RegistryServices: registryServices},
+		checkers.EnvoyFilterChecker{EnvoyFilters: istioConfigList.EnvoyFilters, Gateways: istioConfigList.Gateways, WorkloadsPerNamespace: workloadsPerNamespace, MeshVersion: meshVersion()},
+	}
+}
+
+// getAllReferenceCheckers returns the reference checkers of all the Istio object types with references
+func getAllReferenceCheckers(istioConfigList models.IstioConfigList, workloadsPerNamespace map[string]models.WorkloadList, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails, namespaces []models.Namespace, registryServices []*kubernetes.RegistryService) []ReferenceChecker {
+	return []ReferenceChecker{
+		references.GatewayReferences{Gateways: istioConfigList.Gateways, VirtualServices: istioConfigList.VirtualServices, WorkloadsPerNamespace: workloadsPerNamespace},
+		references.VirtualServiceReferences{Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, DestinationRules: istioConfigList.DestinationRules, AuthorizationPolicies: rbacDetails.AuthorizationPolicies},
+		references.DestinationRuleReferences{Namespaces: namespaces, DestinationRules: istioConfigList.DestinationRules, VirtualServices: istioConfigList.VirtualServices, WorkloadsPerNamespace: workloadsPerNamespace, ServiceEntries: istioConfigList.ServiceEntries, RegistryServices: registryServices},
+		references.ServiceEntryReferences{AuthorizationPolicies: rbacDetails.AuthorizationPolicies, Namespaces: namespaces, DestinationRules: istioConfigList.DestinationRules, ServiceEntries: istioConfigList.ServiceEntries, Sidecars: istioConfigList.Sidecars, RegistryServices: registryServices},
+		references.SidecarReferences{Sidecars: istioConfigList.Sidecars, Namespaces: namespaces, ServiceEntries: istioConfigList.ServiceEntries, RegistryServices: registryServices, WorkloadsPerNamespace: workloadsPerNamespace},
+		references.AuthorizationPolicyReferences{AuthorizationPolicies: rbacDetails.AuthorizationPolicies, Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, ServiceEntries: istioConfigList.ServiceEntries, RegistryServices: registryServices, WorkloadsPerNamespace: workloadsPerNamespace},
+		references.PeerAuthReferences{MTLSDetails: mtlsDetails, WorkloadsPerNamespace: workloadsPerNamespace},
+		references.TelemetryReferences{Telemetries: istioConfigList.Telemetries, WorkloadsPerNamespace: workloadsPerNamespace},
+		references.WasmPluginReferences{WasmPlugins: istioConfigList.WasmPlugins, WorkloadsPerNamespace: workloadsPerNamespace},
 	}
 }
 
@@ -192,7 +216,7 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 		}
 		referenceChecker = references.GatewayReferences{Gateways: istioConfigList.Gateways, VirtualServices: istioConfigList.VirtualServices, WorkloadsPerNamespace: workloadsPerNamespace}
 	case kubernetes.VirtualServices:
-		virtualServiceChecker := checkers.VirtualServiceChecker{Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, DestinationRules: istioConfigList.DestinationRules}
+		virtualServiceChecker := checkers.VirtualServiceChecker{Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, DestinationRules: istioConfigList.DestinationRules, Sidecars: istioConfigList.Sidecars}
 		objectCheckers = []ObjectChecker{noServiceChecker, virtualServiceChecker}
 		referenceChecker = references.VirtualServiceReferences{Namespace: namespace, Namespaces: namespaces, VirtualServices: istioConfigList.VirtualServices, DestinationRules: istioConfigList.DestinationRules, AuthorizationPolicies: rbacDetails.AuthorizationPolicies}
 	case kubernetes.DestinationRules:
@@ -228,7 +252,25 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 		requestAuthnChecker := checkers.RequestAuthenticationChecker{RequestAuthentications: istioConfigList.RequestAuthentications, WorkloadsPerNamespace: workloadsPerNamespace}
 		objectCheckers = []ObjectChecker{requestAuthnChecker}
 	case kubernetes.EnvoyFilters:
-		// Validation on EnvoyFilters are not yet in place
+		envoyFilterChecker := checkers.EnvoyFilterChecker{EnvoyFilters: istioConfigList.EnvoyFilters, Gateways: istioConfigList.Gateways, WorkloadsPerNamespace: workloadsPerNamespace, MeshVersion: meshVersion()}
+		objectCheckers = []ObjectChecker{envoyFilterChecker}
+	case kubernetes.K8sGateways:
+		objectCheckers = []ObjectChecker{
+			checkers.K8sGatewayChecker{K8sGateways: istioConfigList.K8sGateways},
+		}
+	case kubernetes.K8sHTTPRoutes:
+		k8sHTTPRouteChecker := checkers.K8sHTTPRouteChecker{K8sHTTPRoutes: istioConfigList.K8sHTTPRoutes, K8sGateways: istioConfigList.K8sGateways, Namespaces: namespaces, RegistryServices: registryServices}
+		objectCheckers = []ObjectChecker{k8sHTTPRouteChecker}
+	case kubernetes.K8sTCPRoutes:
+		// Validation on K8sTCPRoutes are not yet in place
+	case kubernetes.K8sGRPCRoutes:
+		// Validation on K8sGRPCRoutes are not yet in place
+	case kubernetes.Telemetries:
+		// Validation on Telemetries are not yet in place
+		referenceChecker = references.TelemetryReferences{Telemetries: istioConfigList.Telemetries, WorkloadsPerNamespace: workloadsPerNamespace}
+	case kubernetes.WasmPlugins:
+		// Validation on WasmPlugins are not yet in place
+		referenceChecker = references.WasmPluginReferences{WasmPlugins: istioConfigList.WasmPlugins, WorkloadsPerNamespace: workloadsPerNamespace}
 	default:
 		err = fmt.Errorf("object type not found: %v", objectType)
 	}
@@ -248,7 +290,189 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 		return models.IstioValidations{}, istioReferences, err
 	}
 
-	return runObjectCheckers(objectCheckers).FilterByKey(models.ObjectTypeSingular[objectType], object), istioReferences, nil
+	validations := runObjectCheckers(objectCheckers).FilterByKey(models.ObjectTypeSingular[objectType], object)
+	suppressValidationChecks(validations, istioConfigList, mtlsDetails, rbacDetails)
+	return validations, istioReferences, nil
+}
+
+// GetIstioConfigChangeValidations runs all the checkers on the Istio config of the namespace, all namespaces if empty,
+// as it is and as it would be with the proposed objects created or replacing the current ones. Both validations
+// include the findings of the cross-object checks, so the findings on the objects affected by the change are compared
+// too.
+func (in *IstioValidationsService) GetIstioConfigChangeValidations(ctx context.Context, namespace string, proposed []models.IstioConfigDetails) (before models.IstioValidations, after models.IstioValidations, err error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetIstioConfigChangeValidations",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("objects", len(proposed)),
+	)
+	defer end()
+
+	var istioConfigList models.IstioConfigList
+	var namespaces models.Namespaces
+	var workloadsPerNamespace map[string]models.WorkloadList
+	var mtlsDetails kubernetes.MTLSDetails
+	var rbacDetails kubernetes.RBACDetails
+	var registryServices []*kubernetes.RegistryService
+
+	wg := sync.WaitGroup{}
+	errChan := make(chan error, 1)
+
+	wg.Add(4)
+	go in.fetchIstioConfigList(ctx, &istioConfigList, &mtlsDetails, &rbacDetails, namespace, errChan, &wg)
+	go in.fetchAllWorkloads(ctx, &workloadsPerNamespace, &namespaces, errChan, &wg)
+	go in.fetchNonLocalmTLSConfigs(&mtlsDetails, errChan, &wg)
+	go in.fetchRegistryServices(&registryServices, errChan, &wg)
+	wg.Wait()
+
+	close(errChan)
+	for e := range errChan {
+		if e != nil { // Check that default value wasn't returned
+			return nil, nil, e
+		}
+	}
+
+	before = runObjectCheckers(in.getAllObjectCheckers(istioConfigList, workloadsPerNamespace, mtlsDetails, rbacDetails, namespaces, registryServices))
+	suppressValidationChecks(before, istioConfigList, mtlsDetails, rbacDetails)
+
+	proposedConfigList, proposedMtlsDetails, proposedRbacDetails := istioConfigList, mtlsDetails, rbacDetails
+	for _, p := range proposed {
+		proposedConfigList, proposedMtlsDetails, proposedRbacDetails = withProposedObject(proposedConfigList, proposedMtlsDetails, proposedRbacDetails, p)
+	}
+	after = runObjectCheckers(in.getAllObjectCheckers(proposedConfigList, workloadsPerNamespace, proposedMtlsDetails, proposedRbacDetails, namespaces, registryServices))
+	suppressValidationChecks(after, proposedConfigList, proposedMtlsDetails, proposedRbacDetails)
+
+	return before, after, nil
+}
+
+// withProposedObject returns copies of the Istio config where the proposed object replaces the object with the same
+// namespace and name, or is added if there is none. The given Istio config is not modified.
+func withProposedObject(istioConfigList models.IstioConfigList, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails, proposed models.IstioConfigDetails) (models.IstioConfigList, kubernetes.MTLSDetails, kubernetes.RBACDetails) {
+	switch {
+	case proposed.AuthorizationPolicy != nil:
+		o := *proposed.AuthorizationPolicy
+		rbacDetails.AuthorizationPolicies = append([]security_v1beta.AuthorizationPolicy{}, rbacDetails.AuthorizationPolicies...)
+		if i := indexOfObject(len(rbacDetails.AuthorizationPolicies), func(i int) meta_v1.Object { return &rbacDetails.AuthorizationPolicies[i] }, &o); i >= 0 {
+			rbacDetails.AuthorizationPolicies[i] = o
+		} else {
+			rbacDetails.AuthorizationPolicies = append(rbacDetails.AuthorizationPolicies, o)
+		}
+	case proposed.DestinationRule != nil:
+		o := *proposed.DestinationRule
+		istioConfigList.DestinationRules = append([]networking_v1beta1.DestinationRule{}, istioConfigList.DestinationRules...)
+		if i := indexOfObject(len(istioConfigList.DestinationRules), func(i int) meta_v1.Object { return &istioConfigList.DestinationRules[i] }, &o); i >= 0 {
+			istioConfigList.DestinationRules[i] = o
+		} else {
+			istioConfigList.DestinationRules = append(istioConfigList.DestinationRules, o)
+		}
+		mtlsDetails.DestinationRules = append([]networking_v1beta1.DestinationRule{}, mtlsDetails.DestinationRules...)
+		if i := indexOfObject(len(mtlsDetails.DestinationRules), func(i int) meta_v1.Object { return &mtlsDetails.DestinationRules[i] }, &o); i >= 0 {
+			mtlsDetails.DestinationRules[i] = o
+		} else {
+			mtlsDetails.DestinationRules = append(mtlsDetails.DestinationRules, o)
+		}
+	case proposed.Gateway != nil:
+		o := *proposed.Gateway
+		istioConfigList.Gateways = append([]networking_v1beta1.Gateway{}, istioConfigList.Gateways...)
+		if i := indexOfObject(len(istioConfigList.Gateways), func(i int) meta_v1.Object { return &istioConfigList.Gateways[i] }, &o); i >= 0 {
+			istioConfigList.Gateways[i] = o
+		} else {
+			istioConfigList.Gateways = append(istioConfigList.Gateways, o)
+		}
+	case proposed.PeerAuthentication != nil:
+		o := *proposed.PeerAuthentication
+		mtlsDetails.PeerAuthentications = append([]security_v1beta.PeerAuthentication{}, mtlsDetails.PeerAuthentications...)
+		if i := indexOfObject(len(mtlsDetails.PeerAuthentications), func(i int) meta_v1.Object { return &mtlsDetails.PeerAuthentications[i] }, &o); i >= 0 {
+			mtlsDetails.PeerAuthentications[i] = o
+		} else {
+			mtlsDetails.PeerAuthentications = append(mtlsDetails.PeerAuthentications, o)
+		}
+		if o.Namespace == config.Get().ExternalServices.Istio.RootNamespace {
+			mtlsDetails.MeshPeerAuthentications = append([]security_v1beta.PeerAuthentication{}, mtlsDetails.MeshPeerAuthentications...)
+			if i := indexOfObject(len(mtlsDetails.MeshPeerAuthentications), func(i int) meta_v1.Object { return &mtlsDetails.MeshPeerAuthentications[i] }, &o); i >= 0 {
+				mtlsDetails.MeshPeerAuthentications[i] = o
+			} else {
+				mtlsDetails.MeshPeerAuthentications = append(mtlsDetails.MeshPeerAuthentications, o)
+			}
+		}
+	case proposed.RequestAuthentication != nil:
+		o := *proposed.RequestAuthentication
+		istioConfigList.RequestAuthentications = append([]security_v1beta.RequestAuthentication{}, istioConfigList.RequestAuthentications...)
+		if i := indexOfObject(len(istioConfigList.RequestAuthentications), func(i int) meta_v1.Object { return &istioConfigList.RequestAuthentications[i] }, &o); i >= 0 {
+			istioConfigList.RequestAuthentications[i] = o
+		} else {
+			istioConfigList.RequestAuthentications = append(istioConfigList.RequestAuthentications, o)
+		}
+	case proposed.ServiceEntry != nil:
+		o := *proposed.ServiceEntry
+		istioConfigList.ServiceEntries = append([]networking_v1beta1.ServiceEntry{}, istioConfigList.ServiceEntries...)
+		if i := indexOfObject(len(istioConfigList.ServiceEntries), func(i int) meta_v1.Object { return &istioConfigList.ServiceEntries[i] }, &o); i >= 0 {
+			istioConfigList.ServiceEntries[i] = o
+		} else {
+			istioConfigList.ServiceEntries = append(istioConfigList.ServiceEntries, o)
+		}
+	case proposed.Sidecar != nil:
+		o := *proposed.Sidecar
+		istioConfigList.Sidecars = append([]networking_v1beta1.Sidecar{}, istioConfigList.Sidecars...)
+		if i := indexOfObject(len(istioConfigList.Sidecars), func(i int) meta_v1.Object { return &istioConfigList.Sidecars[i] }, &o); i >= 0 {
+			istioConfigList.Sidecars[i] = o
+		} else {
+			istioConfigList.Sidecars = append(istioConfigList.Sidecars, o)
+		}
+	case proposed.VirtualService != nil:
+		o := *proposed.VirtualService
+		istioConfigList.VirtualServices = append([]networking_v1beta1.VirtualService{}, istioConfigList.VirtualServices...)
+		if i := indexOfObject(len(istioConfigList.VirtualServices), func(i int) meta_v1.Object { return &istioConfigList.VirtualServices[i] }, &o); i >= 0 {
+			istioConfigList.VirtualServices[i] = o
+		} else {
+			istioConfigList.VirtualServices = append(istioConfigList.VirtualServices, o)
+		}
+	case proposed.WorkloadEntry != nil:
+		o := *proposed.WorkloadEntry
+		istioConfigList.WorkloadEntries = append([]networking_v1beta1.WorkloadEntry{}, istioConfigList.WorkloadEntries...)
+		if i := indexOfObject(len(istioConfigList.WorkloadEntries), func(i int) meta_v1.Object { return &istioConfigList.WorkloadEntries[i] }, &o); i >= 0 {
+			istioConfigList.WorkloadEntries[i] = o
+		} else {
+			istioConfigList.WorkloadEntries = append(istioConfigList.WorkloadEntries, o)
+		}
+	case proposed.EnvoyFilter != nil:
+		o := *proposed.EnvoyFilter
+		istioConfigList.EnvoyFilters = append([]networking_v1alpha3.EnvoyFilter{}, istioConfigList.EnvoyFilters...)
+		if i := indexOfObject(len(istioConfigList.EnvoyFilters), func(i int) meta_v1.Object { return &istioConfigList.EnvoyFilters[i] }, &o); i >= 0 {
+			istioConfigList.EnvoyFilters[i] = o
+		} else {
+			istioConfigList.EnvoyFilters = append(istioConfigList.EnvoyFilters, o)
+		}
+	case proposed.K8sGateway != nil:
+		o := *proposed.K8sGateway
+		istioConfigList.K8sGateways = append([]k8s_networking_v1alpha2.Gateway{}, istioConfigList.K8sGateways...)
+		if i := indexOfObject(len(istioConfigList.K8sGateways), func(i int) meta_v1.Object { return &istioConfigList.K8sGateways[i] }, &o); i >= 0 {
+			istioConfigList.K8sGateways[i] = o
+		} else {
+			istioConfigList.K8sGateways = append(istioConfigList.K8sGateways, o)
+		}
+	case proposed.K8sHTTPRoute != nil:
+		o := *proposed.K8sHTTPRoute
+		istioConfigList.K8sHTTPRoutes = append([]k8s_networking_v1alpha2.HTTPRoute{}, istioConfigList.K8sHTTPRoutes...)
+		if i := indexOfObject(len(istioConfigList.K8sHTTPRoutes), func(i int) meta_v1.Object { return &istioConfigList.K8sHTTPRoutes[i] }, &o); i >= 0 {
+			istioConfigList.K8sHTTPRoutes[i] = o
+		} else {
+			istioConfigList.K8sHTTPRoutes = append(istioConfigList.K8sHTTPRoutes, o)
+		}
+	}
+	// The other object types are not validated
+
+	return istioConfigList, mtlsDetails, rbacDetails
+}
+
+// indexOfObject returns the index of the object with the same namespace and name in a list of the given length, or -1
+func indexOfObject(length int, item func(i int) meta_v1.Object, object meta_v1.Object) int {
+	for i := 0; i < length; i++ {
+		if o := item(i); o.GetNamespace() == object.GetNamespace() && o.GetName() == object.GetName() {
+			return i
+		}
+	}
+	return -1
 }
 
 func runObjectCheckers(objectCheckers []ObjectChecker) models.IstioValidations {
@@ -264,6 +488,20 @@ func runObjectCheckers(objectCheckers []ObjectChecker) models.IstioValidations {
 	return objectTypeValidations
 }
 
+// suppressValidationChecks marks the checks accepted by the suppression rules of the configuration or of the
+// annotations of the validated Istio objects
+func suppressValidationChecks(validations models.IstioValidations, istioConfigList models.IstioConfigList, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails) {
+	// The security policies aren't part of the validated Istio config list
+	istioConfigList.AuthorizationPolicies = rbacDetails.AuthorizationPolicies
+	istioConfigList.PeerAuthentications = append(append([]security_v1beta.PeerAuthentication{}, mtlsDetails.PeerAuthentications...), mtlsDetails.MeshPeerAuthentications...)
+
+	objects := map[models.IstioValidationKey]meta_v1.Object{}
+	for _, o := range istioConfigListObjects(istioConfigList) {
+		objects[models.BuildKey(models.ObjectTypeSingular[o.resourceType], o.object.GetName(), o.object.GetNamespace())] = o.object
+	}
+	validations.SuppressChecks(objects, time.Now())
+}
+
 func runObjectChecker(objectChecker ObjectChecker) models.IstioValidations {
 	// tracking the time it takes to execute the Check
 	promtimer := internalmetrics.GetCheckerProcessingTimePrometheusTimer(fmt.Sprintf("%T", objectChecker))
@@ -362,6 +600,11 @@ func (in *IstioValidationsService) fetchIstioConfigList(ctx context.Context, rVa
 		IncludeWorkloadEntries:        true,
 		IncludeAuthorizationPolicies:  true,
 		IncludePeerAuthentications:    true,
+		IncludeK8sGateways:            true,
+		IncludeK8sHTTPRoutes:          true,
+		IncludeTelemetries:            true,
+		IncludeWasmPlugins:            true,
+		IncludeEnvoyFilters:           true,
 	}
 	istioConfigList, err := in.businessLayer.IstioConfig.GetIstioConfigList(ctx, criteria)
 	if err != nil {
@@ -393,6 +636,17 @@ func (in *IstioValidationsService) fetchIstioConfigList(ctx context.Context, rVa
 	// All WorkloadEntries
 	rValue.WorkloadEntries = append(rValue.WorkloadEntries, istioConfigList.WorkloadEntries...)
 
+	// All K8s Gateways and HTTPRoutes
+	rValue.K8sGateways = append(rValue.K8sGateways, istioConfigList.K8sGateways...)
+	rValue.K8sHTTPRoutes = append(rValue.K8sHTTPRoutes, istioConfigList.K8sHTTPRoutes...)
+
+	// All Telemetries and WasmPlugins
+	rValue.Telemetries = append(rValue.Telemetries, istioConfigList.Telemetries...)
+	rValue.WasmPlugins = append(rValue.WasmPlugins, istioConfigList.WasmPlugins...)
+
+	// All EnvoyFilters
+	rValue.EnvoyFilters = append(rValue.EnvoyFilters, istioConfigList.EnvoyFilters...)
+
 	in.filterPeerAuths(namespace, mtlsDetails, istioConfigList.PeerAuthentications)
 
 	in.filterAuthPolicies(namespace, rbacDetails, istioConfigList.AuthorizationPolicies)
@@ -522,6 +776,12 @@ func (in *IstioValidationsService) fetchRegistryServices(rValue *[]*kubernetes.R
 	}
 }
 
+// meshVersion returns the Istio version of the mesh, or "" when it hasn't been discovered yet
+func meshVersion() string {
+	version, _ := status.GetStatus(status.MeshVersion)
+	return version
+}
+
 func (in *IstioValidationsService) isGatewayToNamespace() bool {
 	gatewayToNamespace := false
 	if in.businessLayer != nil {
@@ -346,3 +346,35 @@ func yamlFixtureLoaderFor(file string) *validations.YamlFixtureLoader {
 	path := fmt.Sprintf("../tests/data/validations/exportto/cns/%s", file)
 	return &validations.YamlFixtureLoader{Filename: path}
 }
+
+func TestSuppressValidationChecksFromAnnotations(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.CreateVirtualService()
+	vs.Annotations = map[string]string{models.ValidationSuppressionAnnotation: `[{"codes": ["KIA1101"], "reason": "Known issue"}]`}
+	ap := security_v1beta.AuthorizationPolicy{}
+	ap.Name, ap.Namespace = "allow-all", "bookinfo"
+	ap.Annotations = map[string]string{models.ValidationSuppressionAnnotation: `[{"codes": ["KIA1101"], "reason": "Accepted"}]`}
+
+	check := models.Build("virtualservices.nohost.hostnotfound", "spec/http[0]/route[0]/destination/host")
+	vsKey := models.BuildKey("virtualservice", vs.Name, vs.Namespace)
+	apKey := models.BuildKey("authorizationpolicy", ap.Name, ap.Namespace)
+	vals := models.IstioValidations{
+		vsKey: &models.IstioValidation{Name: vs.Name, ObjectType: "virtualservice", Checks: []*models.IstioCheck{&check}},
+		apKey: &models.IstioValidation{Name: ap.Name, ObjectType: "authorizationpolicy", Checks: []*models.IstioCheck{&check}},
+	}
+
+	suppressValidationChecks(vals,
+		models.IstioConfigList{VirtualServices: []networking_v1beta1.VirtualService{*vs}},
+		kubernetes.MTLSDetails{},
+		kubernetes.RBACDetails{AuthorizationPolicies: []security_v1beta.AuthorizationPolicy{ap}})
+
+	assert.True(vals[vsKey].Valid)
+	assert.True(vals[vsKey].Checks[0].Suppressed)
+	assert.Equal("Known issue", vals[vsKey].Checks[0].SuppressionReason)
+	assert.True(vals[apKey].Checks[0].Suppressed)
+	assert.Equal("Accepted", vals[apKey].Checks[0].SuppressionReason)
+	// Checks are copied on suppression
+	assert.False(check.Suppressed)
+}
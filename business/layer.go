@@ -29,6 +29,7 @@ type Layer struct {
 	ProxyLogging   ProxyLoggingService
 	ProxyStatus    ProxyStatusService
 	RegistryStatus RegistryStatusService
+	SLO            SLOService
 	Svc            SvcService
 	TLS            TLSService
 	TokenReview    TokenReviewService
@@ -154,6 +155,7 @@ func NewWithBackends(k8s kubernetes.ClientInterface, prom prometheus.ClientInter
 	// Out of order because it relies on ProxyStatus
 	temporaryLayer.ProxyLogging = ProxyLoggingService{k8s: k8s, proxyStatus: &temporaryLayer.ProxyStatus}
 	temporaryLayer.RegistryStatus = RegistryStatusService{k8s: k8s, businessLayer: temporaryLayer}
+	temporaryLayer.SLO = SLOService{prom: prom, k8s: k8s, businessLayer: temporaryLayer}
 	temporaryLayer.Svc = SvcService{prom: prom, k8s: k8s, businessLayer: temporaryLayer}
 	temporaryLayer.TLS = TLSService{k8s: k8s, businessLayer: temporaryLayer}
 	temporaryLayer.TokenReview = NewTokenReview(k8s)
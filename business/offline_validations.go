@@ -0,0 +1,429 @@
+package business
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	security_v1beta "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/business/checkers"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// offlineManifests are the objects of a set of manifests, as they would be fetched from a cluster
+type offlineManifests struct {
+	deployments     []apps_v1.Deployment
+	istioConfigList models.IstioConfigList
+	namespaces      map[string]*models.Namespace
+	services        []core_v1.Service
+	// objects and sources are the validated objects and their manifest file, by key
+	objects map[models.IstioValidationKey]meta_v1.Object
+	sources map[models.IstioValidationKey]string
+}
+
+// ValidateManifestsDir runs all the checkers on the Services, Deployments, Namespaces and Istio objects of the YAML and
+// JSON manifests of a directory and its subdirectories, without cluster. The other objects are ignored. The objects
+// without namespace are set in the default namespace.
+func ValidateManifestsDir(dir, defaultNamespace string) (models.OfflineValidationReport, error) {
+	objects := []*manifestObject{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !isManifestFile(path) {
+			return nil
+		}
+		manifest, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		source, err := filepath.Rel(dir, path)
+		if err != nil {
+			source = path
+		}
+		fileObjects, err := parseManifest(manifest, filepath.ToSlash(source), defaultNamespace)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, fileObjects...)
+		return nil
+	})
+	if err != nil {
+		return models.OfflineValidationReport{}, err
+	}
+	return validateManifestObjects(objects)
+}
+
+// ValidateManifests runs all the checkers on the Services, Deployments, Namespaces and Istio objects of a bundle of
+// manifests, as accepted by ImportIstioConfig, without cluster. The other objects are ignored.
+func ValidateManifests(bundle []byte, defaultNamespace string) (models.OfflineValidationReport, error) {
+	objects, err := parseManifestBundle(bundle, defaultNamespace)
+	if err != nil {
+		return models.OfflineValidationReport{}, err
+	}
+	return validateManifestObjects(objects)
+}
+
+func validateManifestObjects(objects []*manifestObject) (models.OfflineValidationReport, error) {
+	manifests, err := newOfflineManifests(objects)
+	if err != nil {
+		return models.OfflineValidationReport{}, err
+	}
+
+	namespaces := manifests.namespaceList()
+	workloadsPerNamespace := manifests.workloadsPerNamespace(namespaces)
+	registryServices, err := manifests.registryServices()
+	if err != nil {
+		return models.OfflineValidationReport{}, err
+	}
+
+	istioConfigList := manifests.istioConfigList
+	rootNamespace := config.Get().ExternalServices.Istio.RootNamespace
+	// Without the mesh config, the auto mTLS is assumed enabled as it is by default
+	mtlsDetails := kubernetes.MTLSDetails{
+		DestinationRules:    istioConfigList.DestinationRules,
+		PeerAuthentications: istioConfigList.PeerAuthentications,
+		EnabledAutoMtls:     true,
+	}
+	for _, pa := range istioConfigList.PeerAuthentications {
+		if pa.Namespace == rootNamespace {
+			mtlsDetails.MeshPeerAuthentications = append(mtlsDetails.MeshPeerAuthentications, pa)
+		}
+	}
+	rbacDetails := kubernetes.RBACDetails{AuthorizationPolicies: istioConfigList.AuthorizationPolicies}
+
+	// The gateway to namespace setting of istiod isn't known without cluster, the validation service without
+	// business layer uses the default
+	objectCheckers := (&IstioValidationsService{}).getAllObjectCheckers(istioConfigList, workloadsPerNamespace, mtlsDetails, rbacDetails, namespaces, registryServices)
+	for _, ns := range namespaces {
+		objectCheckers = append(objectCheckers, checkers.ServiceChecker{
+			Services:    filterServicesByNamespace(manifests.services, ns.Name),
+			Deployments: filterDeploymentsByNamespace(manifests.deployments, ns.Name),
+		})
+	}
+	validations := runObjectCheckers(objectCheckers)
+	validations.SuppressChecks(manifests.objects, time.Now())
+
+	istioReferences := models.IstioReferencesMap{}
+	for _, referenceChecker := range getAllReferenceCheckers(istioConfigList, workloadsPerNamespace, mtlsDetails, rbacDetails, namespaces, registryServices) {
+		istioReferences.MergeReferencesMap(runObjectReferenceChecker(referenceChecker))
+	}
+
+	return models.NewOfflineValidationReport(manifests.sources, validations, istioReferences), nil
+}
+
+// newOfflineManifests sorts the objects by type. The objects of the other types are ignored.
+func newOfflineManifests(objects []*manifestObject) (*offlineManifests, error) {
+	manifests := &offlineManifests{
+		namespaces: map[string]*models.Namespace{},
+		objects:    map[models.IstioValidationKey]meta_v1.Object{},
+		sources:    map[models.IstioValidationKey]string{},
+	}
+	for _, o := range objects {
+		invalid := func(err error) error {
+			return api_errors.NewBadRequest(fmt.Sprintf("Invalid document %d of %s: %v", o.document, o.source, err))
+		}
+
+		if o.kind == "Namespace" && o.apiVersion == "v1" {
+			var namespace core_v1.Namespace
+			if err := json.Unmarshal(o.body, &namespace); err != nil {
+				return nil, invalid(err)
+			}
+			ns := models.CastNamespace(namespace)
+			manifests.namespaces[ns.Name] = &ns
+			continue
+		}
+
+		if o.namespace == "" {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Document %d of %s: object [%s] without namespace", o.document, o.source, o.name))
+		}
+		if _, found := manifests.namespaces[o.namespace]; !found {
+			manifests.namespaces[o.namespace] = &models.Namespace{Name: o.namespace, Labels: map[string]string{}, Annotations: map[string]string{}}
+		}
+
+		var key models.IstioValidationKey
+		var object meta_v1.Object
+		if resourceType, found := istioResourceType(o.apiVersion, o.kind); found {
+			istioObject, err := addIstioObject(&manifests.istioConfigList, resourceType, o.body)
+			if err != nil {
+				return nil, invalid(err)
+			}
+			key, object = models.BuildKey(models.ObjectTypeSingular[resourceType], o.name, o.namespace), istioObject
+		} else if o.kind == "Service" && o.apiVersion == "v1" {
+			var service core_v1.Service
+			if err := json.Unmarshal(o.body, &service); err != nil {
+				return nil, invalid(err)
+			}
+			manifests.services = append(manifests.services, service)
+			key, object = models.BuildKey(checkers.ServiceCheckerType, o.name, o.namespace), &service
+		} else if o.kind == "Deployment" && o.apiVersion == "apps/v1" {
+			var deployment apps_v1.Deployment
+			if err := json.Unmarshal(o.body, &deployment); err != nil {
+				return nil, invalid(err)
+			}
+			manifests.deployments = append(manifests.deployments, deployment)
+			key, object = models.BuildKey(checkers.WorkloadCheckerType, o.name, o.namespace), &meta_v1.ObjectMeta{Name: o.name, Namespace: o.namespace, Labels: deployment.Spec.Template.Labels}
+		} else {
+			log.Debugf("Ignoring %s [%s] of document %d of %s: unsupported object type [%s]", o.kind, o.name, o.document, o.source, o.apiVersion)
+			continue
+		}
+
+		if _, found := manifests.sources[key]; found {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("Document %d of %s: more than one %s [%s] in namespace [%s]", o.document, o.source, key.ObjectType, o.name, o.namespace))
+		}
+		manifests.objects[key] = object
+		manifests.sources[key] = o.source
+	}
+	return manifests, nil
+}
+
+// namespaceList returns the namespaces of the manifests and of their objects, sorted by name
+func (m *offlineManifests) namespaceList() models.Namespaces {
+	namespaces := models.Namespaces{}
+	for _, ns := range m.namespaces {
+		namespaces = append(namespaces, *ns)
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+	return namespaces
+}
+
+// workloadsPerNamespace returns the workloads of the Deployments. Without pods, the workloads are assumed to have a
+// sidecar unless the injection is disabled, and to run with the service account of their pod template.
+func (m *offlineManifests) workloadsPerNamespace(namespaces models.Namespaces) map[string]models.WorkloadList {
+	workloadsPerNamespace := map[string]models.WorkloadList{}
+	for _, ns := range namespaces {
+		workloadsPerNamespace[ns.Name] = models.WorkloadList{Namespace: ns, Workloads: []models.WorkloadListItem{}, Validations: models.IstioValidations{}}
+	}
+	for i := range m.deployments {
+		d := &m.deployments[i]
+		w := &models.Workload{}
+		w.ParseDeployment(d)
+		item := models.WorkloadListItem{}
+		item.ParseWorkload(w)
+		item.IstioSidecar = w.IstioInjectionAnnotation == nil || *w.IstioInjectionAnnotation
+		serviceAccount := d.Spec.Template.Spec.ServiceAccountName
+		if serviceAccount == "" {
+			serviceAccount = "default"
+		}
+		item.ServiceAccountNames = []string{serviceAccount}
+
+		workloadList := workloadsPerNamespace[d.Namespace]
+		workloadList.Workloads = append(workloadList.Workloads, item)
+		workloadsPerNamespace[d.Namespace] = workloadList
+	}
+	return workloadsPerNamespace
+}
+
+// registryServices returns the services of the Istio registry for the Services, as istiod would expose them in its
+// registryz debug endpoint
+func (m *offlineManifests) registryServices() ([]*kubernetes.RegistryService, error) {
+	domain := config.Get().ExternalServices.Istio.IstioIdentityDomain
+	registry := []map[string]interface{}{}
+	for _, s := range m.services {
+		ports := []map[string]interface{}{}
+		for _, p := range s.Spec.Ports {
+			ports = append(ports, map[string]interface{}{"name": p.Name, "port": p.Port, "protocol": registryProtocol(p)})
+		}
+		attributes := map[string]interface{}{
+			"ServiceRegistry": "Kubernetes",
+			"Name":            s.Name,
+			"Namespace":       s.Namespace,
+			"Labels":          s.Labels,
+			"LabelSelectors":  s.Spec.Selector,
+		}
+		if exportTo, found := s.Annotations["networking.istio.io/exportTo"]; found {
+			exportToMap := map[string]bool{}
+			for _, ns := range strings.Split(exportTo, ",") {
+				exportToMap[strings.TrimSpace(ns)] = true
+			}
+			attributes["ExportTo"] = exportToMap
+		}
+		registry = append(registry, map[string]interface{}{
+			"Attributes": attributes,
+			"ports":      ports,
+			"hostname":   fmt.Sprintf("%s.%s.%s", s.Name, s.Namespace, domain),
+		})
+	}
+	registryz, err := json.Marshal(registry)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.ParseRegistryServices(map[string][]byte{"offline": registryz})
+}
+
+// registryProtocol returns the protocol selected by Istio for a port: the app protocol, or the prefix of the port
+// name, or the transport protocol
+func registryProtocol(port core_v1.ServicePort) string {
+	if kubernetes.MatchPortAppProtocolWithValidProtocols(port.AppProtocol) {
+		return strings.ToUpper(*port.AppProtocol)
+	}
+	if kubernetes.MatchPortNameWithValidProtocols(port.Name) {
+		return strings.ToUpper(strings.Split(port.Name, "-")[0])
+	}
+	return string(port.Protocol)
+}
+
+// addIstioObject unmarshals an object of the given Istio resource type and adds it to the list
+func addIstioObject(list *models.IstioConfigList, resourceType string, body []byte) (meta_v1.Object, error) {
+	switch resourceType {
+	case kubernetes.AuthorizationPolicies:
+		o := security_v1beta.AuthorizationPolicy{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.AuthorizationPolicies = append(list.AuthorizationPolicies, o)
+		return &o, nil
+	case kubernetes.DestinationRules:
+		o := networking_v1beta1.DestinationRule{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.DestinationRules = append(list.DestinationRules, o)
+		return &o, nil
+	case kubernetes.EnvoyFilters:
+		o := networking_v1alpha3.EnvoyFilter{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.EnvoyFilters = append(list.EnvoyFilters, o)
+		return &o, nil
+	case kubernetes.Gateways:
+		o := networking_v1beta1.Gateway{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.Gateways = append(list.Gateways, o)
+		return &o, nil
+	case kubernetes.K8sGateways:
+		o := k8s_networking_v1alpha2.Gateway{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.K8sGateways = append(list.K8sGateways, o)
+		return &o, nil
+	case kubernetes.K8sHTTPRoutes:
+		o := k8s_networking_v1alpha2.HTTPRoute{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.K8sHTTPRoutes = append(list.K8sHTTPRoutes, o)
+		return &o, nil
+	case kubernetes.K8sTCPRoutes:
+		o := k8s_networking_v1alpha2.TCPRoute{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.K8sTCPRoutes = append(list.K8sTCPRoutes, o)
+		return &o, nil
+	case kubernetes.K8sGRPCRoutes:
+		o := unstructured.Unstructured{}
+		if err := o.UnmarshalJSON(body); err != nil {
+			return nil, err
+		}
+		list.K8sGRPCRoutes = append(list.K8sGRPCRoutes, o)
+		return &o, nil
+	case kubernetes.PeerAuthentications:
+		o := security_v1beta.PeerAuthentication{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.PeerAuthentications = append(list.PeerAuthentications, o)
+		return &o, nil
+	case kubernetes.RequestAuthentications:
+		o := security_v1beta.RequestAuthentication{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.RequestAuthentications = append(list.RequestAuthentications, o)
+		return &o, nil
+	case kubernetes.ServiceEntries:
+		o := networking_v1beta1.ServiceEntry{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.ServiceEntries = append(list.ServiceEntries, o)
+		return &o, nil
+	case kubernetes.Sidecars:
+		o := networking_v1beta1.Sidecar{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.Sidecars = append(list.Sidecars, o)
+		return &o, nil
+	case kubernetes.Telemetries:
+		o := telemetry_v1alpha1.Telemetry{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.Telemetries = append(list.Telemetries, o)
+		return &o, nil
+	case kubernetes.VirtualServices:
+		o := networking_v1beta1.VirtualService{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.VirtualServices = append(list.VirtualServices, o)
+		return &o, nil
+	case kubernetes.WasmPlugins:
+		o := extensions_v1alpha1.WasmPlugin{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.WasmPlugins = append(list.WasmPlugins, o)
+		return &o, nil
+	case kubernetes.WorkloadEntries:
+		o := networking_v1beta1.WorkloadEntry{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.WorkloadEntries = append(list.WorkloadEntries, o)
+		return &o, nil
+	case kubernetes.WorkloadGroups:
+		o := networking_v1beta1.WorkloadGroup{}
+		if err := json.Unmarshal(body, &o); err != nil {
+			return nil, err
+		}
+		list.WorkloadGroups = append(list.WorkloadGroups, o)
+		return &o, nil
+	}
+	return nil, fmt.Errorf("object type not found: %v", resourceType)
+}
+
+func filterServicesByNamespace(services []core_v1.Service, namespace string) []core_v1.Service {
+	filtered := []core_v1.Service{}
+	for _, s := range services {
+		if s.Namespace == namespace {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+func filterDeploymentsByNamespace(deployments []apps_v1.Deployment, namespace string) []apps_v1.Deployment {
+	filtered := []apps_v1.Deployment{}
+	for _, d := range deployments {
+		if d.Namespace == namespace {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
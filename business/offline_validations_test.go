@@ -0,0 +1,92 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+func TestValidateManifestsDir(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	report, err := ValidateManifestsDir("../tests/data/offline", "bookinfo")
+	require.NoError(err)
+
+	// The ConfigMap is ignored
+	assert.Equal(5, report.Summary.ObjectCount)
+	assert.Equal(1, report.Summary.Errors)
+	assert.Equal(2, report.Summary.Warnings)
+
+	require.Len(report.Objects, 5)
+	dr := report.Objects[0]
+	assert.Equal(models.BuildKey("destinationrule", "reviews", "bookinfo"), dr.IstioValidationKey)
+	assert.Equal("bookinfo/routing.yaml", dr.Source)
+	assert.True(dr.Valid)
+	require.NotNil(dr.References)
+	assert.Equal([]models.WorkloadReference{{Name: "reviews-v1", Namespace: "bookinfo"}}, dr.References.WorkloadReferences)
+
+	assert.Equal(models.BuildKey("service", "reviews", "bookinfo"), report.Objects[1].IstioValidationKey)
+	assert.Equal("bookinfo/reviews.yaml", report.Objects[1].Source)
+	assert.False(report.Objects[2].Valid)
+	assert.Equal(models.BuildKey("workload", "reviews-v1", "bookinfo"), report.Objects[4].IstioValidationKey)
+
+	require.Len(report.Findings, 3)
+	assert.Equal(models.BuildKey("virtualservice", "ratings", "bookinfo"), report.Findings[0].IstioValidationKey)
+	assert.Equal("KIA1101", report.Findings[0].Code)
+	assert.Equal(models.BuildKey("virtualservice", "reviews", "bookinfo"), report.Findings[1].IstioValidationKey)
+	assert.Equal("KIA1107", report.Findings[1].Code)
+	assert.Equal("KIA1201", report.Findings[2].Code)
+}
+
+func TestValidateManifests(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.KialiFeatureFlags.Validations.Suppressions = []config.ValidationSuppression{
+		{Codes: []string{"KIA1101"}, Reason: "Service deployed later"},
+	}
+	config.Set(conf)
+
+	manifest := `apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: reviews
+spec:
+  ports:
+  - name: http
+    port: 9080
+`
+	report, err := ValidateManifests([]byte(manifest), "bookinfo")
+	require.NoError(err)
+	assert.Equal(2, report.Summary.ObjectCount)
+	assert.Equal(0, report.Summary.Errors)
+	assert.Equal(1, report.Summary.Suppressed)
+	require.Len(report.Findings, 1)
+	assert.True(report.Findings[0].Suppressed)
+
+	_, err = ValidateManifests([]byte(bundleManifest), "")
+	require.Error(err)
+	assert.Contains(err.Error(), "without namespace")
+
+	_, err = ValidateManifests([]byte(bundleManifest+"---\n"+bundleManifest), "bookinfo")
+	require.Error(err)
+	assert.Contains(err.Error(), "more than one virtualservice [reviews] in namespace [bookinfo]")
+}
@@ -0,0 +1,146 @@
+package business
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitishm/engarde/pkg/parser"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/log"
+)
+
+// followLogsBuffer is the number of lines the readers of the followed containers can get ahead of the consumer.
+// Once the buffer is full the readers stop reading, so the Kubernetes API stops sending the logs of a slow consumer.
+const followLogsBuffer = 64
+
+// FollowLogOptions holds the options of a pod logs follow stream
+type FollowLogOptions struct {
+	// Containers are the followed containers, all the containers of the pod if empty
+	Containers []string
+	// After skips the lines logged at or before this time, used to resume a stream. The Kubernetes API only supports
+	// a precision of seconds.
+	After *time.Time
+	// TailLines is the number of past lines of each container sent before the new ones, when not resuming a stream
+	TailLines *int64
+}
+
+// FollowedLogEntry is a log line of one of the followed containers
+type FollowedLogEntry struct {
+	LogEntry
+	Container string `json:"container"`
+	// Time is the Kubernetes timestamp of the line, with nanoseconds. Resumed streams start after it.
+	Time time.Time `json:"-"`
+}
+
+// FollowPodLogs follows the logs of containers of a pod. The lines of all the containers are sent to the returned
+// channel as they are logged, parsed like the pod logs, the access logs of the Istio proxy included. The channel is
+// closed when all the streams end, or once the context is done.
+func (in *WorkloadService) FollowPodLogs(ctx context.Context, namespace, name string, opts FollowLogOptions) (<-chan FollowedLogEntry, error) {
+	containers := opts.Containers
+	if len(containers) == 0 {
+		pod, err := in.k8s.GetPod(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range pod.Spec.Containers {
+			containers = append(containers, c.Name)
+		}
+	}
+
+	// Open all the streams first, so a missing container fails the request
+	readers := make([]io.ReadCloser, 0, len(containers))
+	for _, container := range containers {
+		k8sOpts := core_v1.PodLogOptions{Container: container, Follow: true, Timestamps: true}
+		if opts.After != nil {
+			k8sOpts.SinceTime = &meta_v1.Time{Time: *opts.After}
+		} else if opts.TailLines != nil {
+			k8sOpts.TailLines = opts.TailLines
+		}
+		reader, err := in.k8s.StreamPodLogs(namespace, name, &k8sOpts)
+		if err != nil {
+			for _, r := range readers {
+				closeLogsReader(r)
+			}
+			return nil, fmt.Errorf("Logs of container [%s] of pod [%s] could not be followed: %v", container, name, err)
+		}
+		readers = append(readers, reader)
+	}
+
+	entries := make(chan FollowedLogEntry, followLogsBuffer)
+	wg := sync.WaitGroup{}
+	for i, reader := range readers {
+		wg.Add(1)
+		go func(container string, reader io.ReadCloser) {
+			defer wg.Done()
+			followContainerLogs(ctx, container, reader, opts.After, entries)
+		}(containers[i], reader)
+	}
+	go func() {
+		wg.Wait()
+		close(entries)
+	}()
+
+	// The follow streams only end when the pod ends, closing the readers unblocks the readers waiting for new lines
+	go func() {
+		<-ctx.Done()
+		for _, r := range readers {
+			closeLogsReader(r)
+		}
+	}()
+
+	return entries, nil
+}
+
+// followContainerLogs parses the lines of a container logs stream and sends them until the stream ends or the
+// context is done
+func followContainerLogs(ctx context.Context, container string, reader io.Reader, after *time.Time, entries chan<- FollowedLogEntry) {
+	isProxy := container == proxyContainerName
+	// the parser is not safe for concurrent use, each container has its own
+	engardeParser := parser.New(parser.IstioProxyAccessLogsPattern)
+
+	bufferedReader := bufio.NewReader(reader)
+	for {
+		line, err := bufferedReader.ReadString('\n')
+		if len(line) > 0 {
+			if entry, ok := parseFollowedLogLine(line, container, isProxy, engardeParser); ok && (after == nil || entry.Time.After(*after)) {
+				select {
+				case entries <- entry:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		if err != nil {
+			if err != io.EOF && ctx.Err() == nil {
+				log.Debugf("Logs stream of container [%s] ended: %v", container, err)
+			}
+			return
+		}
+	}
+}
+
+func parseFollowedLogLine(line, container string, isProxy bool, engardeParser *parser.Parser) (FollowedLogEntry, bool) {
+	entry := parseLogLine(line, isProxy, engardeParser)
+	if entry == nil {
+		return FollowedLogEntry{}, false
+	}
+	// parseLogLine keeps seconds only, the nanoseconds of the Kubernetes timestamp are needed to resume streams
+	t, err := time.Parse(time.RFC3339Nano, strings.SplitN(line, " ", 2)[0])
+	if err != nil {
+		t = entry.OriginalTime
+	}
+	return FollowedLogEntry{LogEntry: *entry, Container: container, Time: t}, true
+}
+
+func closeLogsReader(reader io.Closer) {
+	if err := reader.Close(); err != nil {
+		log.Errorf("Error when closing the connection streaming logs of a pod: %s", err.Error())
+	}
+}
@@ -0,0 +1,144 @@
+package business
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func containerLogsOpts(container string) interface{} {
+	return mock.MatchedBy(func(opts *core_v1.PodLogOptions) bool {
+		return opts.Container == container && opts.Follow && opts.Timestamps
+	})
+}
+
+func collectFollowedLogs(entries <-chan FollowedLogEntry) []FollowedLogEntry {
+	collected := []FollowedLogEntry{}
+	for e := range entries {
+		collected = append(collected, e)
+	}
+	return collected
+}
+
+func TestFollowPodLogs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("GetPod", "bookinfo", "reviews-v1-a").Return(&core_v1.Pod{Spec: core_v1.PodSpec{Containers: []core_v1.Container{{Name: "reviews"}, {Name: proxyContainerName}}}}, nil)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", containerLogsOpts("reviews")).Return(io.NopCloser(strings.NewReader(
+		"2022-05-01T10:00:00.100Z INFO started\n"+
+			"2022-05-01T10:00:01.200Z ERROR failed\n")), nil)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", containerLogsOpts(proxyContainerName)).Return(io.NopCloser(strings.NewReader(
+		accessLogLine("2022-05-01T10:00:02", "/reviews/1", 200, "-", 10))), nil)
+
+	svc := setupWorkloadService(k8s)
+	entries, err := svc.FollowPodLogs(context.Background(), "bookinfo", "reviews-v1-a", FollowLogOptions{})
+	require.NoError(err)
+
+	followed := collectFollowedLogs(entries)
+	require.Len(followed, 3)
+	byContainer := map[string][]FollowedLogEntry{}
+	for _, e := range followed {
+		byContainer[e.Container] = append(byContainer[e.Container], e)
+	}
+	require.Len(byContainer["reviews"], 2)
+	assert.Equal("INFO", byContainer["reviews"][0].Severity)
+	assert.Equal("ERROR", byContainer["reviews"][1].Severity)
+	assert.Equal(200*time.Millisecond, byContainer["reviews"][1].Time.Sub(byContainer["reviews"][1].OriginalTime))
+	require.Len(byContainer[proxyContainerName], 1)
+	require.NotNil(byContainer[proxyContainerName][0].AccessLog)
+	assert.Equal("/reviews/1", byContainer[proxyContainerName][0].AccessLog.UriPath)
+}
+
+func TestFollowPodLogsResumes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	after := time.Date(2022, 5, 1, 10, 0, 0, 100000000, time.UTC)
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", mock.MatchedBy(func(opts *core_v1.PodLogOptions) bool {
+		return opts.SinceTime != nil && opts.SinceTime.Equal(&meta_v1.Time{Time: after}) && opts.TailLines == nil
+	})).Return(io.NopCloser(strings.NewReader(
+		"2022-05-01T10:00:00.000Z already sent\n"+
+			"2022-05-01T10:00:00.100Z already sent\n"+
+			"2022-05-01T10:00:00.300Z new line\n")), nil)
+
+	svc := setupWorkloadService(k8s)
+	tailLines := int64(10)
+	entries, err := svc.FollowPodLogs(context.Background(), "bookinfo", "reviews-v1-a", FollowLogOptions{Containers: []string{"reviews"}, After: &after, TailLines: &tailLines})
+	require.NoError(err)
+
+	followed := collectFollowedLogs(entries)
+	require.Len(followed, 1)
+	assert.Equal("new line", followed[0].Message)
+}
+
+func TestFollowPodLogsMissingContainer(t *testing.T) {
+	config.Set(config.NewConfig())
+
+	reviews := &closeRecorder{Reader: strings.NewReader("")}
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", containerLogsOpts("reviews")).Return(reviews, nil)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", containerLogsOpts("missing")).Return(reviews, errors.New("container not found"))
+
+	svc := setupWorkloadService(k8s)
+	_, err := svc.FollowPodLogs(context.Background(), "bookinfo", "reviews-v1-a", FollowLogOptions{Containers: []string{"reviews", "missing"}})
+	assert.Error(t, err)
+	// the streams already open are closed
+	assert.True(t, reviews.closed)
+}
+
+func TestFollowPodLogsCancel(t *testing.T) {
+	config.Set(config.NewConfig())
+
+	// the pipe blocks like a follow stream waiting for new lines
+	pipeReader, pipeWriter := io.Pipe()
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("StreamPodLogs", "bookinfo", "reviews-v1-a", containerLogsOpts("reviews")).Return(pipeReader, nil)
+
+	svc := setupWorkloadService(k8s)
+	ctx, cancel := context.WithCancel(context.Background())
+	entries, err := svc.FollowPodLogs(ctx, "bookinfo", "reviews-v1-a", FollowLogOptions{Containers: []string{"reviews"}})
+	require.NoError(t, err)
+
+	_, err = pipeWriter.Write([]byte("2022-05-01T10:00:00.000Z first line\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "first line", (<-entries).Message)
+
+	cancel()
+	select {
+	case _, ok := <-entries:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the entries channel was not closed")
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
@@ -0,0 +1,45 @@
+package references
+
+import (
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type TelemetryReferences struct {
+	Telemetries           []telemetry_v1alpha1.Telemetry
+	WorkloadsPerNamespace map[string]models.WorkloadList
+}
+
+func (n TelemetryReferences) References() models.IstioReferencesMap {
+	result := models.IstioReferencesMap{}
+
+	for _, tm := range n.Telemetries {
+		key := models.IstioReferenceKey{Namespace: tm.Namespace, Name: tm.Name, ObjectType: models.ObjectTypeSingular[kubernetes.Telemetries]}
+		references := &models.IstioReferences{}
+		references.WorkloadReferences = n.getWorkloadReferences(tm)
+		result.MergeReferencesMap(models.IstioReferencesMap{key: references})
+	}
+
+	return result
+}
+
+func (n TelemetryReferences) getWorkloadReferences(tm telemetry_v1alpha1.Telemetry) []models.WorkloadReference {
+	result := make([]models.WorkloadReference, 0)
+
+	if tm.Spec.Selector != nil {
+		selector := labels.SelectorFromSet(tm.Spec.Selector.MatchLabels)
+
+		// Telemetry searches Workloads from own namespace
+		for _, wl := range n.WorkloadsPerNamespace[tm.Namespace].Workloads {
+			wlLabelSet := labels.Set(wl.Labels)
+			if selector.Matches(wlLabelSet) {
+				result = append(result, models.WorkloadReference{Name: wl.Name, Namespace: tm.Namespace})
+			}
+		}
+	}
+	return result
+}
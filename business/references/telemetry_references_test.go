@@ -0,0 +1,66 @@
+package references
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_telemetry_v1alpha1 "istio.io/api/telemetry/v1alpha1"
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func prepareTestForTelemetry(tm *telemetry_v1alpha1.Telemetry) models.IstioReferences {
+	tmReferences := TelemetryReferences{
+		Telemetries: []telemetry_v1alpha1.Telemetry{*tm},
+		WorkloadsPerNamespace: map[string]models.WorkloadList{
+			"bookinfo": data.CreateWorkloadList("bookinfo",
+				data.CreateWorkloadListItem("details-v1", map[string]string{"app": "details", "version": "v1"}),
+				data.CreateWorkloadListItem("reviews-v1", map[string]string{"app": "reviews", "version": "v1"}),
+			),
+		},
+	}
+	return *tmReferences.References()[models.IstioReferenceKey{ObjectType: "telemetry", Namespace: tm.Namespace, Name: tm.Name}]
+}
+
+func TestTelemetryReferences(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	references := prepareTestForTelemetry(createTelemetry("details-tracing", "bookinfo", map[string]string{"app": "details"}))
+	assert.Empty(references.ServiceReferences)
+	assert.Empty(references.ObjectReferences)
+
+	// Check Workload references
+	assert.Len(references.WorkloadReferences, 1)
+	assert.Equal(references.WorkloadReferences[0].Name, "details-v1")
+	assert.Equal(references.WorkloadReferences[0].Namespace, "bookinfo")
+}
+
+func TestTelemetryNoSelectorReferences(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	references := prepareTestForTelemetry(createTelemetry("namespace-tracing", "bookinfo", nil))
+	assert.Empty(references.ServiceReferences)
+	assert.Empty(references.ObjectReferences)
+	assert.Empty(references.WorkloadReferences)
+}
+
+func createTelemetry(name, namespace string, selector map[string]string) *telemetry_v1alpha1.Telemetry {
+	tm := telemetry_v1alpha1.Telemetry{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	if selector != nil {
+		tm.Spec = api_telemetry_v1alpha1.Telemetry{
+			Selector: &api_type_v1beta1.WorkloadSelector{MatchLabels: selector},
+		}
+	}
+	return &tm
+}
@@ -0,0 +1,45 @@
+package references
+
+import (
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type WasmPluginReferences struct {
+	WasmPlugins           []extensions_v1alpha1.WasmPlugin
+	WorkloadsPerNamespace map[string]models.WorkloadList
+}
+
+func (n WasmPluginReferences) References() models.IstioReferencesMap {
+	result := models.IstioReferencesMap{}
+
+	for _, wp := range n.WasmPlugins {
+		key := models.IstioReferenceKey{Namespace: wp.Namespace, Name: wp.Name, ObjectType: models.ObjectTypeSingular[kubernetes.WasmPlugins]}
+		references := &models.IstioReferences{}
+		references.WorkloadReferences = n.getWorkloadReferences(wp)
+		result.MergeReferencesMap(models.IstioReferencesMap{key: references})
+	}
+
+	return result
+}
+
+func (n WasmPluginReferences) getWorkloadReferences(wp extensions_v1alpha1.WasmPlugin) []models.WorkloadReference {
+	result := make([]models.WorkloadReference, 0)
+
+	if wp.Spec.Selector != nil {
+		selector := labels.SelectorFromSet(wp.Spec.Selector.MatchLabels)
+
+		// WasmPlugin searches Workloads from own namespace
+		for _, wl := range n.WorkloadsPerNamespace[wp.Namespace].Workloads {
+			wlLabelSet := labels.Set(wl.Labels)
+			if selector.Matches(wlLabelSet) {
+				result = append(result, models.WorkloadReference{Name: wl.Name, Namespace: wp.Namespace})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package references
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_extensions_v1alpha1 "istio.io/api/extensions/v1alpha1"
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func prepareTestForWasmPlugin(wp *extensions_v1alpha1.WasmPlugin) models.IstioReferences {
+	wpReferences := WasmPluginReferences{
+		WasmPlugins: []extensions_v1alpha1.WasmPlugin{*wp},
+		WorkloadsPerNamespace: map[string]models.WorkloadList{
+			"istio-system": data.CreateWorkloadList("istio-system",
+				data.CreateWorkloadListItem("istio-ingressgateway", map[string]string{"istio": "ingressgateway"}),
+				data.CreateWorkloadListItem("istiod", map[string]string{"app": "istiod"}),
+			),
+		},
+	}
+	return *wpReferences.References()[models.IstioReferenceKey{ObjectType: "wasmplugin", Namespace: wp.Namespace, Name: wp.Name}]
+}
+
+func TestWasmPluginReferences(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	references := prepareTestForWasmPlugin(createWasmPlugin("openid-connect", "istio-system", map[string]string{"istio": "ingressgateway"}))
+	assert.Empty(references.ServiceReferences)
+	assert.Empty(references.ObjectReferences)
+
+	// Check Workload references
+	assert.Len(references.WorkloadReferences, 1)
+	assert.Equal(references.WorkloadReferences[0].Name, "istio-ingressgateway")
+	assert.Equal(references.WorkloadReferences[0].Namespace, "istio-system")
+}
+
+func TestWasmPluginNoReferences(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	references := prepareTestForWasmPlugin(createWasmPlugin("openid-connect", "istio-system", map[string]string{"istio": "egressgateway"}))
+	assert.Empty(references.ServiceReferences)
+	assert.Empty(references.ObjectReferences)
+	assert.Empty(references.WorkloadReferences)
+}
+
+func createWasmPlugin(name, namespace string, selector map[string]string) *extensions_v1alpha1.WasmPlugin {
+	return &extensions_v1alpha1.WasmPlugin{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: api_extensions_v1alpha1.WasmPlugin{
+			Selector: &api_type_v1beta1.WorkloadSelector{MatchLabels: selector},
+			Url:      "oci://ghcr.io/istio-ecosystem/wasm-extensions/openid_connect:1.0.0",
+		},
+	}
+}
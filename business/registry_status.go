@@ -109,6 +109,30 @@ func filterRegistryConfiguration(registryStatus *kubernetes.RegistryStatus, crit
 		}
 	}
 
+	for _, gw := range registryStatus.Configuration.K8sGateways {
+		if gw.Namespace == criteria.Namespace {
+			filtered.K8sGateways = append(filtered.K8sGateways, gw)
+		}
+	}
+
+	for _, route := range registryStatus.Configuration.K8sHTTPRoutes {
+		if route.Namespace == criteria.Namespace {
+			filtered.K8sHTTPRoutes = append(filtered.K8sHTTPRoutes, route)
+		}
+	}
+
+	for _, route := range registryStatus.Configuration.K8sTCPRoutes {
+		if route.Namespace == criteria.Namespace {
+			filtered.K8sTCPRoutes = append(filtered.K8sTCPRoutes, route)
+		}
+	}
+
+	for _, route := range registryStatus.Configuration.K8sGRPCRoutes {
+		if route.GetNamespace() == criteria.Namespace {
+			filtered.K8sGRPCRoutes = append(filtered.K8sGRPCRoutes, route)
+		}
+	}
+
 	for _, we := range registryStatus.Configuration.WorkloadEntries {
 		if we.Namespace == criteria.Namespace {
 			filtered.WorkloadEntries = append(filtered.WorkloadEntries, we)
@@ -138,6 +162,18 @@ func filterRegistryConfiguration(registryStatus *kubernetes.RegistryStatus, crit
 			filtered.RequestAuthentications = append(filtered.RequestAuthentications, ra)
 		}
 	}
+
+	for _, tm := range registryStatus.Configuration.Telemetries {
+		if tm.Namespace == criteria.Namespace {
+			filtered.Telemetries = append(filtered.Telemetries, tm)
+		}
+	}
+
+	for _, wp := range registryStatus.Configuration.WasmPlugins {
+		if wp.Namespace == criteria.Namespace {
+			filtered.WasmPlugins = append(filtered.WasmPlugins, wp)
+		}
+	}
 	return &filtered
 }
 
@@ -10,6 +10,7 @@ import (
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/kiali/kiali/business/checkers"
@@ -652,6 +653,12 @@ func (in *SvcService) getServiceValidations(services []core_v1.Service, deployme
 		Pods:        pods,
 	}.Check()
 
+	serviceObjects := map[models.IstioValidationKey]meta_v1.Object{}
+	for i := range services {
+		serviceObjects[models.BuildKey(checkers.ServiceCheckerType, services[i].Name, services[i].Namespace)] = &services[i]
+	}
+	validations.SuppressChecks(serviceObjects, time.Now())
+
 	return validations
 }
 
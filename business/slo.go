@@ -0,0 +1,149 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// SLOService deals with the Service Level Objectives of services and workloads, evaluated from the Istio telemetry
+type SLOService struct {
+	prom          prometheus.ClientInterface
+	k8s           kubernetes.ClientInterface
+	businessLayer *Layer
+}
+
+// GetServiceSLOs returns the compliance, the remaining error budget and the burn rates of the objectives of a service
+func (in *SLOService) GetServiceSLOs(ctx context.Context, namespace, service string, queryTime time.Time) (*models.SLOList, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetServiceSLOs",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("service", service),
+		observability.Attribute("queryTime", queryTime),
+	)
+	defer end()
+
+	svc, err := in.businessLayer.Svc.GetService(ctx, namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	labels := fmt.Sprintf(`reporter="destination",destination_service_namespace="%s",destination_service_name="%s"`, namespace, service)
+	return in.getSLOs(namespace, "service", service, svc.SLOAnnotations, labels, queryTime)
+}
+
+// GetWorkloadSLOs returns the compliance, the remaining error budget and the burn rates of the objectives of a workload
+func (in *SLOService) GetWorkloadSLOs(ctx context.Context, namespace, workload string, queryTime time.Time) (*models.SLOList, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetWorkloadSLOs",
+		observability.Attribute("package", "business"),
+		observability.Attribute("namespace", namespace),
+		observability.Attribute("workload", workload),
+		observability.Attribute("queryTime", queryTime),
+	)
+	defer end()
+
+	w, err := fetchWorkload(ctx, in.businessLayer, WorkloadCriteria{Namespace: namespace, WorkloadName: workload})
+	if err != nil {
+		return nil, err
+	}
+	labels := fmt.Sprintf(`reporter="destination",destination_workload_namespace="%s",destination_workload="%s"`, namespace, workload)
+	return in.getSLOs(namespace, "workload", workload, w.SLOAnnotations, labels, queryTime)
+}
+
+func (in *SLOService) getSLOs(namespace, kind, name string, annotations map[string]string, labels string, queryTime time.Time) (*models.SLOList, error) {
+	sloList := &models.SLOList{Namespace: namespace, Kind: kind, Name: name, SLOs: []models.SLOStatus{}}
+	for _, slo := range models.GetSLOs(namespace, kind, name, annotations) {
+		status, err := in.getSLOStatus(slo, labels, queryTime)
+		if err != nil {
+			return nil, err
+		}
+		sloList.SLOs = append(sloList.SLOs, *status)
+	}
+	return sloList, nil
+}
+
+func (in *SLOService) getSLOStatus(slo config.SLO, labels string, queryTime time.Time) (*models.SLOStatus, error) {
+	// The error budget is the ratio of bad requests allowed by the target
+	budget := 1 - slo.Target/100
+	status := &models.SLOStatus{SLO: slo, BurnRates: []models.BurnRate{}, Status: models.SLOStatusNA}
+
+	// The objective window, then the long and short windows of every burn rate window, are queried concurrently
+	windows := []string{slo.Window}
+	burnRateWindows := config.Get().SLO.BurnRateWindows
+	for _, window := range burnRateWindows {
+		windows = append(windows, window.Long, window.Short)
+	}
+	ratios := make([]float64, len(windows))
+	found := make([]bool, len(windows))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(windows))
+	errChan := make(chan error, len(windows))
+	for i, window := range windows {
+		go func(i int, window string) {
+			defer wg.Done()
+			var err error
+			ratios[i], found[i], err = in.getErrorRatio(slo, labels, window, queryTime)
+			if err != nil {
+				log.Errorf("Error fetching the error ratio of objective %s over %s: %s", slo.Name, window, err)
+				errChan <- err
+			}
+		}(i, window)
+	}
+	wg.Wait()
+	if len(errChan) != 0 {
+		return nil, <-errChan
+	}
+
+	if found[0] {
+		status.Compliance = (1 - ratios[0]) * 100
+		status.ErrorBudgetRemaining = (1 - ratios[0]/budget) * 100
+		status.Status = models.SLOStatusMet
+		if status.Compliance < slo.Target {
+			status.Status = models.SLOStatusBreached
+		}
+	}
+
+	for i, window := range burnRateWindows {
+		long, short := 1+2*i, 2+2*i
+		burnRate := models.BurnRate{BurnRateWindow: window, LongBurnRate: -1, ShortBurnRate: -1}
+		if found[long] {
+			burnRate.LongBurnRate = ratios[long] / budget
+		}
+		if found[short] {
+			burnRate.ShortBurnRate = ratios[short] / budget
+		}
+		burnRate.Alerting = found[long] && found[short] && burnRate.LongBurnRate >= window.Threshold && burnRate.ShortBurnRate >= window.Threshold
+		if burnRate.Alerting && status.Status == models.SLOStatusMet {
+			status.Status = models.SLOStatusAtRisk
+		}
+		status.BurnRates = append(status.BurnRates, burnRate)
+	}
+	return status, nil
+}
+
+// getErrorRatio returns the ratio of bad requests of an objective over a window, and false when there were no requests
+func (in *SLOService) getErrorRatio(slo config.SLO, labels, window string, queryTime time.Time) (float64, bool, error) {
+	latencyThreshold := 0.0
+	if slo.Type == models.SLOTypeLatency {
+		latencyThreshold = slo.LatencyThreshold
+	}
+	result, err := in.prom.GetSLIErrorRatio(labels, latencyThreshold, window, queryTime)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(result) == 0 || math.IsNaN(float64(result[0].Value)) {
+		return 0, false, nil
+	}
+	return math.Min(math.Max(float64(result[0].Value), 0), 1), true, nil
+}
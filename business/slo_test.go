@@ -0,0 +1,122 @@
+package business
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func sloRatio(value float64) model.Vector {
+	return model.Vector{&model.Sample{Metric: model.Metric{}, Value: model.SampleValue(value)}}
+}
+
+func TestGetServiceSLOs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.SLO.BurnRateWindows = []config.BurnRateWindow{
+		{Long: "1h", Short: "5m", Threshold: 14.4},
+		{Long: "6h", Short: "30m", Threshold: 6},
+	}
+	conf.SLO.Objectives = []config.SLO{
+		{Name: "reviews-availability", Namespace: "bookinfo", Kind: "service", EntityName: "reviews", Type: "availability", Target: 99, Window: "30d"},
+		{Name: "reviews-latency", Kind: "service", Type: "latency", Target: 99, Window: "30d", LatencyThreshold: 250},
+		{Name: "workload-availability", Kind: "workload", Type: "availability", Target: 99, Window: "30d"},
+	}
+	config.Set(conf)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("GetService", "bookinfo", "reviews").Return(&core_v1.Service{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}}, nil)
+
+	queryTime := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	labels := `reporter="destination",destination_service_namespace="bookinfo",destination_service_name="reviews"`
+	prom := new(prometheustest.PromClientMock)
+	// availability: 0.5% of errors in 30d, burning fast in the last hour
+	prom.On("GetSLIErrorRatio", labels, 0.0, "30d", queryTime).Return(sloRatio(0.005), nil)
+	prom.On("GetSLIErrorRatio", labels, 0.0, "1h", queryTime).Return(sloRatio(0.2), nil)
+	prom.On("GetSLIErrorRatio", labels, 0.0, "5m", queryTime).Return(sloRatio(0.3), nil)
+	prom.On("GetSLIErrorRatio", labels, 0.0, "6h", queryTime).Return(sloRatio(0.03), nil)
+	prom.On("GetSLIErrorRatio", labels, 0.0, "30m", queryTime).Return(sloRatio(0.1), nil)
+	// latency: 2% of slow requests in 30d, no traffic recently
+	prom.On("GetSLIErrorRatio", labels, 250.0, "30d", queryTime).Return(sloRatio(0.02), nil)
+	prom.On("GetSLIErrorRatio", labels, 250.0, "1h", queryTime).Return(model.Vector{}, nil)
+	prom.On("GetSLIErrorRatio", labels, 250.0, "5m", queryTime).Return(sloRatio(math.NaN()), nil)
+	prom.On("GetSLIErrorRatio", labels, 250.0, "6h", queryTime).Return(model.Vector{}, nil)
+	prom.On("GetSLIErrorRatio", labels, 250.0, "30m", queryTime).Return(model.Vector{}, nil)
+
+	layer := NewWithBackends(k8s, prom, nil)
+	sloList, err := layer.SLO.GetServiceSLOs(context.TODO(), "bookinfo", "reviews", queryTime)
+	require.NoError(err)
+
+	assert.Equal("service", sloList.Kind)
+	require.Len(sloList.SLOs, 2)
+
+	availability := sloList.SLOs[0]
+	assert.Equal("reviews-availability", availability.Name)
+	assert.InDelta(99.5, availability.Compliance, 0.0001)
+	assert.InDelta(50, availability.ErrorBudgetRemaining, 0.0001)
+	assert.Equal(models.SLOStatusAtRisk, availability.Status)
+	require.Len(availability.BurnRates, 2)
+	assert.InDelta(20, availability.BurnRates[0].LongBurnRate, 0.0001)
+	assert.InDelta(30, availability.BurnRates[0].ShortBurnRate, 0.0001)
+	assert.True(availability.BurnRates[0].Alerting)
+	assert.InDelta(3, availability.BurnRates[1].LongBurnRate, 0.0001)
+	assert.False(availability.BurnRates[1].Alerting)
+
+	latency := sloList.SLOs[1]
+	assert.Equal("reviews-latency", latency.Name)
+	assert.InDelta(98, latency.Compliance, 0.0001)
+	assert.InDelta(-100, latency.ErrorBudgetRemaining, 0.0001)
+	assert.Equal(models.SLOStatusBreached, latency.Status)
+	assert.Equal(-1.0, latency.BurnRates[0].LongBurnRate)
+	assert.Equal(-1.0, latency.BurnRates[0].ShortBurnRate)
+	assert.False(latency.BurnRates[0].Alerting)
+}
+
+func TestGetServiceSLOsFromAnnotation(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.SLO.BurnRateWindows = []config.BurnRateWindow{}
+	conf.SLO.Objectives = []config.SLO{
+		{Name: "all-availability", Type: "availability", Target: 99, Window: "30d"},
+	}
+	config.Set(conf)
+
+	k8s := new(kubetest.K8SClientMock)
+	k8s.On("IsOpenShift").Return(false)
+	k8s.On("GetService", "bookinfo", "reviews").Return(&core_v1.Service{ObjectMeta: meta_v1.ObjectMeta{
+		Name:        "reviews",
+		Namespace:   "bookinfo",
+		Annotations: map[string]string{models.SLOAnnotation: "availability,99.9,7d"},
+	}}, nil)
+
+	queryTime := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	labels := `reporter="destination",destination_service_namespace="bookinfo",destination_service_name="reviews"`
+	prom := new(prometheustest.PromClientMock)
+	prom.On("GetSLIErrorRatio", labels, 0.0, "7d", queryTime).Return(model.Vector{}, nil)
+
+	layer := NewWithBackends(k8s, prom, nil)
+	sloList, err := layer.SLO.GetServiceSLOs(context.TODO(), "bookinfo", "reviews", queryTime)
+	require.NoError(err)
+
+	// The annotation overrides the objectives of the configuration
+	require.Len(sloList.SLOs, 1)
+	assert.Equal("availability-7d", sloList.SLOs[0].Name)
+	assert.Equal(99.9, sloList.SLOs[0].Target)
+	assert.Equal(models.SLOStatusNA, sloList.SLOs[0].Status)
+	assert.Empty(sloList.SLOs[0].BurnRates)
+}
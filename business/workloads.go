@@ -150,6 +150,8 @@ func (in *WorkloadService) GetWorkloadList(ctx context.Context, criteria Workloa
 		IncludePeerAuthentications:    true,
 		IncludeRequestAuthentications: true,
 		IncludeSidecars:               true,
+		IncludeTelemetries:            true,
+		IncludeWasmPlugins:            true,
 	}
 	var istioConfigList models.IstioConfigList
 
@@ -191,6 +193,11 @@ func (in *WorkloadService) GetWorkloadList(ctx context.Context, criteria Workloa
 	allWorkloads[criteria.Namespace] = *workloadList
 	validations := in.getWorkloadValidations(authpolicies, allWorkloads)
 	validations.StripIgnoredChecks()
+	workloadObjects := map[models.IstioValidationKey]meta_v1.Object{}
+	for _, w := range workloadList.Workloads {
+		workloadObjects[models.BuildKey(checkers.WorkloadCheckerType, w.Name, criteria.Namespace)] = &meta_v1.ObjectMeta{Name: w.Name, Namespace: criteria.Namespace, Labels: w.Labels}
+	}
+	validations.SuppressChecks(workloadObjects, time.Now())
 	workloadList.Validations = validations
 	return *workloadList, nil
 }
@@ -263,6 +270,28 @@ func FilterWorkloadReferences(wSelector string, istioConfigList models.IstioConf
 			wkdReferences = append(wkdReferences, &ref)
 		}
 	}
+	tmFiltered := kubernetes.FilterTelemetriesBySelector(wSelector, istioConfigList.Telemetries)
+	for _, tm := range tmFiltered {
+		ref := models.BuildKey(tm.Kind, tm.Name, tm.Namespace)
+		exist := false
+		for _, r := range wkdReferences {
+			exist = exist || *r == ref
+		}
+		if !exist {
+			wkdReferences = append(wkdReferences, &ref)
+		}
+	}
+	wpFiltered := kubernetes.FilterWasmPluginsBySelector(wSelector, istioConfigList.WasmPlugins)
+	for _, wp := range wpFiltered {
+		ref := models.BuildKey(wp.Kind, wp.Name, wp.Namespace)
+		exist := false
+		for _, r := range wkdReferences {
+			exist = exist || *r == ref
+		}
+		if !exist {
+			wkdReferences = append(wkdReferences, &ref)
+		}
+	}
 	return wkdReferences
 }
 
@@ -723,6 +752,22 @@ func fetchWorkloads(ctx context.Context, layer *Layer, namespace string, labelSe
 		return ws, err
 	}
 
+	// Custom controllers are only fetched when they control pods, ReplicaSets or Deployments of the namespace
+	controlled := []meta_v1.Object{}
+	for i := range pods {
+		controlled = append(controlled, &pods[i])
+	}
+	for i := range repset {
+		controlled = append(controlled, &repset[i])
+	}
+	for i := range dep {
+		controlled = append(controlled, &dep[i])
+	}
+	custom, err := fetchCustomWorkloads(layer, namespace, customWorkloadTypesOf("", controlled))
+	if err != nil {
+		return ws, err
+	}
+
 	// Key: name of controller; Value: type of controller
 	controllers := map[string]string{}
 
@@ -843,6 +888,24 @@ func fetchWorkloads(ctx context.Context, layer *Layer, namespace string, labelSe
 		}
 	}
 
+	// Resolve Deployments from custom controllers
+	for cname, ctype := range controllers {
+		if ctype == kubernetes.DeploymentType {
+			for i := range dep {
+				if dep[i].Name == cname {
+					if isControlledByCustomWorkload(&dep[i], custom) {
+						ref := meta_v1.GetControllerOf(&dep[i])
+						if _, exist := controllers[ref.Name]; !exist {
+							controllers[ref.Name] = ref.Kind
+						}
+						delete(controllers, cname)
+					}
+					break
+				}
+			}
+		}
+	}
+
 	// Cornercase, check for controllers without pods, to show them as a workload
 	var selector labels.Selector
 	var selErr error
@@ -857,7 +920,7 @@ func fetchWorkloads(ctx context.Context, layer *Layer, namespace string, labelSe
 		if selector != nil {
 			selectorCheck = selector.Matches(labels.Set(d.Spec.Template.Labels))
 		}
-		if _, exist := controllers[d.Name]; !exist && selectorCheck {
+		if _, exist := controllers[d.Name]; !exist && selectorCheck && !isControlledByCustomWorkload(&d, custom) {
 			controllers[d.Name] = "Deployment"
 		}
 	}
@@ -906,6 +969,15 @@ func fetchWorkloads(ctx context.Context, layer *Layer, namespace string, labelSe
 			controllers[ds.Name] = "DaemonSet"
 		}
 	}
+	for _, cw := range custom {
+		selectorCheck := true
+		if selector != nil {
+			selectorCheck = selector.Matches(labels.Set(kubernetes.GetCustomWorkloadTemplate(cw.Unstructured, cw.Type).Labels))
+		}
+		if _, exist := controllers[cw.GetName()]; !exist && selectorCheck {
+			controllers[cw.GetName()] = cw.Type.Kind
+		}
+	}
 
 	// Build workloads from controllers
 	var cnames []string
@@ -1084,6 +1156,21 @@ func fetchWorkloads(ctx context.Context, layer *Layer, namespace string, labelSe
 				cnFound = false
 			}
 		default:
+			found := false
+			iFound := -1
+			for i, cw := range custom {
+				if cw.GetName() == cname && cw.Type.Kind == ctype {
+					found = true
+					iFound = i
+					break
+				}
+			}
+			if found {
+				// Custom controller registered in the configuration
+				w.SetPods(customWorkloadPods(custom[iFound], dep, repset, pods))
+				w.ParseCustomWorkload(custom[iFound].Unstructured, custom[iFound].Type)
+				break
+			}
 			// Two scenarios:
 			// 1. Custom controller with replicaset
 			// 2. Custom controller without replicaset controlling pods directly.
@@ -1489,6 +1576,53 @@ func fetchWorkload(ctx context.Context, layer *Layer, criteria WorkloadCriteria)
 		}
 	}
 
+	// Custom controllers are fetched when requested, when they control the pods or ReplicaSets of the workload, or
+	// when the workload is not found as another controller, i.e. a Knative Revision controlling a Deployment
+	var customTypes []config.CustomWorkloadType
+	if wt, ok := kubernetes.GetCustomWorkloadType(criteria.WorkloadType); ok && isWorkloadIncluded(wt.Kind) {
+		customTypes = []config.CustomWorkloadType{wt}
+	} else if criteria.WorkloadType == "" {
+		controlled := []meta_v1.Object{}
+		for i := range pods {
+			controlled = append(controlled, &pods[i])
+		}
+		for i := range repset {
+			controlled = append(controlled, &repset[i])
+		}
+		customTypes = customWorkloadTypesOf(criteria.WorkloadName, controlled)
+		if _, exist := controllers[criteria.WorkloadName]; !exist && len(customTypes) == 0 {
+			for _, wt := range config.Get().KubernetesConfig.CustomWorkloadTypes {
+				if isWorkloadIncluded(wt.Kind) {
+					customTypes = append(customTypes, wt)
+				}
+			}
+		}
+	}
+	var custom *customWorkload
+	var customDep []apps_v1.Deployment
+	if len(customTypes) > 0 {
+		var err error
+		custom, err = fetchCustomWorkload(layer, criteria.Namespace, criteria.WorkloadName, customTypes)
+		if err != nil {
+			return wl, err
+		}
+		// Custom controllers can control their pods through Deployments, i.e. Knative Revisions
+		if custom != nil {
+			if IsNamespaceCached(criteria.Namespace) {
+				customDep, err = kialiCache.GetDeployments(criteria.Namespace)
+			} else {
+				customDep, err = layer.k8s.GetDeployments(criteria.Namespace)
+			}
+			if err != nil {
+				log.Errorf("Error fetching Deployments per namespace %s: %s", criteria.Namespace, err)
+				return wl, err
+			}
+			if _, exist := controllers[custom.GetName()]; !exist {
+				controllers[custom.GetName()] = custom.Type.Kind
+			}
+		}
+	}
+
 	// Build workload from controllers
 
 	if _, exist := controllers[criteria.WorkloadName]; exist {
@@ -1636,6 +1770,12 @@ func fetchWorkload(ctx context.Context, layer *Layer, criteria WorkloadCriteria)
 				cnFound = false
 			}
 		default:
+			if custom != nil && custom.Type.Kind == controllerType {
+				// Custom controller registered in the configuration
+				w.SetPods(customWorkloadPods(*custom, customDep, repset, pods))
+				w.ParseCustomWorkload(custom.Unstructured, custom.Type)
+				break
+			}
 			// Two scenarios:
 			// 1. Custom controller with replicaset
 			// 2. Custom controller without replicaset controlling pods directly.
@@ -1705,6 +1845,10 @@ func updateWorkload(layer *Layer, namespace string, workloadName string, workloa
 		kubernetes.DaemonSetType,
 	}
 
+	for _, wt := range config.Get().KubernetesConfig.CustomWorkloadTypes {
+		workloadTypes = append(workloadTypes, wt.Kind)
+	}
+
 	// workloadType is an optional parameter used to optimize the workload type fetch
 	// By default workloads use only the "name" but not the pair "name,type".
 	if workloadType != "" {
@@ -41,6 +41,11 @@ const (
 
 	TokenCookieName = "kiali-token"
 
+	// Session stores available to persist Kiali user sessions
+	SessionStoreCookie = "cookie"
+	SessionStoreFile   = "file"
+	SessionStoreMemory = "memory"
+
 	// These constants are used for external services auth (Prometheus, Grafana ...) ; not for Kiali auth
 	AuthTypeBasic  = "basic"
 	AuthTypeBearer = "bearer"
@@ -98,8 +103,10 @@ type Observability struct {
 type Server struct {
 	Address                    string        `yaml:",omitempty"`
 	AuditLog                   bool          `yaml:"audit_log,omitempty"` // When true, allows additional audit logging on Write operations
+	AuditLogSink               AuditLogSink  `yaml:"audit_log_sink,omitempty"`
 	CORSAllowAll               bool          `yaml:"cors_allow_all,omitempty"`
 	GzipEnabled                bool          `yaml:"gzip_enabled,omitempty"`
+	MaxBundleSize              int           `yaml:"max_bundle_size,omitempty"` // Maximum size in megabytes of the manifest bundles imported or validated, compressed or not
 	Observability              Observability `yaml:"observability,omitempty"`
 	Port                       int           `yaml:",omitempty"`
 	StaticContentRootDirectory string        `yaml:"static_content_root_directory,omitempty"`
@@ -110,6 +117,16 @@ type Server struct {
 	WebSchema                  string        `yaml:"web_schema,omitempty"`
 }
 
+// AuditLogSink defines where the audit entries of the write operations are recorded when the audit log is enabled.
+// Entries are written as JSON lines to FilePath, which is rotated when exceeding MaxSize megabytes, keeping MaxBackups
+// rotated files. When FilePath is empty, the latest MaxEntries entries are kept in memory.
+type AuditLogSink struct {
+	FilePath   string `yaml:"file_path,omitempty"`
+	MaxBackups int    `yaml:"max_backups,omitempty"`
+	MaxEntries int    `yaml:"max_entries,omitempty"`
+	MaxSize    int    `yaml:"max_size,omitempty"`
+}
+
 // Auth provides authentication data for external services
 type Auth struct {
 	CAFile             string `yaml:"ca_file"`
@@ -228,6 +245,14 @@ type ComponentStatus struct {
 	Namespace string `yaml:"namespace,omitempty"`
 }
 
+// GraphCacheConfig defines the server-side cache of generated graphs. A cached graph is shared by all
+// users requesting the same graph, and is served for requests with a query time within CacheDuration.
+type GraphCacheConfig struct {
+	CacheDuration int  `yaml:"cache_duration,omitempty"` // Cache duration per graph expressed in seconds
+	Enabled       bool `yaml:"enabled,omitempty"`
+	MaxEntries    int  `yaml:"max_entries,omitempty"` // Maximum number of cached graphs
+}
+
 // ExternalServices holds configurations for other systems that Kiali depends on
 type ExternalServices struct {
 	Grafana          GrafanaConfig          `yaml:"grafana,omitempty"`
@@ -279,6 +304,19 @@ type KubernetesConfig struct {
 	// Kiali cache list of namespaces per user, this is typically short lived cache compared with the duration of the
 	// namespace cache defined by previous CacheDuration parameter
 	CacheTokenNamespaceDuration int `yaml:"cache_token_namespace_duration,omitempty"`
+	// Custom controllers shown as workloads, i.e. Argo Rollouts. A custom controller is only queried when it controls
+	// pods, ReplicaSets or Deployments of a namespace, or when a workload of its kind is requested.
+	// None are registered by default. Some examples:
+	//   - group: argoproj.io, version: v1alpha1, kind: Rollout, resource: rollouts, template_path: spec.template,
+	//     desired_replicas_path: spec.replicas, current_replicas_path: status.replicas,
+	//     available_replicas_path: status.availableReplicas
+	//   - group: serving.knative.dev, version: v1, kind: Revision, resource: revisions,
+	//     desired_replicas_path: status.desiredReplicas, current_replicas_path: status.actualReplicas,
+	//     available_replicas_path: status.actualReplicas
+	//   - group: apps.kruise.io, version: v1alpha1, kind: CloneSet, resource: clonesets, template_path: spec.template,
+	//     desired_replicas_path: spec.replicas, current_replicas_path: status.replicas,
+	//     available_replicas_path: status.availableReplicas
+	CustomWorkloadTypes []CustomWorkloadType `yaml:"custom_workload_types,omitempty"`
 	// List of controllers that won't be used for Workload calculation
 	// Kiali queries Deployment,ReplicaSet,ReplicationController,DeploymentConfig,StatefulSet,Job and CronJob controllers
 	// Deployment and ReplicaSet will be always queried, but ReplicationController,DeploymentConfig,StatefulSet,Job and CronJobs
@@ -287,6 +325,22 @@ type KubernetesConfig struct {
 	QPS              float32  `yaml:"qps,omitempty"`
 }
 
+// CustomWorkloadType defines a custom controller kind, and where its pod template and replicas are found
+type CustomWorkloadType struct {
+	Group   string `yaml:"group"`
+	Version string `yaml:"version"`
+	Kind    string `yaml:"kind"`
+	// Resource is the plural name of the kind in the Kubernetes API, i.e. rollouts
+	Resource string `yaml:"resource"`
+	// Dot separated path of the pod template, i.e. spec.template
+	// When empty, the labels and annotations of the controller itself are used
+	TemplatePath string `yaml:"template_path,omitempty"`
+	// Dot separated paths of the replicas counts, i.e. spec.replicas
+	DesiredReplicasPath   string `yaml:"desired_replicas_path,omitempty"`
+	CurrentReplicasPath   string `yaml:"current_replicas_path,omitempty"`
+	AvailableReplicasPath string `yaml:"available_replicas_path,omitempty"`
+}
+
 // ApiConfig contains API specific configuration.
 type ApiConfig struct {
 	Namespaces ApiNamespacesConfig
@@ -302,9 +356,20 @@ type ApiNamespacesConfig struct {
 type AuthConfig struct {
 	OpenId    OpenIdConfig    `yaml:"openid,omitempty"`
 	OpenShift OpenShiftConfig `yaml:"openshift,omitempty"`
+	Session   SessionConfig   `yaml:"session,omitempty"`
 	Strategy  string          `yaml:"strategy,omitempty"`
 }
 
+// SessionConfig defines where user sessions are persisted.
+// The "cookie" store keeps the whole session in browser cookies. The "memory" and "file" stores keep
+// the session server-side and only send an opaque session id to the browser.
+type SessionConfig struct {
+	// Admins lists the usernames allowed to list and revoke sessions of server-side stores
+	Admins   []string `yaml:"admins,omitempty"`
+	FilePath string   `yaml:"file_path,omitempty"`
+	Store    string   `yaml:"store,omitempty"`
+}
+
 // OpenShiftConfig contains specific configuration for authentication when on OpenShift
 type OpenShiftConfig struct {
 	ClientIdPrefix string `yaml:"client_id_prefix,omitempty"`
@@ -399,7 +464,31 @@ type UIDefaults struct {
 
 // Validations defines default settings configured for the Validations subsystem
 type Validations struct {
-	Ignore []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	Ignore       []string                `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	Suppressions []ValidationSuppression `yaml:"suppressions,omitempty" json:"suppressions,omitempty"`
+}
+
+// ValidationSuppression accepts the checks with the given codes on the matching objects: unlike ignored codes, the
+// checks are still reported but marked as suppressed. Namespace and Name are regular expressions, Selector matches the
+// labels of the object and ObjectType is a singular type (i.e. virtualservice). Empty fields match anything.
+// A suppression requires at least one code and a Reason, and stops applying after Expires (RFC3339) when set.
+type ValidationSuppression struct {
+	Codes      []string          `yaml:"codes,omitempty" json:"codes,omitempty"`
+	Namespace  string            `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	ObjectType string            `yaml:"object_type,omitempty" json:"objectType,omitempty"`
+	Name       string            `yaml:"name,omitempty" json:"name,omitempty"`
+	Selector   map[string]string `yaml:"selector,omitempty" json:"selector,omitempty"`
+	Reason     string            `yaml:"reason" json:"reason"`
+	Expires    string            `yaml:"expires,omitempty" json:"expires,omitempty"`
+}
+
+// IstioConfigHistory defines how many revisions of each Istio object are kept when it is updated or deleted
+// through Kiali, to allow rolling it back. Zero disables the history. At most MaxObjects objects are tracked,
+// the history of the least recently changed object is dropped first. The history is kept in memory: it does
+// not survive a restart of Kiali and is not shared across replicas.
+type IstioConfigHistory struct {
+	MaxObjects   int `yaml:"max_objects,omitempty" json:"maxObjects"`
+	MaxRevisions int `yaml:"max_revisions,omitempty" json:"maxRevisions"`
 }
 
 // CertificatesInformationIndicators defines configuration to enable the feature and to grant read permissions to a list of secrets
@@ -412,6 +501,7 @@ type CertificatesInformationIndicators struct {
 type KialiFeatureFlags struct {
 	CertificatesInformationIndicators CertificatesInformationIndicators `yaml:"certificates_information_indicators,omitempty" json:"certificatesInformationIndicators"`
 	DisabledFeatures                  []string                          `yaml:"disabled_features,omitempty" json:"disabledFeatures,omitempty"`
+	IstioConfigHistory                IstioConfigHistory                `yaml:"istio_config_history,omitempty" json:"istioConfigHistory"`
 	IstioInjectionAction              bool                              `yaml:"istio_injection_action,omitempty" json:"istioInjectionAction"`
 	IstioUpgradeAction                bool                              `yaml:"istio_upgrade_action,omitempty" json:"istioUpgradeAction"`
 	UIDefaults                        UIDefaults                        `yaml:"ui_defaults,omitempty" json:"uiDefaults,omitempty"`
@@ -427,12 +517,41 @@ type Tolerance struct {
 	Direction string  `yaml:"direction,omitempty" json:"direction"`
 }
 
+// LatencyTolerance config
+// Degraded and Failure are request duration thresholds in milliseconds of a percentile (p95 or p99), 0 disables a threshold
+type LatencyTolerance struct {
+	Percentile string  `yaml:"percentile,omitempty" json:"percentile"`
+	Degraded   float32 `yaml:"degraded,omitempty" json:"degraded"`
+	Failure    float32 `yaml:"failure,omitempty" json:"failure"`
+	Protocol   string  `yaml:"protocol,omitempty" json:"protocol"`
+	Direction  string  `yaml:"direction,omitempty" json:"direction"`
+}
+
+// ConnectionTolerance config
+// Degraded and Failure are percentages of TCP connections closed with response flags, as the error ratios of Tolerance
+type ConnectionTolerance struct {
+	Degraded  float32 `yaml:"degraded,omitempty" json:"degraded"`
+	Failure   float32 `yaml:"failure,omitempty" json:"failure"`
+	Direction string  `yaml:"direction,omitempty" json:"direction"`
+}
+
+// RestartTolerance config
+// Degraded and Failure are thresholds of the container restarts of any pod of a workload over the rate interval,
+// 0 disables a threshold. Restarts are read from the kube-state-metrics scraped by Prometheus.
+type RestartTolerance struct {
+	Degraded int32 `yaml:"degraded,omitempty" json:"degraded"`
+	Failure  int32 `yaml:"failure,omitempty" json:"failure"`
+}
+
 // Rate config
 type Rate struct {
-	Namespace string      `yaml:"namespace,omitempty" json:"namespace,omitempty"`
-	Kind      string      `yaml:"kind,omitempty" json:"kind,omitempty"`
-	Name      string      `yaml:"name,omitempty" json:"name,omitempty"`
-	Tolerance []Tolerance `yaml:"tolerance,omitempty" json:"tolerance"`
+	Namespace  string                `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	Kind       string                `yaml:"kind,omitempty" json:"kind,omitempty"`
+	Name       string                `yaml:"name,omitempty" json:"name,omitempty"`
+	Tolerance  []Tolerance           `yaml:"tolerance,omitempty" json:"tolerance"`
+	Latency    []LatencyTolerance    `yaml:"latency,omitempty" json:"latency,omitempty"`
+	Connection []ConnectionTolerance `yaml:"connection,omitempty" json:"connection,omitempty"`
+	Restarts   *RestartTolerance     `yaml:"restarts,omitempty" json:"restarts,omitempty"`
 }
 
 // HealthConfig rates
@@ -440,6 +559,41 @@ type HealthConfig struct {
 	Rate []Rate `yaml:"rate,omitempty" json:"rate,omitempty"`
 }
 
+// SLOConfig declares the Service Level Objectives of services and workloads and the windows of the burn rates
+// evaluated for every objective
+type SLOConfig struct {
+	BurnRateWindows []BurnRateWindow `yaml:"burn_rate_windows,omitempty" json:"burnRateWindows,omitempty"`
+	// Bucket boundaries, in milliseconds, of the istio_request_duration_milliseconds histogram. They must match the
+	// buckets configured in the mesh, the Istio defaults are used by default.
+	LatencyBuckets []float64 `yaml:"latency_buckets,omitempty" json:"latencyBuckets,omitempty"`
+	Objectives     []SLO     `yaml:"objectives,omitempty" json:"objectives,omitempty"`
+}
+
+// SLO config
+// An objective applies to all the entities matching the Namespace, Kind (service or workload) and Name expressions.
+// Type is "availability" (ratio of non 5xx responses) or "latency" (ratio of requests served under LatencyThreshold
+// milliseconds, which must be one of the LatencyBuckets of the SLOConfig).
+// Target is a percentage (i.e. 99.9) and Window a Prometheus duration (i.e. 30d).
+type SLO struct {
+	Name             string  `yaml:"name,omitempty" json:"name"`
+	Namespace        string  `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	Kind             string  `yaml:"kind,omitempty" json:"kind,omitempty"`
+	EntityName       string  `yaml:"entity_name,omitempty" json:"entityName,omitempty"`
+	Type             string  `yaml:"type,omitempty" json:"type"`
+	Target           float64 `yaml:"target,omitempty" json:"target"`
+	Window           string  `yaml:"window,omitempty" json:"window"`
+	LatencyThreshold float64 `yaml:"latency_threshold,omitempty" json:"latencyThreshold,omitempty"`
+}
+
+// BurnRateWindow config
+// A burn rate alerts when both the Long and Short windows burn the error budget at least Threshold times faster than
+// the rate that would consume it exactly in the objective window.
+type BurnRateWindow struct {
+	Long      string  `yaml:"long,omitempty" json:"long"`
+	Short     string  `yaml:"short,omitempty" json:"short"`
+	Threshold float64 `yaml:"threshold,omitempty" json:"threshold"`
+}
+
 //go:embed *
 var compatibilityMatrixFile embed.FS
 
@@ -482,6 +636,7 @@ type Config struct {
 	CustomDashboards         dashboards.MonitoringDashboardsList `yaml:"custom_dashboards,omitempty"`
 	Deployment               DeploymentConfig                    `yaml:"deployment,omitempty"`
 	ExternalServices         ExternalServices                    `yaml:"external_services,omitempty"`
+	GraphCache               GraphCacheConfig                    `yaml:"graph_cache,omitempty"`
 	HealthConfig             HealthConfig                        `yaml:"health_config,omitempty" json:"healthConfig,omitempty"`
 	Identity                 security.Identity                   `yaml:",omitempty"`
 	InCluster                bool                                `yaml:"in_cluster,omitempty"`
@@ -492,6 +647,7 @@ type Config struct {
 	KubernetesConfig         KubernetesConfig                    `yaml:"kubernetes_config,omitempty"`
 	LoginToken               LoginToken                          `yaml:"login_token,omitempty"`
 	Server                   Server                              `yaml:",omitempty"`
+	SLO                      SLOConfig                           `yaml:"slo,omitempty" json:"slo,omitempty"`
 }
 
 // NewConfig creates a default Config struct
@@ -532,6 +688,11 @@ func NewConfig() (c *Config) {
 				ClientIdPrefix: "kiali",
 				ServerPrefix:   "https://kubernetes.default.svc/",
 			},
+			Session: SessionConfig{
+				Admins:   []string{},
+				FilePath: "/tmp/kiali-sessions.json",
+				Store:    SessionStoreCookie,
+			},
 		},
 		CustomDashboards: dashboards.GetBuiltInMonitoringDashboards(),
 		Deployment: DeploymentConfig{
@@ -624,6 +785,11 @@ func NewConfig() (c *Config) {
 				WhiteListIstioSystem: []string{"jaeger-query", "istio-ingressgateway"},
 			},
 		},
+		GraphCache: GraphCacheConfig{
+			CacheDuration: 10,
+			Enabled:       false,
+			MaxEntries:    100,
+		},
 		IstioLabels: IstioLabels{
 			AppLabelName:       "app",
 			InjectionLabelName: "istio-injection",
@@ -635,7 +801,11 @@ func NewConfig() (c *Config) {
 				Enabled: true,
 				Secrets: []string{"cacerts", "istio-ca-secret"},
 			},
-			DisabledFeatures:     []string{},
+			DisabledFeatures: []string{},
+			IstioConfigHistory: IstioConfigHistory{
+				MaxObjects:   1000,
+				MaxRevisions: 10,
+			},
 			IstioInjectionAction: true,
 			IstioUpgradeAction:   false,
 			UIDefaults: UIDefaults{
@@ -697,9 +867,10 @@ func NewConfig() (c *Config) {
 			Burst:                       200,
 			CacheDuration:               5 * 60,
 			CacheEnabled:                true,
-			CacheIstioTypes:             []string{"AuthorizationPolicy", "DestinationRule", "EnvoyFilter", "Gateway", "PeerAuthentication", "RequestAuthentication", "ServiceEntry", "Sidecar", "VirtualService", "WorkloadEntry", "WorkloadGroup"},
+			CacheIstioTypes:             []string{"AuthorizationPolicy", "DestinationRule", "EnvoyFilter", "Gateway", "K8sGateway", "K8sHTTPRoute", "K8sTCPRoute", "K8sGRPCRoute", "PeerAuthentication", "RequestAuthentication", "ServiceEntry", "Sidecar", "Telemetry", "VirtualService", "WasmPlugin", "WorkloadEntry", "WorkloadGroup"},
 			CacheNamespaces:             []string{".*"},
 			CacheTokenNamespaceDuration: 10,
+			CustomWorkloadTypes:         []CustomWorkloadType{},
 			ExcludeWorkloads:            []string{"CronJob", "DeploymentConfig", "Job", "ReplicationController"},
 			QPS:                         175,
 		},
@@ -708,8 +879,14 @@ func NewConfig() (c *Config) {
 			SigningKey:        "kiali",
 		},
 		Server: Server{
-			AuditLog:    true,
-			GzipEnabled: true,
+			AuditLog: true,
+			AuditLogSink: AuditLogSink{
+				MaxBackups: 3,
+				MaxEntries: 1000,
+				MaxSize:    10,
+			},
+			GzipEnabled:   true,
+			MaxBundleSize: 10,
 			Observability: Observability{
 				Metrics: Metrics{
 					Enabled: true,
@@ -727,6 +904,15 @@ func NewConfig() (c *Config) {
 			WebHistoryMode:             "browser",
 			WebSchema:                  "",
 		},
+		SLO: SLOConfig{
+			BurnRateWindows: []BurnRateWindow{
+				{Long: "1h", Short: "5m", Threshold: 14.4},
+				{Long: "6h", Short: "30m", Threshold: 6},
+				{Long: "1d", Short: "2h", Threshold: 3},
+				{Long: "3d", Short: "6h", Threshold: 1},
+			},
+			LatencyBuckets: []float64{0.5, 1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 300000, 600000, 1800000, 3600000},
+		},
 	}
 
 	return
@@ -4,7 +4,9 @@ import (
 	jaegerModels "github.com/kiali/kiali/jaeger/model/json"
 
 	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/business/audit"
 	"github.com/kiali/kiali/business/authentication"
+	"github.com/kiali/kiali/graph/api"
 	"github.com/kiali/kiali/graph/config/cytoscape"
 	"github.com/kiali/kiali/jaeger"
 	"github.com/kiali/kiali/models"
@@ -34,7 +36,7 @@ type AggregateValueParam struct {
 	Name string `json:"aggregateValue"`
 }
 
-// swagger:parameters appMetrics appDetails graphApp graphAppVersion appDashboard appSpans appTraces errorTraces
+// swagger:parameters appMetrics appDetails graphApp graphAppVersion appDashboard appSpans appTraces errorTraces appAccessLogs appHealthTimeline
 type AppParam struct {
 	// The app name (label value).
 	//
@@ -79,7 +81,7 @@ type LoggingParam struct {
 	Level ProxyLogLevel `json:"level"`
 }
 
-// swagger:parameters istioConfigList workloadList workloadDetails workloadUpdate serviceDetails serviceUpdate appSpans serviceSpans workloadSpans appTraces serviceTraces workloadTraces errorTraces workloadValidations appList serviceMetrics aggregateMetrics appMetrics workloadMetrics istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype serviceList appDetails graphAggregate graphAggregateByService graphApp graphAppVersion graphNamespace graphService graphWorkload namespaceMetrics customDashboard appDashboard serviceDashboard workloadDashboard istioConfigCreate istioConfigCreateSubtype namespaceUpdate namespaceTls podDetails podLogs namespaceValidations podProxyDump podProxyResource podProxyLogging
+// swagger:parameters istioConfigList workloadList workloadDetails workloadUpdate serviceDetails serviceUpdate appSpans serviceSpans workloadSpans appTraces serviceTraces workloadTraces errorTraces workloadValidations appList serviceMetrics aggregateMetrics appMetrics workloadMetrics istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype serviceList appDetails graphAggregate graphAggregateByService graphApp graphAppVersion graphNamespace graphService graphWorkload namespaceMetrics customDashboard appDashboard serviceDashboard workloadDashboard istioConfigCreate istioConfigCreateSubtype namespaceUpdate namespaceTls podDetails podLogs namespaceValidations podProxyDump podProxyResource podProxyLogging istioConfigHistory istioConfigRevision istioConfigRollback workloadAccessLogs appAccessLogs podLogsStream serviceSLOs workloadSLOs appHealthTimeline serviceHealthTimeline workloadHealthTimeline workloadAuthorizationSimulation
 type NamespaceParam struct {
 	// The namespace name.
 	//
@@ -88,7 +90,7 @@ type NamespaceParam struct {
 	Name string `json:"namespace"`
 }
 
-// swagger:parameters istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype
+// swagger:parameters istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype istioConfigHistory istioConfigRevision istioConfigRollback
 type ObjectNameParam struct {
 	// The Istio object name.
 	//
@@ -97,7 +99,7 @@ type ObjectNameParam struct {
 	Name string `json:"object"`
 }
 
-// swagger:parameters istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype istioConfigCreate istioConfigCreateSubtype
+// swagger:parameters istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype istioConfigCreate istioConfigCreateSubtype istioConfigHistory istioConfigRevision istioConfigRollback
 type ObjectTypeParam struct {
 	// The Istio object type.
 	//
@@ -107,6 +109,128 @@ type ObjectTypeParam struct {
 	Name string `json:"object_type"`
 }
 
+// swagger:parameters istioConfigRevision istioConfigRollback
+type RevisionParam struct {
+	// The revision number.
+	//
+	// in: path
+	// required: true
+	Name string `json:"revision"`
+}
+
+// swagger:parameters istioConfigRollback
+type ForceRollbackParam struct {
+	// Re-apply the revision even if it would introduce validation errors.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Name bool `json:"force"`
+}
+
+// swagger:parameters istioConfigUpdate istioConfigCreate
+type DryRunParam struct {
+	// Submit the change with the server-side dry-run option, and return the changes on the object and the validation findings the change would introduce or resolve, instead of persisting it.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Name bool `json:"dryRun"`
+}
+
+// swagger:parameters istioConfigImport
+type ImportNamespaceParam struct {
+	// The namespace of the objects of the bundle without namespace.
+	//
+	// in: query
+	// required: false
+	Name string `json:"namespace"`
+}
+
+// swagger:parameters istioConfigImport
+type ImportDryRunParam struct {
+	// Submit the objects with the server-side dry-run option and validate them, instead of applying them.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Name bool `json:"dryRun"`
+}
+
+// swagger:parameters istioConfigImport
+type ForceImportParam struct {
+	// Apply the bundle even if it would introduce validation errors.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Name bool `json:"force"`
+}
+
+// swagger:parameters istioConfigOfflineValidations
+type OfflineValidationsNamespaceParam struct {
+	// The namespace of the objects of the bundle without namespace.
+	//
+	// in: query
+	// required: false
+	Name string `json:"namespace"`
+}
+
+// swagger:parameters istioConfigOfflineValidations
+type OfflineValidationsFormatParam struct {
+	// Format of the report: json, sarif or junit.
+	//
+	// in: query
+	// required: false
+	// default: json
+	Name string `json:"format"`
+}
+
+// swagger:parameters workloadAuthorizationSimulation
+type AuthorizationRequestParam struct {
+	// The source identity and the request to evaluate.
+	//
+	// in: body
+	// required: true
+	Body models.AuthorizationRequest
+}
+
+// swagger:parameters istioConfigExport
+type ExportNamespacesParam struct {
+	// Comma separated list of the namespaces to export. All the accessible namespaces by default.
+	//
+	// in: query
+	// required: false
+	Name string `json:"namespaces"`
+}
+
+// swagger:parameters istioConfigExport
+type ExportObjectsParam struct {
+	// Comma separated list of the Istio object types to export (e.g. virtualservices,destinationrules). All the types by default.
+	//
+	// in: query
+	// required: false
+	Name string `json:"objects"`
+}
+
+// swagger:parameters istioConfigExport
+type ExportLabelSelectorParam struct {
+	// Export only the objects matching this label selector.
+	//
+	// in: query
+	// required: false
+	Name string `json:"labelSelector"`
+}
+
+// swagger:parameters istioConfigExport
+type ExportWorkloadSelectorParam struct {
+	// Export only the objects applying to the workloads with these labels, in the format label1=value1,label2=value2.
+	//
+	// in: query
+	// required: false
+	Name string `json:"workloadSelector"`
+}
+
 // swagger:parameters istioConfigList istioConfigDetails serviceDetails serviceUpdate
 type ValidateParam struct {
 	// Enable validation or not
@@ -116,7 +240,7 @@ type ValidateParam struct {
 	Name string `json:"validate"`
 }
 
-// swagger:parameters podDetails podLogs podProxyDump podProxyResource podProxyLogging
+// swagger:parameters podDetails podLogs podProxyDump podProxyResource podProxyLogging podLogsStream
 type PodParam struct {
 	// The pod name.
 	//
@@ -134,7 +258,7 @@ type ResourceParam struct {
 	Name string `json:"resource"`
 }
 
-// swagger:parameters serviceDetails serviceUpdate serviceMetrics graphService graphAggregateByService serviceDashboard serviceSpans serviceTraces
+// swagger:parameters serviceDetails serviceUpdate serviceMetrics graphService graphAggregateByService serviceDashboard serviceSpans serviceTraces serviceSLOs serviceHealthTimeline
 type ServiceParam struct {
 	// The service name.
 	//
@@ -143,16 +267,16 @@ type ServiceParam struct {
 	Name string `json:"service"`
 }
 
-// swagger:parameters podLogs
+// swagger:parameters podLogs workloadAccessLogs appAccessLogs
 type SinceTimeParam struct {
-	// The start time for fetching logs. UNIX time in seconds. Default is all logs.
+	// The start time for fetching logs. UNIX time in seconds. Default is all logs, or the last hour for the access logs.
 	//
 	// in: query
 	// required: false
 	Name string `json:"sinceTime"`
 }
 
-// swagger:parameters podLogs
+// swagger:parameters podLogs workloadAccessLogs appAccessLogs
 type DurationLogParam struct {
 	// Query time-range duration (Golang string duration). Duration starts on
 	// `sinceTime` if set, or the time for the first log message if not set.
@@ -162,6 +286,110 @@ type DurationLogParam struct {
 	Name string `json:"duration"`
 }
 
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogMaxEntriesParam struct {
+	// Maximum number of entries to return. The logs of each pod are read until this number of entries match, the summary
+	// includes all the matching entries read.
+	//
+	// in: query
+	// required: false
+	// default: 1000
+	Name string `json:"maxEntries"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogStatusCodesParam struct {
+	// Comma separated list of the response codes (404), classes (5xx) or ranges (400-404) to return.
+	//
+	// in: query
+	// required: false
+	Name string `json:"statusCodes"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogResponseFlagsParam struct {
+	// Comma separated list of Envoy response flags (e.g. UH,UF). The entries having any of them are returned.
+	//
+	// in: query
+	// required: false
+	Name string `json:"responseFlags"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogUpstreamClusterParam struct {
+	// Return only the entries whose upstream cluster contains this string.
+	//
+	// in: query
+	// required: false
+	Name string `json:"upstreamCluster"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogPathRegexParam struct {
+	// Return only the entries whose path matches this regular expression.
+	//
+	// in: query
+	// required: false
+	Name string `json:"pathRegex"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogMinDurationParam struct {
+	// Return only the entries of the requests that took at least this duration (Golang string duration).
+	//
+	// in: query
+	// required: false
+	Name string `json:"minDuration"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogMaxDurationParam struct {
+	// Return only the entries of the requests that took at most this duration (Golang string duration).
+	//
+	// in: query
+	// required: false
+	Name string `json:"maxDuration"`
+}
+
+// swagger:parameters workloadAccessLogs appAccessLogs
+type AccessLogTopPathsParam struct {
+	// Number of paths with the most 5xx responses in the summary.
+	//
+	// in: query
+	// required: false
+	// default: 10
+	Name string `json:"topPaths"`
+}
+
+// swagger:parameters podLogsStream
+type ContainersParam struct {
+	// Comma separated list of the pod containers to follow, the istio-proxy included. All the containers by default.
+	//
+	// in: query
+	// required: false
+	Name string `json:"containers"`
+}
+
+// swagger:parameters podLogsStream
+type TailLinesParam struct {
+	// Number of past lines of each container sent before the new ones. Ignored when resuming a stream with the Last-Event-ID header.
+	//
+	// in: query
+	// required: false
+	// default: 100
+	Name string `json:"tailLines"`
+}
+
+// swagger:parameters podLogsStream
+type IdleTimeoutParam struct {
+	// Duration without new lines after which the stream ends (Golang string duration).
+	//
+	// in: query
+	// required: false
+	// default: 20s
+	Name string `json:"idleTimeout"`
+}
+
 // swagger:parameters traceDetails
 type TraceIDParam struct {
 	// The trace ID.
@@ -180,7 +408,7 @@ type DashboardParam struct {
 	Name string `json:"dashboard"`
 }
 
-// swagger:parameters workloadDetails workloadUpdate workloadValidations workloadMetrics graphWorkload workloadDashboard workloadSpans workloadTraces
+// swagger:parameters workloadDetails workloadUpdate workloadValidations workloadMetrics graphWorkload workloadDashboard workloadSpans workloadTraces workloadAccessLogs workloadSLOs workloadHealthTimeline workloadAuthorizationSimulation
 type WorkloadParam struct {
 	// The workload name.
 	//
@@ -194,9 +422,56 @@ type WorkloadParam struct {
 // - keep this alphabetized
 /////////////////////
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphDependencies
+type DependencyDirectionParam struct {
+	// The direction of the dependencies. One of: downstream (the nodes the node sends traffic to) | upstream (the nodes sending traffic to the node).
+	//
+	// in: query
+	// required: false
+	// default: downstream
+	Name string `json:"direction"`
+}
+
+// swagger:parameters graphDependencies
+type DependencyNodeParam struct {
+	// The queried node, as <app|service|workload>:<namespace>/<name>[/<version>] (e.g. workload:bookinfo/reviews-v1).
+	//
+	// in: query
+	// required: true
+	Name string `json:"node"`
+}
+
+// swagger:parameters graphPath
+type PathSourceParam struct {
+	// The source node of the path, as <app|service|workload>:<namespace>/<name>[/<version>].
+	//
+	// in: query
+	// required: true
+	Name string `json:"source"`
+}
+
+// swagger:parameters graphPath
+type PathTargetParam struct {
+	// The target node of the path, as <app|service|workload>:<namespace>/<name>[/<version>].
+	//
+	// in: query
+	// required: true
+	Name string `json:"target"`
+}
+
+// swagger:parameters graphNamespacesStream
+type RefreshIntervalParam struct {
+	// Interval between the refreshes of a streamed graph (Golang string duration). The minimum is 5s.
+	//
+	// in: query
+	// required: false
+	// default: 10s
+	Name string `json:"refreshInterval"`
+}
+
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type AppendersParam struct {
-	// Comma-separated list of Appenders to run. Available appenders: [aggregateNode, deadNode, healthConfig, idleNode, istio, responseTime, securityPolicy, serviceEntry, sidecarsCheck, throughput].
+	// Comma-separated list of Appenders to run. Available appenders: [aggregateNode, anomaly, deadNode, healthConfig, idleNode, istio, responseTime, securityPolicy, serviceEntry, sidecarsCheck, throughput].
 	//
 	// in: query
 	// required: false
@@ -205,6 +480,16 @@ type AppendersParam struct {
 }
 
 // swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type AnomalyOffsetParam struct {
+	// Used only with the anomaly appender. Time offset of the baseline window compared with the graph window (e.g. 1h, 7d).
+	//
+	// in: query
+	// required: false
+	// default: 1d
+	Name string `json:"anomalyOffset"`
+}
+
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type BoxByParam struct {
 	// Comma-separated list of desired node boxing. Available boxings: [app, cluster, namespace].
 	//
@@ -213,7 +498,7 @@ type BoxByParam struct {
 	Name string `json:"boxBy"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type DurationGraphParam struct {
 	// Query time-range duration (Golang string duration).
 	//
@@ -223,7 +508,7 @@ type DurationGraphParam struct {
 	Name string `json:"duration"`
 }
 
-// swagger:parameters graphNamespaces graphService graphWorkload
+// swagger:parameters graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type GraphTypeParam struct {
 	// Graph type. Available graph types: [app, service, versionedApp, workload].
 	//
@@ -242,7 +527,7 @@ type AppGraphTypeParam struct {
 	Name string `json:"graphType"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type IncludeIdleEdges struct {
 	// Flag for including edges that have no request traffic for the time period.
 	//
@@ -252,7 +537,7 @@ type IncludeIdleEdges struct {
 	Name string `json:"includeIdleEdges"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type InjectServiceNodes struct {
 	// Flag for injecting the requested service node between source and destination nodes.
 	//
@@ -262,7 +547,7 @@ type InjectServiceNodes struct {
 	Name string `json:"injectServiceNodes"`
 }
 
-// swagger:parameters graphNamespaces
+// swagger:parameters graphNamespaces graphCycles graphDependencies graphNamespacesStream graphPath
 type NamespacesParam struct {
 	// Comma-separated list of namespaces to include in the graph. The namespaces must be accessible to the client.
 	//
@@ -272,6 +557,24 @@ type NamespacesParam struct {
 }
 
 // swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type CompareOffsetParam struct {
+	// Compare the graph with a baseline window ending compareOffset before queryTime (e.g. 1h). Nodes and edges are annotated with a diff.
+	//
+	// in: query
+	// required: false
+	Name string `json:"compareOffset"`
+}
+
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type CompareTimeParam struct {
+	// Compare the graph with a baseline window ending at this unix time (seconds). Nodes and edges are annotated with a diff.
+	//
+	// in: query
+	// required: false
+	Name string `json:"compareTime"`
+}
+
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath serviceSLOs workloadSLOs appHealthTimeline serviceHealthTimeline workloadHealthTimeline
 type QueryTimeParam struct {
 	// Unix time (seconds) for query such that time range is [queryTime-duration..queryTime]. Default is now.
 	//
@@ -281,7 +584,7 @@ type QueryTimeParam struct {
 	Name string `json:"queryTime"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type RateGrpcParam struct {
 	// How to calculate gRPC traffic rate. One of: none | received (i.e. response_messages) | requests | sent (i.e. request_messages) | total (i.e. sent+received).
 	//
@@ -291,7 +594,7 @@ type RateGrpcParam struct {
 	Name string `json:"rateGrpc"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type RateHttpParam struct {
 	// How to calculate HTTP traffic rate. One of: none | requests.
 	//
@@ -301,7 +604,7 @@ type RateHttpParam struct {
 	Name string `json:"rateHttp"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type RateTcpParam struct {
 	// How to calculate TCP traffic rate. One of: none | received (i.e. received_bytes) | sent (i.e. sent_bytes) | total (i.e. sent+received).
 	//
@@ -311,7 +614,7 @@ type RateTcpParam struct {
 	Name string `json:"rateTcp"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type ResponseTimeParam struct {
 	// Used only with responseTime appender. One of: avg | 50 | 95 | 99.
 	//
@@ -321,7 +624,7 @@ type ResponseTimeParam struct {
 	Name string `json:"responseTime"`
 }
 
-// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload graphCycles graphDependencies graphNamespacesStream graphPath
 type ThroughputParam struct {
 	// Used only with throughput appender. One of: request | response.
 	//
@@ -425,7 +728,7 @@ type RateFuncParam struct {
 	Name string `json:"rateFunc"`
 }
 
-// swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics customDashboard appDashboard serviceDashboard workloadDashboard
+// swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics customDashboard appDashboard serviceDashboard workloadDashboard appHealthTimeline serviceHealthTimeline workloadHealthTimeline
 type RateIntervalParam struct {
 	// Interval used for rate and histogram calculation.
 	//
@@ -455,6 +758,15 @@ type ReporterParam struct {
 	Name string `json:"reporter"`
 }
 
+// swagger:parameters revokeSession
+type SessionParam struct {
+	// The id of the session, as returned by the sessions list.
+	//
+	// in: path
+	// required: true
+	Name string `json:"session"`
+}
+
 // swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics customDashboard appDashboard serviceDashboard workloadDashboard
 type StepParam struct {
 	// Step between [graph] datapoints, in seconds.
@@ -465,6 +777,26 @@ type StepParam struct {
 	Name int `json:"step"`
 }
 
+// swagger:parameters appHealthTimeline serviceHealthTimeline workloadHealthTimeline
+type HealthTimelineDurationParam struct {
+	// Duration of the health timeline, in seconds.
+	//
+	// in: query
+	// required: false
+	// default: 3600
+	Name int `json:"duration"`
+}
+
+// swagger:parameters appHealthTimeline serviceHealthTimeline workloadHealthTimeline
+type HealthTimelineStepParam struct {
+	// Step between the evaluations of the health timeline, in seconds. A timeline is limited to 1000 steps.
+	//
+	// in: query
+	// required: false
+	// default: 60
+	Name int `json:"step"`
+}
+
 // swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics
 type VersionParam struct {
 	// Filters metrics by the specified version.
@@ -533,6 +865,34 @@ type NotAcceptableError struct {
 	} `json:"body"`
 }
 
+// A RequestEntityTooLargeError is the error message that is generated when the request body exceeds the allowed size.
+//
+// swagger:response requestEntityTooLargeError
+type RequestEntityTooLargeError struct {
+	// in: body
+	Body struct {
+		// HTTP status code
+		// example: 413
+		// default: 413
+		Code    int32 `json:"code"`
+		Message error `json:"message"`
+	} `json:"body"`
+}
+
+// A ForbiddenError is the error message that is generated when the user is not allowed to perform the request.
+//
+// swagger:response forbiddenError
+type ForbiddenError struct {
+	// in: body
+	Body struct {
+		// HTTP status code
+		// example: 403
+		// default: 403
+		Code    int32 `json:"code"`
+		Message error `json:"message"`
+	} `json:"body"`
+}
+
 // A Internal is the error message that means something has gone wrong
 //
 // swagger:response internalError
@@ -575,6 +935,13 @@ type swaggTokenGeneratedResp struct {
 	Body authentication.UserSessionData
 }
 
+// Listing all active sessions of a server-side session store
+// swagger:response sessionsResponse
+type SessionsResponse struct {
+	// in:body
+	Body []authentication.StoredSession
+}
+
 // HTTP status code 200 and cytoscapejs Config in data
 // swagger:response graphResponse
 type GraphResponse struct {
@@ -582,6 +949,20 @@ type GraphResponse struct {
 	Body cytoscape.Config
 }
 
+// HTTP status code 200 and the dependencies with their subgraph in data
+// swagger:response graphDependenciesResponse
+type GraphDependenciesResponse struct {
+	// in:body
+	Body api.DependenciesResponse
+}
+
+// HTTP status code 200 and the traffic cycles with their subgraph in data
+// swagger:response graphCyclesResponse
+type GraphCyclesResponse struct {
+	// in:body
+	Body api.CyclesResponse
+}
+
 // HTTP status code 200 and IstioConfigList model in data
 // swagger:response istioConfigList
 type IstioConfigResponse struct {
@@ -680,6 +1061,83 @@ type IstioConfigDetailsResponse struct {
 	Body models.IstioConfigDetails
 }
 
+// Revisions of an Istio Object, without the objects
+// swagger:response istioConfigRevisionsResponse
+type IstioConfigRevisionsResponse struct {
+	// in:body
+	Body []models.IstioConfigRevision
+}
+
+// Revision of an Istio Object
+// swagger:response istioConfigRevisionResponse
+type IstioConfigRevisionResponse struct {
+	// in:body
+	Body models.IstioConfigRevision
+}
+
+// Dry-run result of the update or creation of an Istio Object
+// swagger:response istioConfigPreviewResponse
+type IstioConfigPreviewResponse struct {
+	// in:body
+	Body models.IstioConfigPreview
+}
+
+// Result of the import of a bundle of Istio Objects
+// swagger:response istioConfigImportResponse
+type IstioConfigImportResponse struct {
+	// in:body
+	Body models.IstioConfigImportResult
+}
+
+// Report of the offline validation of a bundle of manifests
+// swagger:response istioConfigOfflineValidationsResponse
+type IstioConfigOfflineValidationsResponse struct {
+	// in:body
+	Body models.OfflineValidationReport
+}
+
+// Apply-ready multi-document YAML of Istio Objects
+// swagger:response istioConfigExportResponse
+type IstioConfigExportResponse struct {
+	// in:body
+	Body string
+}
+
+// Log lines of the containers of a pod, streamed as server-sent events
+// swagger:response podLogsStreamResponse
+type PodLogsStreamResponse struct {
+	// in:body
+	Body business.FollowedLogEntry
+}
+
+// Request health of an app, a service or a workload at every step of a time range
+// swagger:response healthTimelineResponse
+type HealthTimelineResponse struct {
+	// in:body
+	Body models.HealthTimeline
+}
+
+// Compliance, remaining error budget and burn rates of the Service Level Objectives of a service or a workload
+// swagger:response sloListResponse
+type SLOListResponse struct {
+	// in:body
+	Body models.SLOList
+}
+
+// Decision on a request to a workload, with the AuthorizationPolicies that apply to the workload
+// swagger:response authorizationDecisionResponse
+type AuthorizationDecisionResponse struct {
+	// in:body
+	Body models.AuthorizationDecision
+}
+
+// Access log entries of the pods of a workload or an app, with their summary
+// swagger:response accessLogsResponse
+type AccessLogsResponse struct {
+	// in:body
+	Body business.AccessLogs
+}
+
 // Detailed information of an specific app
 // swagger:response appDetails
 type AppDetailsResponse struct {
@@ -797,6 +1255,78 @@ type MetricsStatsResponse struct {
 	Body models.MetricsStats
 }
 
+// swagger:parameters auditLog
+type AuditUserParam struct {
+	// Return only the entries of the operations performed by this user.
+	//
+	// in: query
+	// required: false
+	Name string `json:"user"`
+}
+
+// swagger:parameters auditLog
+type AuditNamespaceParam struct {
+	// Return only the entries of the operations on objects of this namespace.
+	//
+	// in: query
+	// required: false
+	Name string `json:"namespace"`
+}
+
+// swagger:parameters auditLog
+type AuditObjectTypeParam struct {
+	// Return only the entries of the operations on objects of this type (e.g. virtualservices, workloads).
+	//
+	// in: query
+	// required: false
+	Name string `json:"objectType"`
+}
+
+// swagger:parameters auditLog
+type AuditFromParam struct {
+	// Return only the entries of the operations performed at or after this time (unix time in seconds).
+	//
+	// in: query
+	// required: false
+	Name string `json:"from"`
+}
+
+// swagger:parameters auditLog
+type AuditToParam struct {
+	// Return only the entries of the operations performed at or before this time (unix time in seconds).
+	//
+	// in: query
+	// required: false
+	Name string `json:"to"`
+}
+
+// swagger:parameters auditLog
+type AuditOffsetParam struct {
+	// Number of matching entries to skip, the entries being sorted from the newest to the oldest.
+	//
+	// in: query
+	// required: false
+	// default: 0
+	Name string `json:"offset"`
+}
+
+// swagger:parameters auditLog
+type AuditLimitParam struct {
+	// Maximum number of entries to return. The maximum is 1000.
+	//
+	// in: query
+	// required: false
+	// default: 100
+	Name string `json:"limit"`
+}
+
+// Page of the audit log entries
+// swagger:response auditResponse
+type AuditResponse struct {
+	// in: body
+	Body audit.Page
+}
+
 // swagger:enum ProxyLogLevel
 type ProxyLogLevel string
 
@@ -4,16 +4,45 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/graph"
 	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/graph/config/dot"
+	"github.com/kiali/kiali/graph/config/graphml"
+	"github.com/kiali/kiali/graph/config/mermaid"
 	"github.com/kiali/kiali/graph/telemetry/istio"
 	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/prometheus"
 	"github.com/kiali/kiali/prometheus/internalmetrics"
 )
 
+var (
+	graphCache     graph.TrafficMapCache
+	graphCacheOnce sync.Once
+)
+
+// buildNamespacesTrafficMap returns the namespaces TrafficMap from the graph cache, if enabled, or builds it.
+// The returned TrafficMap can be shared with other requests and must not be modified.
+func buildNamespacesTrafficMap(o graph.Options, prom *prometheus.Client, globalInfo *graph.AppenderGlobalInfo) graph.TrafficMap {
+	if !config.Get().GraphCache.Enabled {
+		return istio.BuildNamespacesTrafficMap(o.TelemetryOptions, prom, globalInfo)
+	}
+
+	graphCacheOnce.Do(func() {
+		log.Infof("[Graph Cache] Enabled")
+		graphCache = graph.NewTrafficMapCache()
+	})
+	if isCached, trafficMap := graphCache.Get(o.TelemetryOptions); isCached {
+		return trafficMap
+	}
+	trafficMap := istio.BuildNamespacesTrafficMap(o.TelemetryOptions, prom, globalInfo)
+	graphCache.Set(o.TelemetryOptions, trafficMap)
+	return trafficMap
+}
+
 // GraphNamespaces generates a namespaces graph using the provided options
 func GraphNamespaces(ctx context.Context, business *business.Layer, o graph.Options) (code int, config interface{}) {
 	// time how long it takes to generate this graph
@@ -43,7 +72,16 @@ func graphNamespacesIstio(ctx context.Context, business *business.Layer, prom *p
 	globalInfo.Business = business
 	globalInfo.Context = ctx
 
-	trafficMap := istio.BuildNamespacesTrafficMap(o.TelemetryOptions, prom, globalInfo)
+	var trafficMap graph.TrafficMap
+	if o.CompareTime == 0 {
+		trafficMap = buildNamespacesTrafficMap(o, prom, globalInfo)
+	} else {
+		// the comparison modifies the TrafficMap, so it can't be served from the cache
+		trafficMap = istio.BuildNamespacesTrafficMap(o.TelemetryOptions, prom, globalInfo)
+		baselineOptions := o.BaselineOptions()
+		baselineTrafficMap := istio.BuildNamespacesTrafficMap(baselineOptions.TelemetryOptions, prom, newBaselineGlobalInfo(globalInfo))
+		trafficMap = graph.DiffTrafficMaps(trafficMap, baselineTrafficMap)
+	}
 	code, config = generateGraph(trafficMap, o)
 
 	return code, config
@@ -82,11 +120,27 @@ func graphNodeIstio(ctx context.Context, business *business.Layer, client *prome
 	globalInfo.Context = ctx
 
 	trafficMap := istio.BuildNodeTrafficMap(o.TelemetryOptions, client, globalInfo)
+	if o.CompareTime != 0 {
+		baselineOptions := o.BaselineOptions()
+		baselineTrafficMap := istio.BuildNodeTrafficMap(baselineOptions.TelemetryOptions, client, newBaselineGlobalInfo(globalInfo))
+		trafficMap = graph.DiffTrafficMaps(trafficMap, baselineTrafficMap)
+	}
 	code, config = generateGraph(trafficMap, o)
 
 	return code, config
 }
 
+// newBaselineGlobalInfo returns the global info used to build the baseline graph when comparing windows.
+// Appenders cache telemetry in the vendor info, so only the clients and the home cluster are shared.
+func newBaselineGlobalInfo(globalInfo *graph.AppenderGlobalInfo) *graph.AppenderGlobalInfo {
+	baselineInfo := graph.NewAppenderGlobalInfo()
+	baselineInfo.Business = globalInfo.Business
+	baselineInfo.Context = globalInfo.Context
+	baselineInfo.HomeCluster = globalInfo.HomeCluster
+	baselineInfo.PromClient = globalInfo.PromClient
+	return baselineInfo
+}
+
 func generateGraph(trafficMap graph.TrafficMap, o graph.Options) (int, interface{}) {
 	log.Tracef("Generating config for [%s] graph...", o.ConfigVendor)
 
@@ -97,6 +151,12 @@ func generateGraph(trafficMap graph.TrafficMap, o graph.Options) (int, interface
 	switch o.ConfigVendor {
 	case graph.VendorCytoscape:
 		vendorConfig = cytoscape.NewConfig(trafficMap, o.ConfigOptions)
+	case graph.VendorDot:
+		vendorConfig = dot.NewConfig(trafficMap, o.ConfigOptions)
+	case graph.VendorGraphML:
+		vendorConfig = graphml.NewConfig(trafficMap, o.ConfigOptions)
+	case graph.VendorMermaid:
+		vendorConfig = mermaid.NewConfig(trafficMap, o.ConfigOptions)
 	default:
 		graph.Error(fmt.Sprintf("ConfigVendor [%s] not supported", o.ConfigVendor))
 	}
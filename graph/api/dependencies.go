@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// NodeRef identifies the graph nodes used by the dependency queries
+type NodeRef struct {
+	NodeType  string
+	Namespace string
+	Name      string
+	Version   string // optional, for app nodes
+}
+
+// DependencyEntity is an app, service or workload found by a dependency query
+type DependencyEntity struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	NodeType  string `json:"nodeType"`
+	App       string `json:"app,omitempty"`
+	Service   string `json:"service,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Workload  string `json:"workload,omitempty"`
+}
+
+// DependenciesResponse is the result of a dependency closure or path query
+type DependenciesResponse struct {
+	// Entities are the nodes affected by the query, excluding the queried node for closures,
+	// or the nodes of the path, in traffic order.
+	Entities []DependencyEntity `json:"entities"`
+	// Graph is the subgraph holding the queried node(s) and the entities
+	Graph cytoscape.Config `json:"graph"`
+}
+
+// CyclesResponse is the result of a cycles query
+type CyclesResponse struct {
+	// Cycles are the groups of nodes sending traffic to each other, directly or transitively
+	Cycles [][]DependencyEntity `json:"cycles"`
+	// Graph is the subgraph holding all the nodes involved in cycles
+	Graph cytoscape.Config `json:"graph"`
+}
+
+// ParseNodeRef parses a node reference with the format <nodeType>:<namespace>/<name>[/<version>],
+// for example workload:bookinfo/reviews-v1 or app:bookinfo/reviews/v1
+func ParseNodeRef(param, value string) NodeRef {
+	invalid := fmt.Sprintf("Invalid %s [%s], expected <app|service|workload>:<namespace>/<name>[/<version>]", param, value)
+
+	kindAndPath := strings.SplitN(value, ":", 2)
+	if len(kindAndPath) != 2 {
+		graph.BadRequest(invalid)
+	}
+	ref := NodeRef{NodeType: kindAndPath[0]}
+	if ref.NodeType != graph.NodeTypeApp && ref.NodeType != graph.NodeTypeService && ref.NodeType != graph.NodeTypeWorkload {
+		graph.BadRequest(invalid)
+	}
+
+	parts := strings.Split(kindAndPath[1], "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" || (len(parts) == 3 && ref.NodeType != graph.NodeTypeApp) {
+		graph.BadRequest(invalid)
+	}
+	ref.Namespace = parts[0]
+	ref.Name = parts[1]
+	if len(parts) == 3 {
+		ref.Version = parts[2]
+	}
+	return ref
+}
+
+// GraphDependencies returns the upstream or downstream closure of a node of the namespaces graph
+func GraphDependencies(ctx context.Context, business *business.Layer, o graph.Options, node NodeRef, direction string) (code int, response interface{}) {
+	trafficMap := buildDependenciesTrafficMap(ctx, business, o)
+	return http.StatusOK, dependencies(trafficMap, o, node, direction)
+}
+
+// GraphPath returns the shortest traffic path between two nodes of the namespaces graph
+func GraphPath(ctx context.Context, business *business.Layer, o graph.Options, source, target NodeRef) (code int, response interface{}) {
+	trafficMap := buildDependenciesTrafficMap(ctx, business, o)
+	return http.StatusOK, path(trafficMap, o, source, target)
+}
+
+// GraphCycles returns the traffic cycles of the namespaces graph
+func GraphCycles(ctx context.Context, business *business.Layer, o graph.Options) (code int, response interface{}) {
+	trafficMap := buildDependenciesTrafficMap(ctx, business, o)
+	return http.StatusOK, cycles(trafficMap, o)
+}
+
+// buildDependenciesTrafficMap builds the TrafficMap queried by the dependency requests
+func buildDependenciesTrafficMap(ctx context.Context, business *business.Layer, o graph.Options) (trafficMap graph.TrafficMap) {
+	switch o.TelemetryVendor {
+	case graph.VendorIstio:
+		prom, err := prometheus.NewClient()
+		graph.CheckError(err)
+
+		// Create a 'global' object to store the business. Global only to the request.
+		globalInfo := graph.NewAppenderGlobalInfo()
+		globalInfo.Business = business
+		globalInfo.Context = ctx
+
+		trafficMap = buildNamespacesTrafficMap(o, prom, globalInfo)
+	default:
+		graph.Error(fmt.Sprintf("TelemetryVendor [%s] not supported", o.TelemetryVendor))
+	}
+	return trafficMap
+}
+
+func dependencies(trafficMap graph.TrafficMap, o graph.Options, node NodeRef, direction string) DependenciesResponse {
+	roots := findNodes(trafficMap, node)
+	closure := graph.Closure(trafficMap, roots, direction)
+
+	isRoot := make(map[string]bool)
+	for _, r := range roots {
+		isRoot[r.ID] = true
+	}
+	nodes := make([]*graph.Node, 0, len(closure))
+	entities := []DependencyEntity{}
+	for _, n := range closure {
+		nodes = append(nodes, n)
+		if !isRoot[n.ID] {
+			entities = append(entities, newDependencyEntity(n))
+		}
+	}
+	sortEntities(entities)
+
+	return DependenciesResponse{
+		Entities: entities,
+		Graph:    cytoscape.NewConfig(graph.Subgraph(nodes, false), o.ConfigOptions),
+	}
+}
+
+func path(trafficMap graph.TrafficMap, o graph.Options, source, target NodeRef) DependenciesResponse {
+	nodes := graph.ShortestPath(findNodes(trafficMap, source), findNodes(trafficMap, target))
+
+	entities := []DependencyEntity{}
+	for _, n := range nodes {
+		entities = append(entities, newDependencyEntity(n))
+	}
+
+	return DependenciesResponse{
+		Entities: entities,
+		Graph:    cytoscape.NewConfig(graph.Subgraph(nodes, true), o.ConfigOptions),
+	}
+}
+
+func cycles(trafficMap graph.TrafficMap, o graph.Options) CyclesResponse {
+	response := CyclesResponse{Cycles: [][]DependencyEntity{}}
+	nodes := []*graph.Node{}
+	for _, cycle := range graph.Cycles(trafficMap) {
+		entities := []DependencyEntity{}
+		for _, n := range cycle {
+			entities = append(entities, newDependencyEntity(n))
+		}
+		sortEntities(entities)
+		response.Cycles = append(response.Cycles, entities)
+		nodes = append(nodes, cycle...)
+	}
+	response.Graph = cytoscape.NewConfig(graph.Subgraph(nodes, false), o.ConfigOptions)
+	return response
+}
+
+// findNodes returns the nodes matching the reference, or responds with NotFound
+func findNodes(trafficMap graph.TrafficMap, ref NodeRef) []*graph.Node {
+	nodes := graph.FindNodes(trafficMap, ref.NodeType, "", ref.Namespace, ref.Name, ref.Version)
+	if len(nodes) == 0 {
+		graph.Panic(fmt.Sprintf("No %s [%s] found in namespace [%s] of the graph", ref.NodeType, ref.Name, ref.Namespace), http.StatusNotFound)
+	}
+	return nodes
+}
+
+func newDependencyEntity(n *graph.Node) DependencyEntity {
+	return DependencyEntity{
+		Cluster:   n.Cluster,
+		Namespace: n.Namespace,
+		NodeType:  n.NodeType,
+		App:       n.App,
+		Service:   n.Service,
+		Version:   n.Version,
+		Workload:  n.Workload,
+	}
+}
+
+func sortEntities(entities []DependencyEntity) {
+	sort.Slice(entities, func(i, j int) bool {
+		a, b := entities[i], entities[j]
+		switch {
+		case a.Cluster != b.Cluster:
+			return a.Cluster < b.Cluster
+		case a.Namespace != b.Namespace:
+			return a.Namespace < b.Namespace
+		case a.NodeType != b.NodeType:
+			return a.NodeType < b.NodeType
+		case a.App != b.App:
+			return a.App < b.App
+		case a.Service != b.Service:
+			return a.Service < b.Service
+		case a.Version != b.Version:
+			return a.Version < b.Version
+		default:
+			return a.Workload < b.Workload
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/graph"
+)
+
+func TestParseNodeRef(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(NodeRef{NodeType: graph.NodeTypeWorkload, Namespace: "bookinfo", Name: "reviews-v1"}, ParseNodeRef("node", "workload:bookinfo/reviews-v1"))
+	assert.Equal(NodeRef{NodeType: graph.NodeTypeApp, Namespace: "bookinfo", Name: "reviews", Version: "v1"}, ParseNodeRef("node", "app:bookinfo/reviews/v1"))
+	assert.Equal(NodeRef{NodeType: graph.NodeTypeService, Namespace: "bookinfo", Name: "reviews"}, ParseNodeRef("node", "service:bookinfo/reviews"))
+
+	for _, invalid := range []string{"", "bookinfo/reviews", "box:bookinfo/reviews", "workload:bookinfo", "workload:/reviews", "service:bookinfo/reviews/v1", "app:bookinfo/reviews/v1/x"} {
+		assert.PanicsWithValue(graph.Response{
+			Message: "Invalid node [" + invalid + "], expected <app|service|workload>:<namespace>/<name>[/<version>]",
+			Code:    http.StatusBadRequest,
+		}, func() { ParseNodeRef("node", invalid) }, invalid)
+	}
+}
+
+func buildDependenciesTestTrafficMap() graph.TrafficMap {
+	trafficMap := graph.NewTrafficMap()
+	productpage := graph.NewNode("east", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeWorkload)
+	reviews := graph.NewNode("east", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeWorkload)
+	ratings := graph.NewNode("east", "bookinfo", "", "bookinfo", "ratings-v1", "ratings", "v1", graph.GraphTypeWorkload)
+	trafficMap[productpage.ID] = &productpage
+	trafficMap[reviews.ID] = &reviews
+	trafficMap[ratings.ID] = &ratings
+	for _, e := range []*graph.Edge{productpage.AddEdge(&reviews), reviews.AddEdge(&ratings), ratings.AddEdge(&reviews)} {
+		e.Metadata[graph.ProtocolKey] = "http"
+		e.Metadata[graph.MetadataKey("http")] = 10.0
+		e.Metadata[graph.HTTP.EdgeResponses] = graph.Responses{}
+	}
+	return trafficMap
+}
+
+func TestDependencies(t *testing.T) {
+	assert := assert.New(t)
+
+	o := graph.Options{}
+	node := NodeRef{NodeType: graph.NodeTypeWorkload, Namespace: "bookinfo", Name: "reviews-v1"}
+
+	downstream := dependencies(buildDependenciesTestTrafficMap(), o, node, graph.Downstream)
+	assert.Equal([]DependencyEntity{
+		{Cluster: "east", Namespace: "bookinfo", NodeType: graph.NodeTypeWorkload, App: "ratings", Version: "v1", Workload: "ratings-v1"},
+	}, downstream.Entities)
+	assert.Len(downstream.Graph.Elements.Nodes, 2)
+	assert.Len(downstream.Graph.Elements.Edges, 2)
+
+	upstream := dependencies(buildDependenciesTestTrafficMap(), o, node, graph.Upstream)
+	assert.Len(upstream.Entities, 2)
+	assert.Equal("productpage-v1", upstream.Entities[0].Workload)
+	assert.Equal("ratings-v1", upstream.Entities[1].Workload)
+	assert.Len(upstream.Graph.Elements.Nodes, 3)
+
+	assert.PanicsWithValue(graph.Response{
+		Message: "No workload [details-v1] found in namespace [bookinfo] of the graph",
+		Code:    http.StatusNotFound,
+	}, func() {
+		dependencies(buildDependenciesTestTrafficMap(), o, NodeRef{NodeType: graph.NodeTypeWorkload, Namespace: "bookinfo", Name: "details-v1"}, graph.Downstream)
+	})
+}
+
+func TestPathAndCycles(t *testing.T) {
+	assert := assert.New(t)
+
+	o := graph.Options{}
+	source := NodeRef{NodeType: graph.NodeTypeWorkload, Namespace: "bookinfo", Name: "productpage-v1"}
+	target := NodeRef{NodeType: graph.NodeTypeApp, Namespace: "bookinfo", Name: "ratings"}
+
+	// app refs do not match workload nodes
+	assert.Panics(func() { path(buildDependenciesTestTrafficMap(), o, source, target) })
+
+	target = NodeRef{NodeType: graph.NodeTypeWorkload, Namespace: "bookinfo", Name: "ratings-v1"}
+	response := path(buildDependenciesTestTrafficMap(), o, source, target)
+	assert.Len(response.Entities, 3)
+	assert.Equal("productpage-v1", response.Entities[0].Workload)
+	assert.Equal("reviews-v1", response.Entities[1].Workload)
+	assert.Equal("ratings-v1", response.Entities[2].Workload)
+	assert.Len(response.Graph.Elements.Edges, 2)
+
+	response = path(buildDependenciesTestTrafficMap(), o, target, source)
+	assert.Empty(response.Entities)
+	assert.Empty(response.Graph.Elements.Nodes)
+
+	cyclesResponse := cycles(buildDependenciesTestTrafficMap(), o)
+	assert.Len(cyclesResponse.Cycles, 1)
+	assert.Len(cyclesResponse.Cycles[0], 2)
+	assert.Equal("ratings-v1", cyclesResponse.Cycles[0][0].Workload)
+	assert.Equal("reviews-v1", cyclesResponse.Cycles[0][1].Workload)
+	assert.Len(cyclesResponse.Graph.Elements.Nodes, 2)
+}
@@ -0,0 +1,358 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/internalmetrics"
+)
+
+// The types of the events pushed to graph stream subscribers
+const (
+	GraphStreamEventDelta string = "delta" // the node and edge changes since the previous graph
+	GraphStreamEventError string = "error" // the graph could not be refreshed, the previous graph is kept
+	GraphStreamEventGraph string = "graph" // the full graph, sent to subscribers not holding the previous graph
+)
+
+// graphStreamBuffer is the number of events a subscriber can fall behind. A subscriber with a full buffer
+// misses the event and receives the full graph on the next refresh.
+const graphStreamBuffer = 8
+
+// GraphStreamEvent is an event pushed to graph stream subscribers
+type GraphStreamEvent struct {
+	ID   string // identifies the graph held by the subscriber after the event, empty for errors
+	Type string
+	Data interface{} // cytoscape.Config, GraphDelta or GraphStreamError, depending on the Type
+}
+
+// GraphDelta holds the node and edge changes between two refreshes of a streamed graph
+type GraphDelta struct {
+	Timestamp    int64                    `json:"timestamp"`
+	Duration     int64                    `json:"duration"`
+	AddedNodes   []*cytoscape.NodeWrapper `json:"addedNodes,omitempty"`
+	UpdatedNodes []*cytoscape.NodeWrapper `json:"updatedNodes,omitempty"`
+	RemovedNodes []string                 `json:"removedNodes,omitempty"`
+	AddedEdges   []*cytoscape.EdgeWrapper `json:"addedEdges,omitempty"`
+	UpdatedEdges []*cytoscape.EdgeWrapper `json:"updatedEdges,omitempty"`
+	RemovedEdges []string                 `json:"removedEdges,omitempty"`
+}
+
+// IsEmpty returns true if no node or edge changed
+func (d GraphDelta) IsEmpty() bool {
+	return len(d.AddedNodes) == 0 && len(d.UpdatedNodes) == 0 && len(d.RemovedNodes) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.UpdatedEdges) == 0 && len(d.RemovedEdges) == 0
+}
+
+// GraphStreamError is the data of an error event
+type GraphStreamError struct {
+	Code    int    `json:"code"`
+	Message string `json:"error"`
+}
+
+// graphStream periodically rebuilds a graph and pushes it to all of its subscribers. Streams are
+// shared by the requests with the same options and namespace access, so the telemetry is queried once
+// per refresh no matter the number of subscribers. The stream stops when it has no subscribers left on
+// a refresh.
+type graphStream struct {
+	build    graphBuilder
+	id       string // distinguishes the event IDs of consecutive streams with the same key
+	interval time.Duration
+	key      string
+	options  graph.Options
+
+	mutex       sync.Mutex
+	business    *business.Layer   // the business layer of the latest subscriber, used by the refreshes
+	config      *cytoscape.Config // the latest graph, nil until the first refresh
+	subscribers map[*graphSubscriber]bool
+	version     int
+}
+
+// graphBuilder builds the graph of a stream with the business layer of a subscriber
+type graphBuilder func(layer *business.Layer, o graph.Options) cytoscape.Config
+
+type graphSubscriber struct {
+	business *business.Layer
+	events   chan GraphStreamEvent
+	synced   bool // true if the subscriber holds the latest graph
+}
+
+// graphStreams holds the running streams by key
+var graphStreams = struct {
+	sync.Mutex
+	streams map[string]*graphStream
+}{streams: make(map[string]*graphStream)}
+
+// SubscribeGraphNamespaces subscribes to a namespaces graph refreshed every interval. The returned function must
+// be called to unsubscribe. If lastEventID identifies the latest graph of the stream, for example when
+// reconnecting, the full graph is not sent again and the subscriber only receives the following deltas.
+// Note that a stream is only shared by requests with access to the same namespaces, and is refreshed with the
+// business layer of its latest subscriber, so the credentials of a gone subscriber are never used.
+func SubscribeGraphNamespaces(layer *business.Layer, o graph.Options, interval time.Duration, lastEventID string) (<-chan GraphStreamEvent, func()) {
+	if o.ConfigVendor != graph.VendorCytoscape {
+		graph.BadRequest(fmt.Sprintf("Invalid configVendor [%s], graph streams only support %s", o.ConfigVendor, graph.VendorCytoscape))
+	}
+	if o.CompareTime != 0 {
+		graph.BadRequest("Graph streams do not support window comparison")
+	}
+
+	var build graphBuilder
+	switch o.TelemetryVendor {
+	case graph.VendorIstio:
+		prom, err := prometheus.NewClient()
+		graph.CheckError(err)
+		build = func(layer *business.Layer, o graph.Options) cytoscape.Config {
+			// time how long it takes to generate this graph
+			promtimer := internalmetrics.GetGraphGenerationTimePrometheusTimer(o.GetGraphKind(), o.TelemetryOptions.GraphType, o.InjectServiceNodes)
+			defer promtimer.ObserveDuration()
+
+			// Create a 'global' object to store the business. Global only to the refresh.
+			globalInfo := graph.NewAppenderGlobalInfo()
+			globalInfo.Business = layer
+			globalInfo.Context = prom.GetContext()
+
+			trafficMap := buildNamespacesTrafficMap(o, prom, globalInfo)
+			return cytoscape.NewConfig(trafficMap, o.ConfigOptions)
+		}
+	default:
+		graph.Error(fmt.Sprintf("TelemetryVendor [%s] not supported", o.TelemetryVendor))
+	}
+
+	return subscribeGraphStream(graphStreamKey(o, interval), o, interval, build, layer, lastEventID)
+}
+
+// graphStreamKey identifies the streams that can be shared. The queryTime is ignored, as streams are
+// always refreshed with the current time. The namespaces accessible to the request are part of the key:
+// the graph depends on them, and subscribers must not receive the graph built for a different access.
+func graphStreamKey(o graph.Options, interval time.Duration) string {
+	params := url.Values{}
+	for k, v := range o.TelemetryOptions.Params {
+		if k != "queryTime" && k != "refreshInterval" {
+			params[k] = v
+		}
+	}
+
+	accessible := make([]string, 0, len(o.TelemetryOptions.AccessibleNamespaces))
+	for namespace := range o.TelemetryOptions.AccessibleNamespaces {
+		accessible = append(accessible, namespace)
+	}
+	sort.Strings(accessible)
+
+	return fmt.Sprintf("%v?%s#%s", interval, params.Encode(), strings.Join(accessible, ","))
+}
+
+func subscribeGraphStream(key string, o graph.Options, interval time.Duration, build graphBuilder, layer *business.Layer, lastEventID string) (<-chan GraphStreamEvent, func()) {
+	graphStreams.Lock()
+	defer graphStreams.Unlock()
+
+	stream, found := graphStreams.streams[key]
+	if !found {
+		// the first refresh of the stream may run before the subscription, it uses the layer of the subscriber
+		stream = &graphStream{
+			build:       build,
+			business:    layer,
+			id:          fmt.Sprintf("%x", time.Now().UnixNano()),
+			interval:    interval,
+			key:         key,
+			options:     o,
+			subscribers: make(map[*graphSubscriber]bool),
+		}
+		graphStreams.streams[key] = stream
+		log.Debugf("Starting graph stream [%s]", key)
+		go stream.run()
+	}
+	return stream.subscribe(layer, lastEventID)
+}
+
+func (s *graphStream) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.refresh()
+	for range ticker.C {
+		if s.stopIfUnused() {
+			return
+		}
+		s.refresh()
+	}
+}
+
+func (s *graphStream) subscribe(layer *business.Layer, lastEventID string) (<-chan GraphStreamEvent, func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscriber := &graphSubscriber{business: layer, events: make(chan GraphStreamEvent, graphStreamBuffer)}
+	if s.config != nil {
+		if lastEventID == s.eventID() {
+			subscriber.synced = true
+		} else {
+			s.send(subscriber, GraphStreamEvent{ID: s.eventID(), Type: GraphStreamEventGraph, Data: *s.config})
+		}
+	}
+	s.subscribers[subscriber] = true
+	s.business = layer
+
+	unsubscribe := func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		delete(s.subscribers, subscriber)
+		if s.business == subscriber.business {
+			// the credentials of the subscriber may expire, refresh with the ones of another subscriber
+			s.business = nil
+			for other := range s.subscribers {
+				s.business = other.business
+				break
+			}
+		}
+	}
+	return subscriber.events, unsubscribe
+}
+
+// stopIfUnused unregisters the stream if it has no subscribers, returning true if it did
+func (s *graphStream) stopIfUnused() bool {
+	graphStreams.Lock()
+	defer graphStreams.Unlock()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.subscribers) > 0 {
+		return false
+	}
+	delete(graphStreams.streams, s.key)
+	log.Debugf("Stopping graph stream [%s]", s.key)
+	return true
+}
+
+// refresh rebuilds the graph and pushes the changes to the subscribers
+func (s *graphStream) refresh() {
+	s.mutex.Lock()
+	layer := s.business
+	s.mutex.Unlock()
+
+	config, streamErr := s.buildConfig(layer)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if streamErr != nil {
+		for subscriber := range s.subscribers {
+			s.send(subscriber, GraphStreamEvent{Type: GraphStreamEventError, Data: *streamErr})
+		}
+		return
+	}
+
+	var delta *GraphDelta
+	if s.config != nil {
+		d := diffConfigs(*s.config, config)
+		delta = &d
+	}
+	s.config = &config
+	if delta == nil || !delta.IsEmpty() {
+		s.version++
+	}
+	for subscriber := range s.subscribers {
+		switch {
+		case !subscriber.synced || delta == nil:
+			s.send(subscriber, GraphStreamEvent{ID: s.eventID(), Type: GraphStreamEventGraph, Data: config})
+		case !delta.IsEmpty():
+			s.send(subscriber, GraphStreamEvent{ID: s.eventID(), Type: GraphStreamEventDelta, Data: *delta})
+		}
+	}
+}
+
+// buildConfig builds the graph for the current time, turning graph panics into an error
+func (s *graphStream) buildConfig(layer *business.Layer) (config cytoscape.Config, streamErr *GraphStreamError) {
+	defer func() {
+		if r := recover(); r != nil {
+			streamErr = &GraphStreamError{Code: http.StatusInternalServerError}
+			switch err := r.(type) {
+			case graph.Response:
+				streamErr.Code = err.Code
+				streamErr.Message = err.Message
+			case error:
+				streamErr.Message = err.Error()
+			case func() string:
+				streamErr.Message = err()
+			default:
+				streamErr.Message = fmt.Sprintf("%v", r)
+			}
+			log.Errorf("Failed to refresh graph stream [%s]: %s", s.key, streamErr.Message)
+		}
+	}()
+
+	return s.build(layer, s.options.AtQueryTime(time.Now().Unix())), nil
+}
+
+// send pushes the event without blocking. Must be called holding the stream mutex.
+func (s *graphStream) send(subscriber *graphSubscriber, event GraphStreamEvent) {
+	select {
+	case subscriber.events <- event:
+		if event.Type != GraphStreamEventError {
+			subscriber.synced = true
+		}
+	default:
+		if event.Type != GraphStreamEventError {
+			subscriber.synced = false
+		}
+	}
+}
+
+func (s *graphStream) eventID() string {
+	return fmt.Sprintf("%s-%d", s.id, s.version)
+}
+
+// diffConfigs returns the node and edge changes from the previous to the current graph
+func diffConfigs(previous, current cytoscape.Config) GraphDelta {
+	delta := GraphDelta{
+		Timestamp: current.Timestamp,
+		Duration:  current.Duration,
+	}
+
+	previousNodes := make(map[string]*cytoscape.NodeData)
+	for _, n := range previous.Elements.Nodes {
+		previousNodes[n.Data.ID] = n.Data
+	}
+	for _, n := range current.Elements.Nodes {
+		if p, found := previousNodes[n.Data.ID]; !found {
+			delta.AddedNodes = append(delta.AddedNodes, n)
+		} else if !reflect.DeepEqual(p, n.Data) {
+			delta.UpdatedNodes = append(delta.UpdatedNodes, n)
+		}
+		delete(previousNodes, n.Data.ID)
+	}
+	for _, n := range previous.Elements.Nodes {
+		if _, removed := previousNodes[n.Data.ID]; removed {
+			delta.RemovedNodes = append(delta.RemovedNodes, n.Data.ID)
+		}
+	}
+
+	previousEdges := make(map[string]*cytoscape.EdgeData)
+	for _, e := range previous.Elements.Edges {
+		previousEdges[e.Data.ID] = e.Data
+	}
+	for _, e := range current.Elements.Edges {
+		if p, found := previousEdges[e.Data.ID]; !found {
+			delta.AddedEdges = append(delta.AddedEdges, e)
+		} else if !reflect.DeepEqual(p, e.Data) {
+			delta.UpdatedEdges = append(delta.UpdatedEdges, e)
+		}
+		delete(previousEdges, e.Data.ID)
+	}
+	for _, e := range previous.Elements.Edges {
+		if _, removed := previousEdges[e.Data.ID]; removed {
+			delta.RemovedEdges = append(delta.RemovedEdges, e.Data.ID)
+		}
+	}
+
+	return delta
+}
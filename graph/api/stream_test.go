@@ -0,0 +1,190 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+)
+
+func buildStreamConfig(timestamp int64, rate string, nodeIDs ...string) cytoscape.Config {
+	config := cytoscape.Config{Timestamp: timestamp, Duration: 600}
+	for _, id := range nodeIDs {
+		config.Elements.Nodes = append(config.Elements.Nodes, &cytoscape.NodeWrapper{Data: &cytoscape.NodeData{ID: id}})
+	}
+	for i := 1; i < len(nodeIDs); i++ {
+		config.Elements.Edges = append(config.Elements.Edges, &cytoscape.EdgeWrapper{Data: &cytoscape.EdgeData{
+			ID:      nodeIDs[0] + nodeIDs[i],
+			Source:  nodeIDs[0],
+			Target:  nodeIDs[i],
+			Traffic: cytoscape.ProtocolTraffic{Protocol: "http", Rates: map[string]string{"http": rate}},
+		}})
+	}
+	return config
+}
+
+func TestDiffConfigs(t *testing.T) {
+	assert := assert.New(t)
+
+	previous := buildStreamConfig(1, "1.00", "a", "b", "c")
+	current := buildStreamConfig(2, "2.00", "a", "b", "d")
+	current.Elements.Edges[0].Data.Traffic.Rates["http"] = "1.00"
+
+	delta := diffConfigs(previous, current)
+	assert.Equal(int64(2), delta.Timestamp)
+	assert.Len(delta.AddedNodes, 1)
+	assert.Equal("d", delta.AddedNodes[0].Data.ID)
+	assert.Empty(delta.UpdatedNodes)
+	assert.Equal([]string{"c"}, delta.RemovedNodes)
+	assert.Len(delta.AddedEdges, 1)
+	assert.Equal("ad", delta.AddedEdges[0].Data.ID)
+	assert.Empty(delta.UpdatedEdges)
+	assert.Equal([]string{"ac"}, delta.RemovedEdges)
+
+	current = buildStreamConfig(3, "3.00", "a", "b", "d")
+	delta = diffConfigs(previous, current)
+	assert.Len(delta.UpdatedEdges, 1)
+	assert.Equal("ab", delta.UpdatedEdges[0].Data.ID)
+
+	assert.True(diffConfigs(current, buildStreamConfig(4, "3.00", "a", "b", "d")).IsEmpty())
+}
+
+func TestGraphStream(t *testing.T) {
+	assert := assert.New(t)
+
+	configs := []cytoscape.Config{
+		buildStreamConfig(1, "1.00", "a", "b"),
+		buildStreamConfig(2, "1.00", "a", "b"),
+		buildStreamConfig(3, "2.00", "a", "b"),
+	}
+	builds := 0
+	stream := &graphStream{
+		build: func(layer *business.Layer, o graph.Options) cytoscape.Config {
+			builds++
+			if builds > len(configs) {
+				graph.BadRequest("boom")
+			}
+			return configs[builds-1]
+		},
+		id:          "test",
+		interval:    time.Minute,
+		key:         "test",
+		subscribers: make(map[*graphSubscriber]bool),
+	}
+
+	first, _ := stream.subscribe(nil, "")
+	stream.refresh()
+	event := <-first
+	assert.Equal(GraphStreamEventGraph, event.Type)
+	assert.Equal("test-1", event.ID)
+
+	// a subscriber joining later receives the latest graph, a reconnecting one does not
+	second, unsubscribe := stream.subscribe(nil, "")
+	event = <-second
+	assert.Equal(GraphStreamEventGraph, event.Type)
+	assert.Equal("test-1", event.ID)
+	reconnected, _ := stream.subscribe(nil, "test-1")
+	assert.Empty(reconnected)
+
+	// nothing changed
+	stream.refresh()
+	assert.Empty(first)
+	assert.Empty(reconnected)
+
+	unsubscribe()
+	stream.refresh()
+	event = <-first
+	assert.Equal(GraphStreamEventDelta, event.Type)
+	assert.Equal("test-2", event.ID)
+	assert.Len(event.Data.(GraphDelta).UpdatedEdges, 1)
+	event = <-reconnected
+	assert.Equal(GraphStreamEventDelta, event.Type)
+	assert.Empty(second)
+
+	stream.refresh()
+	event = <-first
+	assert.Equal(GraphStreamEventError, event.Type)
+	assert.Equal("", event.ID)
+	assert.Equal(GraphStreamError{Code: 400, Message: "boom"}, event.Data)
+	assert.Equal(configs[2], *stream.config)
+}
+
+func TestGraphStreamKey(t *testing.T) {
+	assert := assert.New(t)
+
+	o := graph.Options{}
+	o.TelemetryOptions.Params = url.Values{"namespaces": {"bookinfo"}, "queryTime": {"1"}, "refreshInterval": {"10s"}}
+	o.TelemetryOptions.AccessibleNamespaces = map[string]time.Time{"istio-system": {}, "bookinfo": {}}
+	key := graphStreamKey(o, 10*time.Second)
+	assert.Equal("10s?namespaces=bookinfo#bookinfo,istio-system", key)
+
+	o.TelemetryOptions.Params = url.Values{"namespaces": {"bookinfo"}, "queryTime": {"2"}}
+	assert.Equal(key, graphStreamKey(o, 10*time.Second))
+	assert.NotEqual(key, graphStreamKey(o, 15*time.Second))
+
+	// requests with a different access don't share the stream
+	o.TelemetryOptions.AccessibleNamespaces = map[string]time.Time{"bookinfo": {}}
+	assert.NotEqual(key, graphStreamKey(o, 10*time.Second))
+}
+
+func TestGraphStreamRefreshesWithSubscriberBusinessLayer(t *testing.T) {
+	assert := assert.New(t)
+
+	var used []*business.Layer
+	stream := &graphStream{
+		build: func(layer *business.Layer, o graph.Options) cytoscape.Config {
+			used = append(used, layer)
+			return buildStreamConfig(1, "1.00", "a", "b")
+		},
+		id:          "test",
+		interval:    time.Minute,
+		key:         "test",
+		subscribers: make(map[*graphSubscriber]bool),
+	}
+
+	first, second := &business.Layer{}, &business.Layer{}
+	_, unsubscribeFirst := stream.subscribe(first, "")
+	stream.refresh()
+	_, unsubscribeSecond := stream.subscribe(second, "")
+	stream.refresh()
+	unsubscribeSecond()
+	stream.refresh()
+	unsubscribeFirst()
+
+	assert.Equal([]*business.Layer{first, second, first}, used)
+	assert.Nil(stream.business)
+}
+
+func TestSubscribeGraphStreamFirstRefreshUsesBusinessLayer(t *testing.T) {
+	assert := assert.New(t)
+
+	used := make(chan *business.Layer, 1)
+	build := func(layer *business.Layer, o graph.Options) cytoscape.Config {
+		select {
+		case used <- layer:
+		default:
+		}
+		return buildStreamConfig(1, "1.00", "a", "b")
+	}
+
+	layer := &business.Layer{}
+	_, unsubscribe := subscribeGraphStream("first-refresh", graph.Options{}, time.Hour, build, layer, "")
+	defer func() {
+		unsubscribe()
+		graphStreams.Lock()
+		delete(graphStreams.streams, "first-refresh")
+		graphStreams.Unlock()
+	}()
+
+	select {
+	case first := <-used:
+		assert.Same(layer, first)
+	case <-time.After(5 * time.Second):
+		assert.Fail("the graph stream wasn't refreshed")
+	}
+}
@@ -0,0 +1,166 @@
+package graph
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/prometheus/internalmetrics"
+)
+
+// cacheIgnoredParams are the query params not affecting the TrafficMap. The namespaces are part of the
+// key in a normalized way, and the other params are only used by the config vendors or by the handlers.
+var cacheIgnoredParams = map[string]bool{
+	"boxBy":           true,
+	"compareOffset":   true,
+	"compareTime":     true,
+	"configVendor":    true,
+	"namespaces":      true,
+	"queryTime":       true,
+	"refreshInterval": true,
+}
+
+type (
+	// TrafficMapCache is a cache of generated TrafficMaps, shared by all users. The cached TrafficMaps
+	// must not be modified.
+	TrafficMapCache interface {
+		Get(o TelemetryOptions) (bool, TrafficMap)
+		Set(o TelemetryOptions, trafficMap TrafficMap)
+	}
+
+	trafficMapCacheEntry struct {
+		access     map[string]bool // the accessibility of the TrafficMap namespaces to the user building it
+		created    time.Time
+		queryTime  time.Time
+		trafficMap TrafficMap
+	}
+
+	trafficMapCacheImpl struct {
+		cacheDuration time.Duration
+		entries       map[string]trafficMapCacheEntry
+		lock          sync.RWMutex
+		maxEntries    int
+	}
+)
+
+// NewTrafficMapCache returns a TrafficMapCache configured with the GraphCache settings
+func NewTrafficMapCache() TrafficMapCache {
+	conf := config.Get().GraphCache
+
+	return &trafficMapCacheImpl{
+		cacheDuration: time.Duration(conf.CacheDuration) * time.Second,
+		entries:       make(map[string]trafficMapCacheEntry),
+		maxEntries:    conf.MaxEntries,
+	}
+}
+
+// Get returns the cached TrafficMap built with the same options, if its query time precedes the requested
+// query time by less than the cache duration. The TrafficMap holds user-specific data for the namespaces
+// the user can't access (e.g. inaccessible nodes), so it is only returned if the requesting user has the
+// same access to the TrafficMap namespaces as the user who built it.
+func (c *trafficMapCacheImpl) Get(o TelemetryOptions) (bool, TrafficMap) {
+	defer c.lock.RUnlock()
+	c.lock.RLock()
+
+	key := TrafficMapCacheKey(o)
+	queryTime := time.Unix(o.QueryTime, 0)
+	if entry, ok := c.entries[key]; ok && c.isValid(entry, queryTime) && hasSameAccess(entry, o.AccessibleNamespaces) {
+		log.Tracef("[Graph Cache] Get [key: %s] [queryTime: %s]", key, queryTime.String())
+		internalmetrics.GetGraphCacheHitsMetric(o.GetGraphKind(), o.GraphType, o.InjectServiceNodes).Inc()
+		return true, entry.trafficMap
+	}
+	internalmetrics.GetGraphCacheMissesMetric(o.GetGraphKind(), o.GraphType, o.InjectServiceNodes).Inc()
+	return false, nil
+}
+
+// Set caches the TrafficMap built with the options. When the cache is full, the expired entries are
+// evicted or, if none expired, the oldest entry.
+func (c *trafficMapCacheImpl) Set(o TelemetryOptions, trafficMap TrafficMap) {
+	defer c.lock.Unlock()
+	c.lock.Lock()
+
+	key := TrafficMapCacheKey(o)
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+
+	access := make(map[string]bool)
+	for _, n := range trafficMap {
+		_, access[n.Namespace] = o.AccessibleNamespaces[n.Namespace]
+	}
+	c.entries[key] = trafficMapCacheEntry{
+		access:     access,
+		created:    time.Now(),
+		queryTime:  time.Unix(o.QueryTime, 0),
+		trafficMap: trafficMap,
+	}
+	log.Tracef("[Graph Cache] Set [key: %s] [queryTime: %s]", key, time.Unix(o.QueryTime, 0).String())
+}
+
+// evict frees at least one entry. Must be called holding the write lock.
+func (c *trafficMapCacheImpl) evict() {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if time.Since(entry.created) >= c.cacheDuration {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.created.Before(oldest) {
+			oldestKey = key
+			oldest = entry.created
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// isValid returns true if the entry was cached less than the cache duration ago, and its query time
+// precedes the requested query time by less than the cache duration
+func (c *trafficMapCacheImpl) isValid(entry trafficMapCacheEntry, queryTime time.Time) bool {
+	return time.Since(entry.created) < c.cacheDuration &&
+		!queryTime.Before(entry.queryTime) && queryTime.Sub(entry.queryTime) < c.cacheDuration
+}
+
+func hasSameAccess(entry trafficMapCacheEntry, accessibleNamespaces map[string]time.Time) bool {
+	for namespace, accessible := range entry.access {
+		if _, ok := accessibleNamespaces[namespace]; ok != accessible {
+			return false
+		}
+	}
+	return true
+}
+
+// TrafficMapCacheKey returns the key identifying the TrafficMaps built with the same options. It ignores
+// the query time, the user access data and the options not affecting the TrafficMap.
+func TrafficMapCacheKey(o TelemetryOptions) string {
+	namespaces := make([]string, 0, len(o.Namespaces))
+	for name := range o.Namespaces {
+		namespaces = append(namespaces, name)
+	}
+	sort.Strings(namespaces)
+
+	params := url.Values{}
+	for k, v := range o.Params {
+		if !cacheIgnoredParams[k] {
+			params[k] = v
+		}
+	}
+
+	return fmt.Sprintf("namespaces=%s&graphType=%s&duration=%v&appenders=%v&idle=%t&inject=%t&rates=%+v&node=%+v&%s",
+		strings.Join(namespaces, ","),
+		o.GraphType,
+		o.Duration,
+		o.Appenders,
+		o.IncludeIdleEdges,
+		o.InjectServiceNodes,
+		o.Rates,
+		o.NodeOptions,
+		params.Encode())
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+)
+
+func buildCacheOptions(queryTime int64, params url.Values, namespaces ...string) TelemetryOptions {
+	o := TelemetryOptions{
+		AccessibleNamespaces: map[string]time.Time{"bookinfo": {}, "travels": {}},
+		Namespaces:           NewNamespaceInfoMap(),
+	}
+	o.GraphType = GraphTypeWorkload
+	o.Duration = 10 * time.Minute
+	o.Params = params
+	o.QueryTime = queryTime
+	for _, ns := range namespaces {
+		o.Namespaces[ns] = NamespaceInfo{Name: ns, Duration: o.Duration}
+	}
+	return o
+}
+
+func buildCacheTrafficMap() TrafficMap {
+	trafficMap := NewTrafficMap()
+	productpage := NewNode("east", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", GraphTypeWorkload)
+	travels := NewNode("east", "travels", "", "travels", "travels-v1", "travels", "v1", GraphTypeWorkload)
+	trafficMap[productpage.ID] = &productpage
+	trafficMap[travels.ID] = &travels
+	productpage.AddEdge(&travels)
+	return trafficMap
+}
+
+func TestTrafficMapCacheKey(t *testing.T) {
+	assert := assert.New(t)
+
+	key := TrafficMapCacheKey(buildCacheOptions(1000, url.Values{"namespaces": {"travels,bookinfo"}, "queryTime": {"1000"}, "boxBy": {"app"}}, "travels", "bookinfo"))
+	assert.Equal(key, TrafficMapCacheKey(buildCacheOptions(2000, url.Values{"namespaces": {"bookinfo,travels"}}, "bookinfo", "travels")))
+	assert.NotEqual(key, TrafficMapCacheKey(buildCacheOptions(1000, url.Values{}, "bookinfo")))
+	assert.NotEqual(key, TrafficMapCacheKey(buildCacheOptions(1000, url.Values{"responseTime": {"99"}}, "bookinfo", "travels")))
+
+	o := buildCacheOptions(1000, url.Values{}, "bookinfo", "travels")
+	o.GraphType = GraphTypeApp
+	assert.NotEqual(key, TrafficMapCacheKey(o))
+}
+
+func TestTrafficMapCache(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := config.NewConfig()
+	conf.GraphCache.CacheDuration = 10
+	conf.GraphCache.MaxEntries = 2
+	config.Set(conf)
+
+	cache := NewTrafficMapCache()
+	now := time.Now().Unix()
+	o := buildCacheOptions(now, url.Values{}, "bookinfo")
+
+	isCached, _ := cache.Get(o)
+	assert.False(isCached)
+
+	trafficMap := buildCacheTrafficMap()
+	cache.Set(o, trafficMap)
+
+	isCached, cached := cache.Get(o)
+	assert.True(isCached)
+	assert.Equal(trafficMap, cached)
+
+	// served within the cache duration after the cached query time, not before it
+	isCached, _ = cache.Get(buildCacheOptions(now+5, url.Values{}, "bookinfo"))
+	assert.True(isCached)
+	isCached, _ = cache.Get(buildCacheOptions(now+10, url.Values{}, "bookinfo"))
+	assert.False(isCached)
+	isCached, _ = cache.Get(buildCacheOptions(now-1, url.Values{}, "bookinfo"))
+	assert.False(isCached)
+
+	// not served to users with a different access to the TrafficMap namespaces
+	restricted := buildCacheOptions(now, url.Values{}, "bookinfo")
+	delete(restricted.AccessibleNamespaces, "travels")
+	isCached, _ = cache.Get(restricted)
+	assert.False(isCached)
+
+	// the oldest entry is evicted when full
+	cache.Set(buildCacheOptions(now, url.Values{}, "travels"), trafficMap)
+	cache.Set(buildCacheOptions(now, url.Values{}, "bookinfo", "travels"), trafficMap)
+	isCached, _ = cache.Get(o)
+	assert.False(isCached)
+	isCached, _ = cache.Get(buildCacheOptions(now, url.Values{}, "travels"))
+	assert.True(isCached)
+}
@@ -8,3 +8,10 @@ type ConfigVendor interface {
 	// definitions for error handling. Refer to the Cytoscape implementation as an example.
 	NewConfig(trafficMap TrafficMap, o ConfigOptions) interface{}
 }
+
+// TextConfig is implemented by vendor configs that are not JSON documents (e.g. DOT or Mermaid).
+// Handlers write them verbatim using the provided content type.
+type TextConfig interface {
+	ContentType() string
+	String() string
+}
@@ -0,0 +1,107 @@
+// Package common provides helpers shared by the text based config vendors (DOT, GraphML, Mermaid).
+//
+// The text vendors do not walk the TrafficMap directly. They render the Cytoscape config, so
+// boxing, rates, mTLS and health are calculated exactly like in the graph displayed by the UI.
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/models"
+)
+
+// Children groups the nodes by their box (parent) ID, keeping the order of the provided nodes.
+// Top-level nodes are stored under the empty key.
+func Children(nodes []*cytoscape.NodeWrapper) map[string][]*cytoscape.NodeData {
+	children := make(map[string][]*cytoscape.NodeData)
+	for _, n := range nodes {
+		children[n.Data.Parent] = append(children[n.Data.Parent], n.Data)
+	}
+	return children
+}
+
+// NodeLabel returns a human readable name for the node
+func NodeLabel(nd *cytoscape.NodeData) string {
+	switch nd.NodeType {
+	case graph.NodeTypeBox:
+		switch nd.IsBox {
+		case graph.BoxByCluster:
+			return fmt.Sprintf("cluster: %s", nd.Cluster)
+		case graph.BoxByNamespace:
+			return fmt.Sprintf("namespace: %s", nd.Namespace)
+		default:
+			return fmt.Sprintf("app: %s", nd.App)
+		}
+	case graph.NodeTypeAggregate:
+		return nd.Aggregate
+	case graph.NodeTypeApp:
+		if graph.IsOK(nd.Version) {
+			return fmt.Sprintf("%s %s", nd.App, nd.Version)
+		}
+		return nd.App
+	case graph.NodeTypeService:
+		return nd.Service
+	case graph.NodeTypeWorkload:
+		return nd.Workload
+	default:
+		return graph.Unknown
+	}
+}
+
+// EdgeLabel summarizes the edge traffic, e.g. "http 10.50rps 2.0% err mTLS 100%"
+func EdgeLabel(ed *cytoscape.EdgeData) string {
+	parts := []string{}
+	for _, p := range graph.Protocols {
+		if p.Name != ed.Traffic.Protocol {
+			continue
+		}
+		parts = append(parts, p.Name)
+		for _, r := range p.EdgeRates {
+			rate, ok := ed.Traffic.Rates[string(r.Name)]
+			if !ok {
+				continue
+			}
+			switch {
+			case r.IsTotal:
+				parts = append(parts, rate+p.UnitShort)
+			case r.IsPercentErr:
+				parts = append(parts, rate+"% err")
+			}
+		}
+	}
+	if ed.IsMTLS != "" {
+		parts = append(parts, fmt.Sprintf("mTLS %s%%", ed.IsMTLS))
+	}
+	return strings.Join(parts, " ")
+}
+
+// NodeHealth evaluates the health data attached to the node by the health appender
+func NodeHealth(nd *cytoscape.NodeData) models.HealthStatus {
+	switch h := nd.HealthData.(type) {
+	case *models.WorkloadHealth:
+		return h.Status(nd.Namespace, nd.Workload)
+	case *models.AppHealth:
+		return h.Status(nd.Namespace, nd.App)
+	case *models.ServiceHealth:
+		return h.Status(nd.Namespace, nd.Service)
+	default:
+		return models.HealthStatusNA
+	}
+}
+
+// HealthColor returns the color used by the UI for the health status
+func HealthColor(status models.HealthStatus) string {
+	switch status {
+	case models.HealthStatusHealthy:
+		return "#3e8635"
+	case models.HealthStatusDegraded:
+		return "#f0ab00"
+	case models.HealthStatusFailure:
+		return "#c9190b"
+	default:
+		return "#8a8d90"
+	}
+}
@@ -92,6 +92,7 @@ type NodeData struct {
 	Service               string              `json:"service,omitempty"`               // requested service for NodeTypeService
 	Aggregate             string              `json:"aggregate,omitempty"`             // set like "<aggregate>=<aggregateVal>"
 	DestServices          []graph.ServiceName `json:"destServices,omitempty"`          // requested services for [dest] node
+	Diff                  *graph.DiffInfo     `json:"diff,omitempty"`                  // set when comparing two query windows
 	Labels                map[string]string   `json:"labels,omitempty"`                // k8s labels associated with the node
 	Traffic               []ProtocolTraffic   `json:"traffic,omitempty"`               // traffic rates for all detected protocols
 	HealthData            interface{}         `json:"healthData"`                      // data to calculate health status from configurations
@@ -124,12 +125,14 @@ type EdgeData struct {
 	Target string `json:"target"` // child node ID
 
 	// App Fields (not required by Cytoscape)
-	DestPrincipal   string          `json:"destPrincipal,omitempty"`   // principal used for the edge destination
-	IsMTLS          string          `json:"isMTLS,omitempty"`          // set to the percentage of traffic using a mutual TLS connection
-	ResponseTime    string          `json:"responseTime,omitempty"`    // in millis
-	SourcePrincipal string          `json:"sourcePrincipal,omitempty"` // principal used for the edge source
-	Throughput      string          `json:"throughput,omitempty"`      // in bytes/sec (request or response, depends on client request)
-	Traffic         ProtocolTraffic `json:"traffic,omitempty"`         // traffic rates for the edge protocol
+	DestPrincipal   string             `json:"destPrincipal,omitempty"`   // principal used for the edge destination
+	Diff            *graph.DiffInfo    `json:"diff,omitempty"`            // set when comparing two query windows
+	HasAnomaly      *graph.AnomalyInfo `json:"hasAnomaly,omitempty"`      // regressions with respect to a baseline window
+	IsMTLS          string             `json:"isMTLS,omitempty"`          // set to the percentage of traffic using a mutual TLS connection
+	ResponseTime    string             `json:"responseTime,omitempty"`    // in millis
+	SourcePrincipal string             `json:"sourcePrincipal,omitempty"` // principal used for the edge source
+	Throughput      string             `json:"throughput,omitempty"`      // in bytes/sec (request or response, depends on client request)
+	Traffic         ProtocolTraffic    `json:"traffic,omitempty"`         // traffic rates for the edge protocol
 }
 
 type NodeWrapper struct {
@@ -380,6 +383,11 @@ func buildConfig(trafficMap graph.TrafficMap, nodes *[]*NodeWrapper, edges *[]*E
 			}
 		}
 
+		// node may be compared with a baseline window
+		if val, ok := n.Metadata[graph.Diff]; ok {
+			nd.Diff = val.(*graph.DiffInfo)
+		}
+
 		// node may be an aggregate
 		if n.NodeType == graph.NodeTypeAggregate {
 			nd.Aggregate = fmt.Sprintf("%s=%s", n.Metadata[graph.Aggregate].(string), n.Metadata[graph.AggregateValue].(string))
@@ -413,6 +421,12 @@ func buildConfig(trafficMap graph.TrafficMap, nodes *[]*NodeWrapper, edges *[]*E
 			if e.Metadata[graph.SourcePrincipal] != nil {
 				ed.SourcePrincipal = e.Metadata[graph.SourcePrincipal].(string)
 			}
+			if e.Metadata[graph.HasAnomaly] != nil {
+				ed.HasAnomaly = e.Metadata[graph.HasAnomaly].(*graph.AnomalyInfo)
+			}
+			if e.Metadata[graph.Diff] != nil {
+				ed.Diff = e.Metadata[graph.Diff].(*graph.DiffInfo)
+			}
 			addEdgeTelemetry(e, &ed)
 
 			ew := EdgeWrapper{
@@ -0,0 +1,106 @@
+// Package dot provides conversion from our graph to the Graphviz DOT language.
+//
+// The following links are useful for understanding DOT:
+//
+// Language:   https://graphviz.org/doc/info/lang.html
+// Attributes: https://graphviz.org/doc/info/attrs.html
+//
+// Algorithm: Generate the Cytoscape config, so boxing and telemetry are resolved the same way,
+//            and write each box as a cluster subgraph holding its member nodes. Nodes are shaped
+//            by node type and outlined with the color of their health status.
+//
+// The package provides the DOT implementation of graph/ConfigVendor.
+package dot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/common"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+)
+
+// Config is a DOT document
+type Config struct {
+	dot string
+}
+
+// ContentType is required by the graph/TextConfig interface
+func (c Config) ContentType() string {
+	return "text/vnd.graphviz"
+}
+
+// String is required by the graph/TextConfig interface
+func (c Config) String() string {
+	return c.dot
+}
+
+// NewConfig is required by the graph/ConfigVendor interface
+func NewConfig(trafficMap graph.TrafficMap, o graph.ConfigOptions) (result Config) {
+	cytoConfig := cytoscape.NewConfig(trafficMap, o)
+	children := common.Children(cytoConfig.Elements.Nodes)
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "// Kiali %s graph, duration %ds, queryTime %s\n", cytoConfig.GraphType, cytoConfig.Duration, time.Unix(cytoConfig.Timestamp, 0).UTC().Format(time.RFC3339))
+	sb.WriteString("digraph kiali {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [style=filled, fillcolor=white, penwidth=2];\n")
+	writeNodes(sb, children, "", 1)
+	for _, e := range cytoConfig.Elements.Edges {
+		ed := e.Data
+		fmt.Fprintf(sb, "  %s -> %s [label=%s", quote(ed.Source), quote(ed.Target), quote(common.EdgeLabel(ed)))
+		if ed.IsMTLS != "" {
+			sb.WriteString(", penwidth=2")
+		}
+		if ed.Traffic.Protocol == "tcp" {
+			sb.WriteString(", color=\"#0066cc\"")
+		}
+		sb.WriteString("];\n")
+	}
+	sb.WriteString("}\n")
+
+	return Config{dot: sb.String()}
+}
+
+func writeNodes(sb *strings.Builder, children map[string][]*cytoscape.NodeData, parent string, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for _, nd := range children[parent] {
+		if nd.NodeType == graph.NodeTypeBox {
+			fmt.Fprintf(sb, "%ssubgraph %s {\n", indent, quote("cluster_"+nd.ID))
+			fmt.Fprintf(sb, "%s  label=%s;\n", indent, quote(common.NodeLabel(nd)))
+			fmt.Fprintf(sb, "%s  style=dashed;\n", indent)
+			fmt.Fprintf(sb, "%s  color=%s;\n", indent, quote(common.HealthColor(common.NodeHealth(nd))))
+			writeNodes(sb, children, nd.ID, depth+1)
+			fmt.Fprintf(sb, "%s}\n", indent)
+			continue
+		}
+		health := common.NodeHealth(nd)
+		fmt.Fprintf(sb, "%s%s [label=%s, shape=%s, color=%s, tooltip=%s];\n", indent, quote(nd.ID), quote(common.NodeLabel(nd)), shape(nd), quote(common.HealthColor(health)), quote(fmt.Sprintf("%s %s/%s: %s", nd.NodeType, nd.Namespace, common.NodeLabel(nd), health)))
+	}
+}
+
+// shape returns the DOT shape closest to the one used by the UI for the node type
+func shape(nd *cytoscape.NodeData) string {
+	if nd.IsServiceEntry != nil {
+		return "diamond"
+	}
+	switch nd.NodeType {
+	case graph.NodeTypeAggregate:
+		return "pentagon"
+	case graph.NodeTypeApp:
+		return "box"
+	case graph.NodeTypeService:
+		return "triangle"
+	case graph.NodeTypeWorkload:
+		return "ellipse"
+	default:
+		return "octagon"
+	}
+}
+
+// quote returns a DOT quoted string
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
@@ -0,0 +1,86 @@
+package dot
+
+import (
+	"crypto/md5"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/models"
+)
+
+func buildTrafficMap() graph.TrafficMap {
+	traffic := graph.NewTrafficMap()
+
+	productpage := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeWorkload)
+	productpage.Metadata[graph.MetadataKey("httpOut")] = 10.0
+	traffic[productpage.ID] = &productpage
+
+	reviews := graph.NewNode("testCluster", "bookinfo", "reviews", "bookinfo", "", "", "", graph.GraphTypeWorkload)
+	traffic[reviews.ID] = &reviews
+
+	reviewsV1 := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeWorkload)
+	reviewsV1.Metadata[graph.HealthData] = &models.WorkloadHealth{
+		WorkloadStatus: &models.WorkloadStatus{Name: "reviews-v1", DesiredReplicas: 1, AvailableReplicas: 0, SyncedProxies: -1},
+	}
+	traffic[reviewsV1.ID] = &reviewsV1
+
+	reviewsV2 := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v2", "reviews", "v2", graph.GraphTypeWorkload)
+	reviewsV2.Metadata[graph.HealthData] = &models.WorkloadHealth{
+		WorkloadStatus: &models.WorkloadStatus{Name: "reviews-v2", DesiredReplicas: 1, AvailableReplicas: 1, SyncedProxies: -1},
+	}
+	traffic[reviewsV2.ID] = &reviewsV2
+
+	e := productpage.AddEdge(&reviews)
+	e.Metadata[graph.MetadataKey("http")] = 10.0
+	e.Metadata[graph.MetadataKey("http5xx")] = 1.0
+	e.Metadata[graph.HTTP.EdgeResponses] = graph.Responses{}
+	e.Metadata[graph.IsMTLS] = 100.0
+
+	e = reviews.AddEdge(&reviewsV1)
+	e.Metadata[graph.MetadataKey("tcp")] = 1200.0
+	e.Metadata[graph.TCP.EdgeResponses] = graph.Responses{}
+
+	e = reviews.AddEdge(&reviewsV2)
+	e.Metadata[graph.MetadataKey("tcp")] = 800.0
+	e.Metadata[graph.TCP.EdgeResponses] = graph.Responses{}
+
+	return traffic
+}
+
+func cytoscapeNodeID(id string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(id)))
+}
+
+func TestDotConfig(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	// boxed nodes are nested in the cluster subgraph
+	nodeID := cytoscapeNodeID("wl_testCluster_bookinfo_reviews-v1")
+	dot := NewConfig(buildTrafficMap(), graph.ConfigOptions{BoxBy: graph.BoxByApp}).String()
+
+	assert.Contains(dot, "digraph kiali {")
+	assert.Contains(dot, "label=\"app: reviews\";")
+	assert.Contains(dot, "[label=\"productpage-v1\", shape=ellipse, color=\"#8a8d90\"")
+	assert.Contains(dot, "[label=\"reviews\", shape=triangle")
+	assert.Contains(dot, "    \""+nodeID+"\" [label=\"reviews-v1\", shape=ellipse, color=\"#c9190b\"")
+	assert.Contains(dot, "[label=\"reviews-v2\", shape=ellipse, color=\"#3e8635\"")
+	assert.Contains(dot, "[label=\"http 10.00rps 10.0% err mTLS 100%\", penwidth=2];")
+	assert.Contains(dot, "[label=\"tcp 1200.00bps\", color=\"#0066cc\"];")
+}
+
+func TestDotConfigWithoutBoxing(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	dot := NewConfig(buildTrafficMap(), graph.ConfigOptions{}).String()
+
+	assert.NotContains(dot, "subgraph")
+	assert.Contains(dot, "shape=triangle")
+}
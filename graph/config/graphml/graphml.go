@@ -0,0 +1,185 @@
+// Package graphml provides conversion from our graph to the GraphML xml format, which can be
+// imported by most graph tools (yEd, Gephi, NetworkX, ...).
+//
+// The following links are useful for understanding GraphML:
+//
+// Primer: http://graphml.graphdrawing.org/primer/graphml-primer.html
+//
+// Algorithm: Generate the Cytoscape config, so boxing and telemetry are resolved the same way,
+//            and write each box as a node holding a nested graph with its member nodes. Every
+//            node and edge attribute used is declared as a GraphML key.
+//
+// The package provides the GraphML implementation of graph/ConfigVendor.
+package graphml
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/common"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+)
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type Key struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type Data struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type Node struct {
+	ID    string `xml:"id,attr"`
+	Data  []Data `xml:"data"`
+	Graph *Graph `xml:"graph,omitempty"`
+}
+
+type Edge struct {
+	ID     string `xml:"id,attr"`
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+	Data   []Data `xml:"data"`
+}
+
+type Graph struct {
+	ID          string `xml:"id,attr"`
+	EdgeDefault string `xml:"edgedefault,attr"`
+	Data        []Data `xml:"data"`
+	Nodes       []Node `xml:"node"`
+	Edges       []Edge `xml:"edge"`
+}
+
+type Document struct {
+	XMLName xml.Name `xml:"graphml"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Keys    []Key    `xml:"key"`
+	Graph   Graph    `xml:"graph"`
+}
+
+// Config is a GraphML document
+type Config struct {
+	Document Document
+}
+
+// ContentType is required by the graph/TextConfig interface
+func (c Config) ContentType() string {
+	return "application/xml"
+}
+
+// String is required by the graph/TextConfig interface
+func (c Config) String() string {
+	doc, err := xml.MarshalIndent(c.Document, "", "  ")
+	graph.CheckError(err)
+	return xml.Header + string(doc) + "\n"
+}
+
+// nodeKeys are the node attributes, in the order they are written
+var nodeKeys = []string{"label", "nodeType", "cluster", "namespace", "app", "version", "workload", "service", "isBox", "health"}
+
+// NewConfig is required by the graph/ConfigVendor interface
+func NewConfig(trafficMap graph.TrafficMap, o graph.ConfigOptions) (result Config) {
+	cytoConfig := cytoscape.NewConfig(trafficMap, o)
+	children := common.Children(cytoConfig.Elements.Nodes)
+
+	keys := []Key{
+		{ID: "graphType", For: "graph", AttrName: "graphType", AttrType: "string"},
+		{ID: "duration", For: "graph", AttrName: "duration", AttrType: "long"},
+		{ID: "timestamp", For: "graph", AttrName: "timestamp", AttrType: "long"},
+	}
+	for _, k := range nodeKeys {
+		keys = append(keys, Key{ID: k, For: "node", AttrName: k, AttrType: "string"})
+	}
+	edgeKeys := []string{"edgeLabel", "protocol", "isMTLS", "responseTime", "throughput"}
+	for _, p := range graph.Protocols {
+		for _, r := range p.EdgeRates {
+			edgeKeys = append(edgeKeys, string(r.Name))
+		}
+	}
+	for _, k := range edgeKeys {
+		attrType := "double"
+		if k == "edgeLabel" || k == "protocol" {
+			attrType = "string"
+		}
+		keys = append(keys, Key{ID: k, For: "edge", AttrName: k, AttrType: attrType})
+	}
+
+	root := Graph{
+		ID:          "kiali",
+		EdgeDefault: "directed",
+		Data: []Data{
+			{Key: "graphType", Value: cytoConfig.GraphType},
+			{Key: "duration", Value: fmt.Sprint(cytoConfig.Duration)},
+			{Key: "timestamp", Value: fmt.Sprint(cytoConfig.Timestamp)},
+		},
+		Nodes: buildNodes(children, ""),
+	}
+	for _, e := range cytoConfig.Elements.Edges {
+		ed := e.Data
+		edge := Edge{ID: ed.ID, Source: ed.Source, Target: ed.Target}
+		edge.Data = appendData(edge.Data, "edgeLabel", common.EdgeLabel(ed))
+		edge.Data = appendData(edge.Data, "protocol", ed.Traffic.Protocol)
+		edge.Data = appendData(edge.Data, "isMTLS", ed.IsMTLS)
+		edge.Data = appendData(edge.Data, "responseTime", ed.ResponseTime)
+		edge.Data = appendData(edge.Data, "throughput", ed.Throughput)
+		for _, k := range edgeKeys {
+			if rate, ok := ed.Traffic.Rates[k]; ok {
+				edge.Data = appendData(edge.Data, k, rate)
+			}
+		}
+		root.Edges = append(root.Edges, edge)
+	}
+
+	return Config{
+		Document: Document{
+			Xmlns: graphMLNamespace,
+			Keys:  keys,
+			Graph: root,
+		},
+	}
+}
+
+func buildNodes(children map[string][]*cytoscape.NodeData, parent string) []Node {
+	nodes := []Node{}
+	for _, nd := range children[parent] {
+		node := Node{ID: nd.ID}
+		values := map[string]string{
+			"label":     common.NodeLabel(nd),
+			"nodeType":  nd.NodeType,
+			"cluster":   nd.Cluster,
+			"namespace": nd.Namespace,
+			"app":       nd.App,
+			"version":   nd.Version,
+			"workload":  nd.Workload,
+			"service":   nd.Service,
+			"isBox":     nd.IsBox,
+			"health":    string(common.NodeHealth(nd)),
+		}
+		for _, k := range nodeKeys {
+			node.Data = appendData(node.Data, k, values[k])
+		}
+		if nd.NodeType == graph.NodeTypeBox {
+			node.Graph = &Graph{
+				ID:          nd.ID + ":",
+				EdgeDefault: "directed",
+				Nodes:       buildNodes(children, nd.ID),
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// appendData adds the value to the data list, omitting empty values
+func appendData(data []Data, key, value string) []Data {
+	if value == "" {
+		return data
+	}
+	return append(data, Data{Key: key, Value: value})
+}
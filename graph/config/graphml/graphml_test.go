@@ -0,0 +1,91 @@
+package graphml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/models"
+)
+
+func buildTrafficMap() graph.TrafficMap {
+	traffic := graph.NewTrafficMap()
+
+	productpage := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeWorkload)
+	productpage.Metadata[graph.MetadataKey("httpOut")] = 10.0
+	traffic[productpage.ID] = &productpage
+
+	reviews := graph.NewNode("testCluster", "bookinfo", "reviews", "bookinfo", "", "", "", graph.GraphTypeWorkload)
+	traffic[reviews.ID] = &reviews
+
+	reviewsV1 := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeWorkload)
+	reviewsV1.Metadata[graph.HealthData] = &models.WorkloadHealth{
+		WorkloadStatus: &models.WorkloadStatus{Name: "reviews-v1", DesiredReplicas: 1, AvailableReplicas: 0, SyncedProxies: -1},
+	}
+	traffic[reviewsV1.ID] = &reviewsV1
+
+	reviewsV2 := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v2", "reviews", "v2", graph.GraphTypeWorkload)
+	reviewsV2.Metadata[graph.HealthData] = &models.WorkloadHealth{
+		WorkloadStatus: &models.WorkloadStatus{Name: "reviews-v2", DesiredReplicas: 1, AvailableReplicas: 1, SyncedProxies: -1},
+	}
+	traffic[reviewsV2.ID] = &reviewsV2
+
+	e := productpage.AddEdge(&reviews)
+	e.Metadata[graph.MetadataKey("http")] = 10.0
+	e.Metadata[graph.MetadataKey("http5xx")] = 1.0
+	e.Metadata[graph.HTTP.EdgeResponses] = graph.Responses{}
+	e.Metadata[graph.IsMTLS] = 100.0
+
+	e = reviews.AddEdge(&reviewsV1)
+	e.Metadata[graph.MetadataKey("tcp")] = 1200.0
+	e.Metadata[graph.TCP.EdgeResponses] = graph.Responses{}
+
+	e = reviews.AddEdge(&reviewsV2)
+	e.Metadata[graph.MetadataKey("tcp")] = 800.0
+	e.Metadata[graph.TCP.EdgeResponses] = graph.Responses{}
+
+	return traffic
+}
+
+func TestGraphMLConfig(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	graphML := NewConfig(buildTrafficMap(), graph.ConfigOptions{BoxBy: graph.BoxByApp})
+
+	// the document must be valid xml
+	doc := Document{}
+	assert.Nil(xml.Unmarshal([]byte(graphML.String()), &doc))
+
+	// the app box holds a nested graph with both versions
+	var box *Node
+	for i, n := range doc.Graph.Nodes {
+		if n.Graph != nil {
+			box = &doc.Graph.Nodes[i]
+		}
+	}
+	assert.NotNil(box)
+	assert.Contains(box.Data, Data{Key: "isBox", Value: "app"})
+	assert.Len(box.Graph.Nodes, 2)
+	assert.Contains(box.Graph.Nodes[0].Data, Data{Key: "health", Value: "Failure"})
+	assert.Contains(box.Graph.Nodes[1].Data, Data{Key: "health", Value: "Healthy"})
+
+	assert.Len(doc.Graph.Edges, 3)
+	assert.Contains(doc.Graph.Edges[0].Data, Data{Key: "isMTLS", Value: "100"})
+	assert.Contains(doc.Graph.Edges[0].Data, Data{Key: "httpPercentErr", Value: "10.0"})
+
+	keys := map[string]bool{}
+	for _, k := range doc.Keys {
+		keys[k.ID] = true
+	}
+	for _, e := range doc.Graph.Edges {
+		for _, d := range e.Data {
+			assert.True(keys[d.Key], "undeclared key %s", d.Key)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Package mermaid provides conversion from our graph to a Mermaid flowchart, which can be
+// embedded in markdown documents rendered by most wikis and code hosting services.
+//
+// The following links are useful for understanding Mermaid flowcharts:
+//
+// Syntax: https://mermaid-js.github.io/mermaid/#/flowchart
+//
+// Algorithm: Generate the Cytoscape config, so boxing and telemetry are resolved the same way,
+//            and write each box as a subgraph holding its member nodes. Nodes are shaped by
+//            node type and styled with a class per health status.
+//
+// The package provides the Mermaid implementation of graph/ConfigVendor.
+package mermaid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/config/common"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/models"
+)
+
+// Config is a Mermaid flowchart
+type Config struct {
+	flowchart string
+}
+
+// ContentType is required by the graph/TextConfig interface
+func (c Config) ContentType() string {
+	return "text/plain; charset=utf-8"
+}
+
+// String is required by the graph/TextConfig interface
+func (c Config) String() string {
+	return c.flowchart
+}
+
+// NewConfig is required by the graph/ConfigVendor interface
+func NewConfig(trafficMap graph.TrafficMap, o graph.ConfigOptions) (result Config) {
+	cytoConfig := cytoscape.NewConfig(trafficMap, o)
+	children := common.Children(cytoConfig.Elements.Nodes)
+	healthClasses := map[models.HealthStatus][]string{}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "%%%% Kiali %s graph, duration %ds, queryTime %s\n", cytoConfig.GraphType, cytoConfig.Duration, time.Unix(cytoConfig.Timestamp, 0).UTC().Format(time.RFC3339))
+	sb.WriteString("flowchart LR\n")
+	writeNodes(sb, children, "", 1, healthClasses)
+	for _, e := range cytoConfig.Elements.Edges {
+		ed := e.Data
+		arrow := "-->"
+		if ed.IsMTLS != "" {
+			arrow = "==>"
+		}
+		if label := common.EdgeLabel(ed); label != "" {
+			fmt.Fprintf(sb, "  %s %s|%s| %s\n", id(ed.Source), arrow, quote(label), id(ed.Target))
+		} else {
+			fmt.Fprintf(sb, "  %s %s %s\n", id(ed.Source), arrow, id(ed.Target))
+		}
+	}
+
+	statuses := make([]string, 0, len(healthClasses))
+	for status := range healthClasses {
+		statuses = append(statuses, string(status))
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		fmt.Fprintf(sb, "  classDef %s stroke:%s,stroke-width:2px\n", className(models.HealthStatus(status)), common.HealthColor(models.HealthStatus(status)))
+		fmt.Fprintf(sb, "  class %s %s\n", strings.Join(healthClasses[models.HealthStatus(status)], ","), className(models.HealthStatus(status)))
+	}
+
+	return Config{flowchart: sb.String()}
+}
+
+func writeNodes(sb *strings.Builder, children map[string][]*cytoscape.NodeData, parent string, depth int, healthClasses map[models.HealthStatus][]string) {
+	indent := strings.Repeat("  ", depth)
+	for _, nd := range children[parent] {
+		health := common.NodeHealth(nd)
+		healthClasses[health] = append(healthClasses[health], id(nd.ID))
+		if nd.NodeType == graph.NodeTypeBox {
+			fmt.Fprintf(sb, "%ssubgraph %s[%s]\n", indent, id(nd.ID), quote(common.NodeLabel(nd)))
+			writeNodes(sb, children, nd.ID, depth+1, healthClasses)
+			fmt.Fprintf(sb, "%send\n", indent)
+			continue
+		}
+		open, closing := shape(nd)
+		fmt.Fprintf(sb, "%s%s%s%s%s\n", indent, id(nd.ID), open, quote(common.NodeLabel(nd)), closing)
+	}
+}
+
+// shape returns the delimiters of the Mermaid shape closest to the one used by the UI for the node type
+func shape(nd *cytoscape.NodeData) (string, string) {
+	if nd.IsServiceEntry != nil {
+		return "{{", "}}"
+	}
+	switch nd.NodeType {
+	case graph.NodeTypeAggregate:
+		return "{", "}"
+	case graph.NodeTypeApp:
+		return "[", "]"
+	case graph.NodeTypeService:
+		return "[/", "\\]"
+	case graph.NodeTypeWorkload:
+		return "((", "))"
+	default:
+		return ">", "]"
+	}
+}
+
+func className(status models.HealthStatus) string {
+	return "health" + string(status)
+}
+
+// id returns a Mermaid node id. Node hashes may start with a digit, which Mermaid does not accept.
+func id(nodeID string) string {
+	return "n" + nodeID
+}
+
+// quote returns a Mermaid quoted string, using entity codes for characters breaking the syntax
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace(s) + `"`
+}
@@ -0,0 +1,76 @@
+package mermaid
+
+import (
+	"crypto/md5"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/models"
+)
+
+func buildTrafficMap() graph.TrafficMap {
+	traffic := graph.NewTrafficMap()
+
+	productpage := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeWorkload)
+	productpage.Metadata[graph.MetadataKey("httpOut")] = 10.0
+	traffic[productpage.ID] = &productpage
+
+	reviews := graph.NewNode("testCluster", "bookinfo", "reviews", "bookinfo", "", "", "", graph.GraphTypeWorkload)
+	traffic[reviews.ID] = &reviews
+
+	reviewsV1 := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeWorkload)
+	reviewsV1.Metadata[graph.HealthData] = &models.WorkloadHealth{
+		WorkloadStatus: &models.WorkloadStatus{Name: "reviews-v1", DesiredReplicas: 1, AvailableReplicas: 0, SyncedProxies: -1},
+	}
+	traffic[reviewsV1.ID] = &reviewsV1
+
+	reviewsV2 := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v2", "reviews", "v2", graph.GraphTypeWorkload)
+	reviewsV2.Metadata[graph.HealthData] = &models.WorkloadHealth{
+		WorkloadStatus: &models.WorkloadStatus{Name: "reviews-v2", DesiredReplicas: 1, AvailableReplicas: 1, SyncedProxies: -1},
+	}
+	traffic[reviewsV2.ID] = &reviewsV2
+
+	e := productpage.AddEdge(&reviews)
+	e.Metadata[graph.MetadataKey("http")] = 10.0
+	e.Metadata[graph.MetadataKey("http5xx")] = 1.0
+	e.Metadata[graph.HTTP.EdgeResponses] = graph.Responses{}
+	e.Metadata[graph.IsMTLS] = 100.0
+
+	e = reviews.AddEdge(&reviewsV1)
+	e.Metadata[graph.MetadataKey("tcp")] = 1200.0
+	e.Metadata[graph.TCP.EdgeResponses] = graph.Responses{}
+
+	e = reviews.AddEdge(&reviewsV2)
+	e.Metadata[graph.MetadataKey("tcp")] = 800.0
+	e.Metadata[graph.TCP.EdgeResponses] = graph.Responses{}
+
+	return traffic
+}
+
+func TestMermaidConfig(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	flowchart := NewConfig(buildTrafficMap(), graph.ConfigOptions{BoxBy: graph.BoxByApp}).String()
+
+	assert.Contains(flowchart, "flowchart LR\n")
+	assert.Contains(flowchart, "[\"app: reviews\"]\n")
+	assert.Contains(flowchart, "    n"+nodeID("wl_testCluster_bookinfo_reviews-v1")+"((\"reviews-v1\"))\n")
+	assert.Contains(flowchart, "n"+nodeID("svc_testCluster_bookinfo_reviews")+"[/\"reviews\"\\]\n")
+	assert.Contains(flowchart, "==>|\"http 10.00rps 10.0% err mTLS 100%\"|")
+	assert.Contains(flowchart, "-->|\"tcp 1200.00bps\"|")
+	assert.Contains(flowchart, "classDef healthFailure stroke:#c9190b")
+	assert.Contains(flowchart, "class n"+nodeID("wl_testCluster_bookinfo_reviews-v1")+" healthFailure\n")
+	assert.Equal(1, strings.Count(flowchart, "end\n"))
+}
+
+func nodeID(id string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(id)))
+}
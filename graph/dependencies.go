@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"sort"
+)
+
+// Dependency directions
+const (
+	Downstream string = "downstream" // the nodes a node sends traffic to, directly or transitively
+	Upstream   string = "upstream"   // the nodes sending traffic to a node, directly or transitively
+)
+
+// FindNodes returns the nodes of the TrafficMap of the given type, namespace and name. The name is
+// matched against the workload, app or service of the node, depending on the node type. Version
+// and cluster are only matched when not empty.
+func FindNodes(trafficMap TrafficMap, nodeType, cluster, namespace, name, version string) []*Node {
+	nodes := []*Node{}
+	for _, n := range trafficMap {
+		if n.NodeType != nodeType || n.Namespace != namespace {
+			continue
+		}
+		if cluster != "" && n.Cluster != cluster {
+			continue
+		}
+		if version != "" && n.Version != version {
+			continue
+		}
+		switch nodeType {
+		case NodeTypeApp:
+			if n.App != name {
+				continue
+			}
+		case NodeTypeService:
+			if n.Service != name {
+				continue
+			}
+		case NodeTypeWorkload:
+			if n.Workload != name {
+				continue
+			}
+		}
+		nodes = append(nodes, n)
+	}
+	sortNodes(nodes)
+	return nodes
+}
+
+// Closure returns the nodes reachable from the roots in the given direction, the roots included
+func Closure(trafficMap TrafficMap, roots []*Node, direction string) map[string]*Node {
+	next := outgoingNodes
+	if direction == Upstream {
+		next = incomingNodes(trafficMap)
+	}
+
+	closure := make(map[string]*Node)
+	queue := []*Node{}
+	for _, r := range roots {
+		closure[r.ID] = r
+		queue = append(queue, r)
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range next(n) {
+			if _, found := closure[m.ID]; !found {
+				closure[m.ID] = m
+				queue = append(queue, m)
+			}
+		}
+	}
+	return closure
+}
+
+// ShortestPath returns the nodes of a shortest traffic path from any of the sources to any of the
+// targets, following the direction of the traffic. It returns nil if there is no such path.
+func ShortestPath(sources, targets []*Node) []*Node {
+	isTarget := make(map[string]bool)
+	for _, t := range targets {
+		isTarget[t.ID] = true
+	}
+
+	previous := make(map[string]*Node)
+	visited := make(map[string]bool)
+	queue := []*Node{}
+	for _, s := range sources {
+		visited[s.ID] = true
+		queue = append(queue, s)
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if isTarget[n.ID] {
+			path := []*Node{n}
+			for p, ok := previous[n.ID]; ok; p, ok = previous[p.ID] {
+				path = append([]*Node{p}, path...)
+			}
+			return path
+		}
+		for _, m := range outgoingNodes(n) {
+			if !visited[m.ID] {
+				visited[m.ID] = true
+				previous[m.ID] = n
+				queue = append(queue, m)
+			}
+		}
+	}
+	return nil
+}
+
+// Cycles returns the groups of nodes involved in traffic cycles. Each group is a strongly connected
+// component of the TrafficMap: every node of the group can reach every other node of the group.
+// Nodes sending traffic to themselves are reported as single node groups.
+func Cycles(trafficMap TrafficMap) [][]*Node {
+	// Tarjan's strongly connected components algorithm
+	index := 0
+	indexes := make(map[string]int)
+	lowLinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	stack := []*Node{}
+	cycles := [][]*Node{}
+
+	var strongConnect func(n *Node)
+	strongConnect = func(n *Node) {
+		indexes[n.ID] = index
+		lowLinks[n.ID] = index
+		index++
+		stack = append(stack, n)
+		onStack[n.ID] = true
+
+		selfLoop := false
+		for _, m := range outgoingNodes(n) {
+			if m.ID == n.ID {
+				selfLoop = true
+			}
+			if _, visited := indexes[m.ID]; !visited {
+				strongConnect(m)
+				if lowLinks[m.ID] < lowLinks[n.ID] {
+					lowLinks[n.ID] = lowLinks[m.ID]
+				}
+			} else if onStack[m.ID] && indexes[m.ID] < lowLinks[n.ID] {
+				lowLinks[n.ID] = indexes[m.ID]
+			}
+		}
+
+		if lowLinks[n.ID] == indexes[n.ID] {
+			component := []*Node{}
+			for {
+				m := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[m.ID] = false
+				component = append(component, m)
+				if m.ID == n.ID {
+					break
+				}
+			}
+			if len(component) > 1 || selfLoop {
+				sortNodes(component)
+				cycles = append(cycles, component)
+			}
+		}
+	}
+
+	// visit the nodes in a predictable order, for stable results
+	nodes := make([]*Node, 0, len(trafficMap))
+	for _, n := range trafficMap {
+		nodes = append(nodes, n)
+	}
+	sortNodes(nodes)
+	for _, n := range nodes {
+		if _, visited := indexes[n.ID]; !visited {
+			strongConnect(n)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0].ID < cycles[j][0].ID
+	})
+	return cycles
+}
+
+// Subgraph returns a new TrafficMap holding copies of the provided nodes, keeping only the
+// edges between them. If path is true, only the edges between consecutive nodes are kept.
+func Subgraph(nodes []*Node, path bool) TrafficMap {
+	subgraph := NewTrafficMap()
+	for _, n := range nodes {
+		nodeCopy := *n
+		nodeCopy.Edges = []*Edge{}
+		subgraph[n.ID] = &nodeCopy
+	}
+	for i, n := range nodes {
+		for _, e := range n.Edges {
+			dest, ok := subgraph[e.Dest.ID]
+			if !ok || (path && (i == len(nodes)-1 || nodes[i+1].ID != dest.ID)) {
+				continue
+			}
+			source := subgraph[n.ID]
+			edge := source.AddEdge(dest)
+			edge.Metadata = e.Metadata
+		}
+	}
+	return subgraph
+}
+
+func outgoingNodes(n *Node) []*Node {
+	nodes := make([]*Node, 0, len(n.Edges))
+	for _, e := range n.Edges {
+		nodes = append(nodes, e.Dest)
+	}
+	return nodes
+}
+
+// incomingNodes returns a function providing the nodes sending traffic to a node
+func incomingNodes(trafficMap TrafficMap) func(n *Node) []*Node {
+	incoming := make(map[string][]*Node)
+	for _, n := range trafficMap {
+		for _, e := range n.Edges {
+			incoming[e.Dest.ID] = append(incoming[e.Dest.ID], n)
+		}
+	}
+	return func(n *Node) []*Node {
+		return incoming[n.ID]
+	}
+}
+
+func sortNodes(nodes []*Node) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+}
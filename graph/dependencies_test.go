@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildDependenciesTrafficMap builds: productpage -> reviews-v1 -> ratings-v1 -> reviews-v1 (cycle),
+// productpage -> details-v1, and an isolated loop-v1 node calling itself.
+func buildDependenciesTrafficMap() (TrafficMap, map[string]*Node) {
+	trafficMap := NewTrafficMap()
+	nodes := map[string]*Node{}
+	for _, name := range []string{"productpage", "reviews", "ratings", "details", "loop"} {
+		n := NewNode("east", "bookinfo", "", "bookinfo", name+"-v1", name, "v1", GraphTypeWorkload)
+		trafficMap[n.ID] = &n
+		nodes[name] = &n
+	}
+	nodes["productpage"].AddEdge(nodes["reviews"])
+	nodes["productpage"].AddEdge(nodes["details"])
+	nodes["reviews"].AddEdge(nodes["ratings"])
+	nodes["ratings"].AddEdge(nodes["reviews"])
+	nodes["loop"].AddEdge(nodes["loop"])
+	return trafficMap, nodes
+}
+
+func TestFindNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap, nodes := buildDependenciesTrafficMap()
+
+	found := FindNodes(trafficMap, NodeTypeWorkload, "", "bookinfo", "reviews-v1", "")
+	assert.Equal([]*Node{nodes["reviews"]}, found)
+	found = FindNodes(trafficMap, NodeTypeWorkload, "east", "bookinfo", "reviews-v1", "v1")
+	assert.Equal([]*Node{nodes["reviews"]}, found)
+	assert.Empty(FindNodes(trafficMap, NodeTypeWorkload, "west", "bookinfo", "reviews-v1", ""))
+	assert.Empty(FindNodes(trafficMap, NodeTypeWorkload, "", "other", "reviews-v1", ""))
+	assert.Empty(FindNodes(trafficMap, NodeTypeApp, "", "bookinfo", "reviews", ""))
+}
+
+func TestClosure(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap, nodes := buildDependenciesTrafficMap()
+
+	downstream := Closure(trafficMap, []*Node{nodes["reviews"]}, Downstream)
+	assert.Len(downstream, 2)
+	assert.Contains(downstream, nodes["reviews"].ID)
+	assert.Contains(downstream, nodes["ratings"].ID)
+
+	upstream := Closure(trafficMap, []*Node{nodes["reviews"]}, Upstream)
+	assert.Len(upstream, 3)
+	assert.Contains(upstream, nodes["reviews"].ID)
+	assert.Contains(upstream, nodes["ratings"].ID)
+	assert.Contains(upstream, nodes["productpage"].ID)
+
+	upstream = Closure(trafficMap, []*Node{nodes["details"]}, Upstream)
+	assert.Len(upstream, 2)
+	assert.Contains(upstream, nodes["productpage"].ID)
+}
+
+func TestShortestPath(t *testing.T) {
+	assert := assert.New(t)
+
+	_, nodes := buildDependenciesTrafficMap()
+
+	path := ShortestPath([]*Node{nodes["productpage"]}, []*Node{nodes["ratings"]})
+	assert.Equal([]*Node{nodes["productpage"], nodes["reviews"], nodes["ratings"]}, path)
+
+	path = ShortestPath([]*Node{nodes["reviews"]}, []*Node{nodes["reviews"]})
+	assert.Equal([]*Node{nodes["reviews"]}, path)
+
+	assert.Nil(ShortestPath([]*Node{nodes["ratings"]}, []*Node{nodes["details"]}))
+}
+
+func TestCycles(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap, nodes := buildDependenciesTrafficMap()
+
+	cycles := Cycles(trafficMap)
+	assert.Len(cycles, 2)
+	assert.Equal([]*Node{nodes["loop"]}, cycles[0])
+	assert.ElementsMatch([]*Node{nodes["reviews"], nodes["ratings"]}, cycles[1])
+}
+
+func TestSubgraph(t *testing.T) {
+	assert := assert.New(t)
+
+	_, nodes := buildDependenciesTrafficMap()
+
+	subgraph := Subgraph([]*Node{nodes["productpage"], nodes["reviews"], nodes["ratings"]}, false)
+	assert.Len(subgraph, 3)
+	assert.Len(subgraph[nodes["productpage"].ID].Edges, 1)
+	assert.Len(subgraph[nodes["reviews"].ID].Edges, 1)
+	assert.Len(subgraph[nodes["ratings"].ID].Edges, 1)
+	assert.Same(subgraph[nodes["reviews"].ID], subgraph[nodes["productpage"].ID].Edges[0].Dest)
+	// the original nodes are untouched
+	assert.Len(nodes["productpage"].Edges, 2)
+
+	path := Subgraph([]*Node{nodes["productpage"], nodes["reviews"], nodes["ratings"]}, true)
+	assert.Len(path[nodes["productpage"].ID].Edges, 1)
+	assert.Len(path[nodes["reviews"].ID].Edges, 1)
+	assert.Empty(path[nodes["ratings"].ID].Edges)
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"math"
+)
+
+// Diff status values, set on nodes and edges of a graph comparing two query windows
+const (
+	DiffAdded     string = "added"     // present only in the current window
+	DiffChanged   string = "changed"   // present in both windows, with significant traffic changes
+	DiffRemoved   string = "removed"   // present only in the baseline window
+	DiffUnchanged string = "unchanged" // present in both windows, without significant traffic changes
+)
+
+const (
+	// diffRateThreshold is the minimum relative change of the request rate or response time
+	// for an edge to be considered changed
+	diffRateThreshold float64 = 0.1
+	// diffErrorThreshold is the minimum change of the error rate, in percentage points, for
+	// an edge to be considered changed
+	diffErrorThreshold float64 = 1.0
+)
+
+// DiffInfo holds the result of comparing a node or an edge between the current and the
+// baseline query windows. Deltas are current minus baseline and are only set for edges.
+type DiffInfo struct {
+	Status            string  `json:"status"`
+	RateDelta         float64 `json:"rateDelta,omitempty"`         // in the unit of the edge protocol (rps, bps)
+	ErrorRateDelta    float64 `json:"errorRateDelta,omitempty"`    // in percentage points
+	ResponseTimeDelta float64 `json:"responseTimeDelta,omitempty"` // in millis
+}
+
+// DiffTrafficMaps merges the baseline TrafficMap into the current TrafficMap and annotates every
+// node and edge with its DiffInfo. Nodes and edges found only in the baseline are added to the
+// result, keeping their baseline telemetry. The current TrafficMap is updated and returned.
+func DiffTrafficMaps(current, baseline TrafficMap) TrafficMap {
+	for id, n := range current {
+		status := DiffAdded
+		if _, ok := baseline[id]; ok {
+			status = DiffUnchanged
+		}
+		n.Metadata[Diff] = &DiffInfo{Status: status}
+	}
+	for id, n := range baseline {
+		if _, ok := current[id]; !ok {
+			removed := *n
+			removed.Edges = []*Edge{}
+			removed.Metadata = copyMetadata(n.Metadata)
+			removed.Metadata[Diff] = &DiffInfo{Status: DiffRemoved}
+			current[id] = &removed
+		}
+	}
+
+	baselineEdges := make(map[string]*Edge)
+	for _, n := range baseline {
+		for _, e := range n.Edges {
+			baselineEdges[edgeKey(e)] = e
+		}
+	}
+
+	for _, n := range current {
+		for _, e := range n.Edges {
+			key := edgeKey(e)
+			if be, ok := baselineEdges[key]; ok {
+				e.Metadata[Diff] = diffEdge(e, be)
+				delete(baselineEdges, key)
+			} else {
+				e.Metadata[Diff] = diffEdge(e, nil)
+			}
+		}
+	}
+
+	for _, be := range baselineEdges {
+		removed := current[be.Source.ID].AddEdge(current[be.Dest.ID])
+		removed.Metadata = copyMetadata(be.Metadata)
+		removed.Metadata[Diff] = diffEdge(nil, be)
+	}
+
+	// a node present in both windows is changed when any of its edges changed
+	for _, n := range current {
+		for _, e := range n.Edges {
+			if e.Metadata[Diff].(*DiffInfo).Status == DiffUnchanged {
+				continue
+			}
+			for _, endpoint := range []*Node{e.Source, e.Dest} {
+				if diff := endpoint.Metadata[Diff].(*DiffInfo); diff.Status == DiffUnchanged {
+					diff.Status = DiffChanged
+				}
+			}
+		}
+	}
+
+	return current
+}
+
+// diffEdge compares the current edge with the baseline edge, any of them can be nil
+func diffEdge(current, baseline *Edge) *DiffInfo {
+	var rate, errRate, responseTime, baselineRate, baselineErrRate, baselineResponseTime float64
+	if current != nil {
+		rate, errRate, responseTime = edgeTraffic(current)
+	}
+	if baseline != nil {
+		baselineRate, baselineErrRate, baselineResponseTime = edgeTraffic(baseline)
+	}
+
+	diff := &DiffInfo{
+		RateDelta:         rate - baselineRate,
+		ErrorRateDelta:    errRate - baselineErrRate,
+		ResponseTimeDelta: responseTime - baselineResponseTime,
+	}
+	switch {
+	case baseline == nil:
+		diff.Status = DiffAdded
+	case current == nil:
+		diff.Status = DiffRemoved
+	case isSignificantChange(rate, baselineRate) ||
+		math.Abs(diff.ErrorRateDelta) >= diffErrorThreshold ||
+		isSignificantChange(responseTime, baselineResponseTime):
+		diff.Status = DiffChanged
+	default:
+		diff.Status = DiffUnchanged
+	}
+	return diff
+}
+
+// edgeTraffic returns the total rate, the error rate percentage and the response time of the edge
+func edgeTraffic(e *Edge) (rate, errRate, responseTime float64) {
+	protocol, _ := e.Metadata[ProtocolKey].(string)
+	for _, p := range Protocols {
+		if p.Name != protocol {
+			continue
+		}
+		errors := 0.0
+		for _, r := range p.EdgeRates {
+			val, _ := e.Metadata[r.Name].(float64)
+			switch {
+			case r.IsTotal:
+				rate = val
+			case r.IsErr:
+				errors += val
+			}
+		}
+		if rate > 0 {
+			errRate = errors / rate * 100
+		}
+	}
+	responseTime, _ = e.Metadata[ResponseTime].(float64)
+	return rate, errRate, responseTime
+}
+
+func isSignificantChange(current, baseline float64) bool {
+	if baseline == 0 {
+		return current != 0
+	}
+	return math.Abs(current-baseline)/baseline >= diffRateThreshold
+}
+
+func edgeKey(e *Edge) string {
+	protocol, _ := e.Metadata[ProtocolKey].(string)
+	return e.Source.ID + " " + e.Dest.ID + " " + protocol
+}
+
+func copyMetadata(md Metadata) Metadata {
+	result := NewMetadata()
+	for k, v := range md {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildDiffEdge(source, dest *Node, protocol string, rate, errRate float64) *Edge {
+	e := source.AddEdge(dest)
+	e.Metadata[ProtocolKey] = protocol
+	e.Metadata[MetadataKey(protocol)] = rate
+	if errRate > 0 {
+		e.Metadata[MetadataKey(protocol+"5xx")] = errRate
+	}
+	return e
+}
+
+func TestDiffTrafficMaps(t *testing.T) {
+	assert := assert.New(t)
+
+	current := NewTrafficMap()
+	productpage := NewNode("east", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", GraphTypeWorkload)
+	reviewsV1 := NewNode("east", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", GraphTypeWorkload)
+	reviewsV3 := NewNode("east", "bookinfo", "", "bookinfo", "reviews-v3", "reviews", "v3", GraphTypeWorkload)
+	details := NewNode("east", "bookinfo", "", "bookinfo", "details-v1", "details", "v1", GraphTypeWorkload)
+	current[productpage.ID] = &productpage
+	current[reviewsV1.ID] = &reviewsV1
+	current[reviewsV3.ID] = &reviewsV3
+	current[details.ID] = &details
+	buildDiffEdge(&productpage, &reviewsV1, "http", 10, 2)
+	buildDiffEdge(&productpage, &reviewsV3, "http", 5, 0)
+	buildDiffEdge(&productpage, &details, "http", 10.5, 0)
+
+	baseline := NewTrafficMap()
+	bProductpage := NewNode("east", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", GraphTypeWorkload)
+	bReviewsV1 := NewNode("east", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", GraphTypeWorkload)
+	bReviewsV2 := NewNode("east", "bookinfo", "", "bookinfo", "reviews-v2", "reviews", "v2", GraphTypeWorkload)
+	bDetails := NewNode("east", "bookinfo", "", "bookinfo", "details-v1", "details", "v1", GraphTypeWorkload)
+	baseline[bProductpage.ID] = &bProductpage
+	baseline[bReviewsV1.ID] = &bReviewsV1
+	baseline[bReviewsV2.ID] = &bReviewsV2
+	baseline[bDetails.ID] = &bDetails
+	buildDiffEdge(&bProductpage, &bReviewsV1, "http", 10, 0)
+	buildDiffEdge(&bProductpage, &bReviewsV2, "http", 5, 0)
+	buildDiffEdge(&bProductpage, &bDetails, "http", 10, 0)
+
+	diff := DiffTrafficMaps(current, baseline)
+
+	assert.Len(diff, 5)
+	assert.Equal(DiffChanged, diff[productpage.ID].Metadata[Diff].(*DiffInfo).Status)
+	assert.Equal(DiffChanged, diff[reviewsV1.ID].Metadata[Diff].(*DiffInfo).Status)
+	assert.Equal(DiffAdded, diff[reviewsV3.ID].Metadata[Diff].(*DiffInfo).Status)
+	assert.Equal(DiffRemoved, diff[bReviewsV2.ID].Metadata[Diff].(*DiffInfo).Status)
+	assert.Equal(DiffUnchanged, diff[details.ID].Metadata[Diff].(*DiffInfo).Status)
+
+	edges := map[string]*DiffInfo{}
+	for _, e := range diff[productpage.ID].Edges {
+		assert.Same(diff[productpage.ID], e.Source)
+		assert.Same(diff[e.Dest.ID], e.Dest)
+		edges[e.Dest.Workload] = e.Metadata[Diff].(*DiffInfo)
+	}
+	assert.Len(edges, 4)
+
+	assert.Equal(DiffChanged, edges["reviews-v1"].Status)
+	assert.Equal(0.0, edges["reviews-v1"].RateDelta)
+	assert.Equal(20.0, edges["reviews-v1"].ErrorRateDelta)
+
+	assert.Equal(DiffAdded, edges["reviews-v3"].Status)
+	assert.Equal(5.0, edges["reviews-v3"].RateDelta)
+
+	assert.Equal(DiffRemoved, edges["reviews-v2"].Status)
+	assert.Equal(-5.0, edges["reviews-v2"].RateDelta)
+
+	// a 5% rate change is not significant
+	assert.Equal(DiffUnchanged, edges["details-v1"].Status)
+	assert.Equal(0.5, edges["details-v1"].RateDelta)
+}
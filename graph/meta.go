@@ -17,8 +17,10 @@ const (
 	AggregateValue        MetadataKey = "aggregateValue"
 	DestPrincipal         MetadataKey = "destPrincipal"
 	DestServices          MetadataKey = "destServices"
+	Diff                  MetadataKey = "diff" // the DiffInfo of a graph comparing two query windows
 	HealthData            MetadataKey = "healthData"
 	HealthDataApp         MetadataKey = "healthDataApp" // for storing app health on versioned app nodes
+	HasAnomaly            MetadataKey = "hasAnomaly"
 	HasCB                 MetadataKey = "hasCB"
 	HasFaultInjection     MetadataKey = "hasFaultInjection"
 	HasHealthConfig       MetadataKey = "hasHealthConfig"
@@ -23,6 +23,9 @@ import (
 // The supported vendors
 const (
 	VendorCytoscape        string = "cytoscape"
+	VendorDot              string = "dot"
+	VendorGraphML          string = "graphml"
+	VendorMermaid          string = "mermaid"
 	VendorIstio            string = "istio"
 	defaultConfigVendor    string = VendorCytoscape
 	defaultTelemetryVendor string = VendorIstio
@@ -104,6 +107,7 @@ type TelemetryOptions struct {
 
 // Options comprises all available options
 type Options struct {
+	CompareTime     int64 // unix time in seconds ending the baseline window, 0 when not comparing windows
 	ConfigVendor    string
 	TelemetryVendor string
 	ConfigOptions
@@ -127,9 +131,12 @@ func NewOptions(r *net_http.Request) Options {
 	var includeIdleEdges bool
 	var injectServiceNodes bool
 	var queryTime int64
+	var compareTime int64
 	appenders := RequestedAppenders{All: true}
 	boxBy := params.Get("boxBy")
 	cluster := params.Get("cluster")
+	compareOffsetString := params.Get("compareOffset")
+	compareTimeString := params.Get("compareTime")
 	configVendor := params.Get("configVendor")
 	durationString := params.Get("duration")
 	graphType := params.Get("graphType")
@@ -154,7 +161,7 @@ func NewOptions(r *net_http.Request) Options {
 	}
 	if configVendor == "" {
 		configVendor = defaultConfigVendor
-	} else if configVendor != VendorCytoscape {
+	} else if configVendor != VendorCytoscape && configVendor != VendorDot && configVendor != VendorGraphML && configVendor != VendorMermaid {
 		BadRequest(fmt.Sprintf("Invalid configVendor [%s]", configVendor))
 	}
 	if durationString == "" {
@@ -219,6 +226,23 @@ func NewOptions(r *net_http.Request) Options {
 			BadRequest(fmt.Sprintf("Invalid queryTime [%s]", queryTimeString))
 		}
 	}
+	if compareOffsetString != "" && compareTimeString != "" {
+		BadRequest("Only one of compareOffset and compareTime can be specified")
+	}
+	if compareOffsetString != "" {
+		compareOffset, compareOffsetErr := model.ParseDuration(compareOffsetString)
+		if compareOffsetErr != nil || compareOffset <= 0 {
+			BadRequest(fmt.Sprintf("Invalid compareOffset [%s]", compareOffsetString))
+		}
+		compareTime = queryTime - int64(time.Duration(compareOffset).Seconds())
+	}
+	if compareTimeString != "" {
+		var compareTimeErr error
+		compareTime, compareTimeErr = strconv.ParseInt(compareTimeString, 10, 64)
+		if compareTimeErr != nil || compareTime <= 0 || compareTime >= queryTime {
+			BadRequest(fmt.Sprintf("Invalid compareTime [%s], it must precede the queryTime", compareTimeString))
+		}
+	}
 	if telemetryVendor == "" {
 		telemetryVendor = defaultTelemetryVendor
 	} else if telemetryVendor != VendorIstio {
@@ -323,6 +347,7 @@ func NewOptions(r *net_http.Request) Options {
 	}
 
 	options := Options{
+		CompareTime:     compareTime,
 		ConfigVendor:    configVendor,
 		TelemetryVendor: telemetryVendor,
 		ConfigOptions: ConfigOptions{
@@ -374,6 +399,30 @@ func (o *TelemetryOptions) GetGraphKind() string {
 	return graphKindNamespace
 }
 
+// BaselineOptions returns the options to build the graph of the baseline window, when comparing
+// windows. The baseline window has the same duration and ends at CompareTime.
+func (o Options) BaselineOptions() Options {
+	baseline := o.AtQueryTime(o.CompareTime)
+	baseline.CompareTime = 0
+	return baseline
+}
+
+// AtQueryTime returns the options to build the same graph ending at the provided queryTime (unix
+// time in seconds). The namespace durations are recomputed for the new time range.
+func (o Options) AtQueryTime(queryTime int64) Options {
+	result := o
+	result.ConfigOptions.QueryTime = queryTime
+	result.TelemetryOptions.QueryTime = queryTime
+
+	result.TelemetryOptions.Namespaces = NewNamespaceInfoMap()
+	for name, namespace := range o.TelemetryOptions.Namespaces {
+		namespace.Duration = getSafeNamespaceDuration(name, o.TelemetryOptions.AccessibleNamespaces[name], o.TelemetryOptions.Duration, queryTime)
+		result.TelemetryOptions.Namespaces[name] = namespace
+	}
+
+	return result
+}
+
 // getAccessibleNamespaces returns a Set of all namespaces accessible to the user.
 // The Set is implemented using the map convention. Each map entry is set to the
 // creation timestamp of the namespace, to be used to ensure valid time ranges for
@@ -0,0 +1,215 @@
+package appender
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/prometheus"
+)
+
+const (
+	// AnomalyAppenderName uniquely identifies the appender: anomaly
+	AnomalyAppenderName = "anomaly"
+
+	// anomalyMinRequests is the minimum number of requests, in each window, to evaluate an edge
+	anomalyMinRequests = 20.0
+	// anomalyMinErrorDelta is the minimum increase of the error rate, in percentage points
+	anomalyMinErrorDelta = 1.0
+	// anomalyZScore is the z-score the error rate increase must reach (~99.9% one-sided confidence)
+	anomalyZScore = 3.0
+	// anomalyResponseTimeRatio is the minimum ratio between the current and the baseline p95 response time
+	anomalyResponseTimeRatio = 1.5
+	// anomalyMinResponseTimeDelta is the minimum increase of the p95 response time, in millis
+	anomalyMinResponseTimeDelta = 10.0
+)
+
+// AnomalyAppender is responsible for flagging edges whose error rate or p95 response time regressed
+// with respect to a baseline window. The baseline window has the same duration as the graph and ends
+// BaselineOffset before the graph's query time (by default, the same time one day before).
+// An error rate regression is reported when the increase is statistically significant, using a
+// two-proportion z-test. A response time regression is reported when the p95 grows by a large factor.
+// Edges need a minimum amount of requests in both windows to be evaluated.
+// Errors are 5xx and missing responses for HTTP, and non-OK statuses for gRPC. TCP edges are ignored.
+// Name: anomaly
+type AnomalyAppender struct {
+	BaselineOffset     time.Duration
+	GraphType          string
+	InjectServiceNodes bool
+	Namespaces         graph.NamespaceInfoMap
+	QueryTime          int64 // unix time in seconds
+	Rates              graph.RequestedRates
+}
+
+// anomalyTelemetry holds the request telemetry of the edges in a window
+type anomalyTelemetry struct {
+	requests     map[string]float64 // request rate
+	errors       map[string]float64 // error rate
+	responseTime map[string]float64 // p95 response time
+}
+
+// Name implements Appender
+func (a AnomalyAppender) Name() string {
+	return AnomalyAppenderName
+}
+
+// IsFinalizer implements Appender
+func (a AnomalyAppender) IsFinalizer() bool {
+	return false
+}
+
+// AppendGraph implements Appender
+func (a AnomalyAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.AppenderGlobalInfo, namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	// Anomalies only apply to request traffic (not TCP or gRPC-message traffic)
+	if a.Rates.Grpc != graph.RateRequests && a.Rates.Http != graph.RateRequests {
+		return
+	}
+
+	if globalInfo.PromClient == nil {
+		var err error
+		globalInfo.PromClient, err = prometheus.NewClient()
+		graph.CheckError(err)
+	}
+
+	a.appendGraph(trafficMap, namespaceInfo.Namespace, globalInfo.PromClient)
+}
+
+func (a AnomalyAppender) appendGraph(trafficMap graph.TrafficMap, namespace string, client *prometheus.Client) {
+	log.Tracef("Generating anomalies using baseline offset [%v]; namespace = %v", a.BaselineOffset, namespace)
+
+	current := a.queryTelemetry(namespace, "", client)
+	baseline := a.queryTelemetry(namespace, fmt.Sprintf(" offset %vs", int(a.BaselineOffset.Seconds())), client)
+
+	applyAnomalies(trafficMap, a.Namespaces[namespace].Duration, current, baseline)
+}
+
+// queryTelemetry queries the request rate, error rate and p95 response time of the namespace edges. The
+// offset modifier, if provided, shifts the query to the baseline window.
+func (a AnomalyAppender) queryTelemetry(namespace, offset string, client *prometheus.Client) anomalyTelemetry {
+	telemetry := anomalyTelemetry{
+		requests:     make(map[string]float64),
+		errors:       make(map[string]float64),
+		responseTime: make(map[string]float64),
+	}
+	duration := int(a.Namespaces[namespace].Duration.Seconds())
+	groupBy := "source_cluster,source_workload_namespace,source_workload,source_canonical_service,source_canonical_revision,destination_cluster,destination_service_namespace,destination_service,destination_service_name,destination_workload_namespace,destination_workload,destination_canonical_service,destination_canonical_revision,request_protocol"
+	queryTime := time.Unix(a.QueryTime, 0)
+
+	// The edges of the query results are resolved like the response time edges, so reuse its processing
+	edgeResolver := ResponseTimeAppender{
+		GraphType:          a.GraphType,
+		InjectServiceNodes: a.InjectServiceNodes,
+		Rates:              a.Rates,
+	}
+
+	// note - the query order is important as both queries may have overlapping results for edges within
+	//        the namespace. The destination proxy telemetry is preferred and so must come first.
+	for _, selector := range []string{
+		fmt.Sprintf(`reporter="destination",destination_service_namespace="%s"`, namespace),
+		fmt.Sprintf(`reporter="source",source_workload_namespace="%s"`, namespace),
+	} {
+		query := fmt.Sprintf(`sum(rate(istio_requests_total{%s}[%vs]%s)) by (%s) > 0`,
+			selector, duration, offset, groupBy)
+		requests := promQuery(query, queryTime, client.GetContext(), client.API(), a)
+
+		query = fmt.Sprintf(`sum(rate(istio_requests_total{%s,request_protocol="http",response_code=~"5\\d\\d|0"}[%vs]%s) or rate(istio_requests_total{%s,request_protocol="grpc",grpc_response_status!="0"}[%vs]%s)) by (%s) > 0`,
+			selector, duration, offset, selector, duration, offset, groupBy)
+		errors := promQuery(query, queryTime, client.GetContext(), client.API(), a)
+		telemetry.addRates(edgeResolver, &requests, &errors)
+
+		query = fmt.Sprintf(`histogram_quantile(0.95, sum(rate(istio_request_duration_milliseconds_bucket{%s}[%vs]%s)) by (le,%s)) > 0`,
+			selector, duration, offset, groupBy)
+		vector := promQuery(query, queryTime, client.GetContext(), client.API(), a)
+		edgeResolver.populateResponseTimeMap(telemetry.responseTime, &vector)
+	}
+
+	return telemetry
+}
+
+// addRates sums the request and error rates of the series of one reporter by edge, as several series fold into one
+// edge on app, versionedApp and service graphs (i.e. the revisions of an app). Unlike response times, the rates of the
+// incoming edges of injected service nodes are also summed. The rates of an edge already reported by a previous
+// reporter are ignored, so that its request and error rates always come from the same reporter.
+func (t anomalyTelemetry) addRates(edgeResolver ResponseTimeAppender, requests, errors *model.Vector) {
+	reporterRequests := make(map[string]float64)
+	edgeResolver.resolveEdges(requests, true, func(key string, val float64) {
+		reporterRequests[key] += val
+	})
+	reporterErrors := make(map[string]float64)
+	edgeResolver.resolveEdges(errors, true, func(key string, val float64) {
+		reporterErrors[key] += val
+	})
+
+	for key, val := range reporterRequests {
+		if _, found := t.requests[key]; found {
+			continue
+		}
+		t.requests[key] = val
+		if errs, ok := reporterErrors[key]; ok {
+			t.errors[key] = errs
+		}
+	}
+}
+
+func applyAnomalies(trafficMap graph.TrafficMap, duration time.Duration, current, baseline anomalyTelemetry) {
+	for _, n := range trafficMap {
+		for _, e := range n.Edges {
+			key := fmt.Sprintf("%s %s %s", e.Source.ID, e.Dest.ID, e.Metadata[graph.ProtocolKey].(string))
+
+			// rates are per second, turn them into request counts for the window
+			currentRequests := current.requests[key] * duration.Seconds()
+			baselineRequests := baseline.requests[key] * duration.Seconds()
+			if currentRequests < anomalyMinRequests || baselineRequests < anomalyMinRequests {
+				continue
+			}
+
+			anomaly := graph.AnomalyInfo{}
+			currentErrorRate := math.Min(current.errors[key]/current.requests[key], 1)
+			baselineErrorRate := math.Min(baseline.errors[key]/baseline.requests[key], 1)
+			if isErrorRateRegression(currentErrorRate, currentRequests, baselineErrorRate, baselineRequests) {
+				anomaly.ErrorRate = &graph.Regression{
+					Baseline: baselineErrorRate * 100,
+					Current:  currentErrorRate * 100,
+				}
+			}
+
+			currentResponseTime, currentOk := current.responseTime[key]
+			baselineResponseTime, baselineOk := baseline.responseTime[key]
+			if currentOk && baselineOk && isResponseTimeRegression(currentResponseTime, baselineResponseTime) {
+				anomaly.ResponseTime = &graph.Regression{
+					Baseline: baselineResponseTime,
+					Current:  currentResponseTime,
+				}
+			}
+
+			if anomaly.ErrorRate != nil || anomaly.ResponseTime != nil {
+				e.Metadata[graph.HasAnomaly] = &anomaly
+			}
+		}
+	}
+}
+
+// isErrorRateRegression runs a one-sided two-proportion z-test on the error rates of both windows
+func isErrorRateRegression(currentRate, currentRequests, baselineRate, baselineRequests float64) bool {
+	if (currentRate-baselineRate)*100 < anomalyMinErrorDelta {
+		return false
+	}
+	pooled := (currentRate*currentRequests + baselineRate*baselineRequests) / (currentRequests + baselineRequests)
+	stdErr := math.Sqrt(pooled * (1 - pooled) * (1/currentRequests + 1/baselineRequests))
+	if stdErr == 0 {
+		return false
+	}
+	return (currentRate-baselineRate)/stdErr >= anomalyZScore
+}
+
+func isResponseTimeRegression(current, baseline float64) bool {
+	return current-baseline >= anomalyMinResponseTimeDelta && current >= baseline*anomalyResponseTimeRatio
+}
@@ -0,0 +1,156 @@
+package appender
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+)
+
+func TestErrorRateRegression(t *testing.T) {
+	assert := assert.New(t)
+
+	// 1% to 5% errors with plenty of requests is significant
+	assert.True(isErrorRateRegression(0.05, 1000, 0.01, 1000))
+	// the same rates with few requests is not
+	assert.False(isErrorRateRegression(0.05, 40, 0.01, 40))
+	// small increases are ignored regardless of the amount of requests
+	assert.False(isErrorRateRegression(0.015, 1000000, 0.01, 1000000))
+	// improvements are not regressions
+	assert.False(isErrorRateRegression(0.01, 1000, 0.05, 1000))
+}
+
+func TestResponseTimeRegression(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(isResponseTimeRegression(100, 50))
+	assert.False(isResponseTimeRegression(60, 50))
+	// small absolute increases are ignored
+	assert.False(isResponseTimeRegression(8, 2))
+}
+
+func TestApplyAnomalies(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap := responseTimeTestTraffic()
+	productpageID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "productpage", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeVersionedApp)
+	reviewsServiceID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "", "", "", "", graph.GraphTypeVersionedApp)
+	reviewsV1ID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	reviewsV2ID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "bookinfo", "reviews-v2", "reviews", "v2", graph.GraphTypeVersionedApp)
+	productpageEdge := fmt.Sprintf("%s %s http", productpageID, reviewsServiceID)
+	reviewsV1Edge := fmt.Sprintf("%s %s http", reviewsServiceID, reviewsV1ID)
+	reviewsV2Edge := fmt.Sprintf("%s %s http", reviewsServiceID, reviewsV2ID)
+
+	current := anomalyTelemetry{
+		requests:     map[string]float64{productpageEdge: 10, reviewsV1Edge: 5, reviewsV2Edge: 0.01},
+		errors:       map[string]float64{reviewsV1Edge: 1, reviewsV2Edge: 0.005},
+		responseTime: map[string]float64{productpageEdge: 250, reviewsV1Edge: 20},
+	}
+	baseline := anomalyTelemetry{
+		requests:     map[string]float64{productpageEdge: 10, reviewsV1Edge: 5, reviewsV2Edge: 0.01},
+		errors:       map[string]float64{},
+		responseTime: map[string]float64{productpageEdge: 100, reviewsV1Edge: 20},
+	}
+
+	applyAnomalies(trafficMap, 10*time.Minute, current, baseline)
+
+	productpage := trafficMap[productpageID]
+	anomaly := productpage.Edges[0].Metadata[graph.HasAnomaly].(*graph.AnomalyInfo)
+	assert.Nil(anomaly.ErrorRate)
+	assert.Equal(&graph.Regression{Baseline: 100, Current: 250}, anomaly.ResponseTime)
+
+	reviewsService := trafficMap[reviewsServiceID]
+	for _, e := range reviewsService.Edges {
+		switch e.Dest.ID {
+		case reviewsV1ID:
+			anomaly := e.Metadata[graph.HasAnomaly].(*graph.AnomalyInfo)
+			assert.Equal(&graph.Regression{Baseline: 0, Current: 20}, anomaly.ErrorRate)
+			assert.Nil(anomaly.ResponseTime)
+		case reviewsV2ID:
+			// not enough requests to evaluate the edge
+			assert.Nil(e.Metadata[graph.HasAnomaly])
+		}
+	}
+
+	ratingsServiceID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "ratings", "", "", "", "", graph.GraphTypeVersionedApp)
+	assert.Nil(trafficMap[ratingsServiceID].Edges[0].Metadata[graph.HasAnomaly])
+}
+
+func anomalyTestMetric(sourceWl, sourceVer, destWl, destVer string) model.Metric {
+	return model.Metric{
+		"source_cluster":                 business.DefaultClusterID,
+		"source_workload_namespace":      "bookinfo",
+		"source_workload":                model.LabelValue(sourceWl),
+		"source_canonical_service":       "productpage",
+		"source_canonical_revision":      model.LabelValue(sourceVer),
+		"destination_cluster":            business.DefaultClusterID,
+		"destination_service_namespace":  "bookinfo",
+		"destination_service":            "reviews.bookinfo.svc.cluster.local",
+		"destination_service_name":       "reviews",
+		"destination_workload_namespace": "bookinfo",
+		"destination_workload":           model.LabelValue(destWl),
+		"destination_canonical_service":  "reviews",
+		"destination_canonical_revision": model.LabelValue(destVer),
+		"request_protocol":               "http"}
+}
+
+func newAnomalyTestTelemetry() anomalyTelemetry {
+	return anomalyTelemetry{
+		requests:     make(map[string]float64),
+		errors:       make(map[string]float64),
+		responseTime: make(map[string]float64),
+	}
+}
+
+func TestAnomalyRatesSumTheSeriesOfAnEdge(t *testing.T) {
+	assert := assert.New(t)
+
+	rates := graph.RequestedRates{Grpc: graph.RateRequests, Http: graph.RateRequests}
+	destRequests := model.Vector{
+		&model.Sample{Metric: anomalyTestMetric("productpage-v1", "v1", "reviews-v1", "v1"), Value: 10},
+		&model.Sample{Metric: anomalyTestMetric("productpage-v1", "v1", "reviews-v2", "v2"), Value: 30},
+	}
+	destErrors := model.Vector{
+		&model.Sample{Metric: anomalyTestMetric("productpage-v1", "v1", "reviews-v2", "v2"), Value: 4},
+	}
+	// The source reporter of the same edges is ignored
+	sourceRequests := model.Vector{
+		&model.Sample{Metric: anomalyTestMetric("productpage-v1", "v1", "reviews-v1", "v1"), Value: 12},
+	}
+	sourceErrors := model.Vector{
+		&model.Sample{Metric: anomalyTestMetric("productpage-v1", "v1", "reviews-v1", "v1"), Value: 12},
+	}
+
+	// The traffic to both reviews versions folds into the productpage -> reviews edge of an app graph
+	telemetry := newAnomalyTestTelemetry()
+	edgeResolver := ResponseTimeAppender{GraphType: graph.GraphTypeApp, Rates: rates}
+	telemetry.addRates(edgeResolver, &destRequests, &destErrors)
+	telemetry.addRates(edgeResolver, &sourceRequests, &sourceErrors)
+
+	productpageID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeApp)
+	reviewsID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeApp)
+	key := fmt.Sprintf("%s %s http", productpageID, reviewsID)
+	assert.Equal(map[string]float64{key: 40}, telemetry.requests)
+	assert.Equal(map[string]float64{key: 4}, telemetry.errors)
+
+	// With an injected service node, the incoming edge of the service sums the traffic of its outgoing edges
+	telemetry = newAnomalyTestTelemetry()
+	edgeResolver = ResponseTimeAppender{GraphType: graph.GraphTypeVersionedApp, InjectServiceNodes: true, Rates: rates}
+	telemetry.addRates(edgeResolver, &destRequests, &destErrors)
+	telemetry.addRates(edgeResolver, &sourceRequests, &sourceErrors)
+
+	productpageID, _ = graph.Id(business.DefaultClusterID, "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeVersionedApp)
+	reviewsServiceID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "", "", "", "", graph.GraphTypeVersionedApp)
+	reviewsV1ID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	reviewsV2ID, _ := graph.Id(business.DefaultClusterID, "bookinfo", "reviews", "bookinfo", "reviews-v2", "reviews", "v2", graph.GraphTypeVersionedApp)
+	serviceKey := fmt.Sprintf("%s %s http", productpageID, reviewsServiceID)
+	v1Key := fmt.Sprintf("%s %s http", reviewsServiceID, reviewsV1ID)
+	v2Key := fmt.Sprintf("%s %s http", reviewsServiceID, reviewsV2ID)
+	assert.Equal(map[string]float64{serviceKey: 40, v1Key: 10, v2Key: 30}, telemetry.requests)
+	assert.Equal(map[string]float64{serviceKey: 4, v2Key: 4}, telemetry.errors)
+}
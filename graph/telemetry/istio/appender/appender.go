@@ -3,6 +3,9 @@ package appender
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
 
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/config"
@@ -12,6 +15,7 @@ import (
 
 const (
 	defaultAggregate      = "request_operation"
+	defaultAnomalyOffset  = "1d"
 	defaultQuantile       = 0.95
 	defaultThroughputType = "response"
 )
@@ -29,6 +33,8 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 			// namespace appenders
 			case AggregateNodeAppenderName:
 				requestedAppenders[AggregateNodeAppenderName] = true
+			case AnomalyAppenderName:
+				requestedAppenders[AnomalyAppenderName] = true
 			case DeadNodeAppenderName:
 				requestedAppenders[DeadNodeAppenderName] = true
 			case IdleNodeAppenderName:
@@ -118,6 +124,26 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 		}
 		appenders = append(appenders, a)
 	}
+	// the anomaly appender doubles the telemetry queries, so it only runs when explicitly requested
+	if _, ok := requestedAppenders[AnomalyAppenderName]; ok {
+		baselineOffset, _ := model.ParseDuration(defaultAnomalyOffset)
+		if anomalyOffset := o.Params.Get("anomalyOffset"); anomalyOffset != "" {
+			var err error
+			baselineOffset, err = model.ParseDuration(anomalyOffset)
+			if err != nil || baselineOffset <= 0 {
+				graph.BadRequest(fmt.Sprintf("Invalid anomalyOffset [%s]", anomalyOffset))
+			}
+		}
+		a := AnomalyAppender{
+			BaselineOffset:     time.Duration(baselineOffset),
+			GraphType:          o.GraphType,
+			InjectServiceNodes: o.InjectServiceNodes,
+			Namespaces:         o.Namespaces,
+			QueryTime:          o.QueryTime,
+			Rates:              o.Rates,
+		}
+		appenders = append(appenders, a)
+	}
 	if _, ok := requestedAppenders[SecurityPolicyAppenderName]; ok || o.Appenders.All {
 		a := SecurityPolicyAppender{
 			GraphType:          o.GraphType,
@@ -21,6 +21,7 @@ const IstioAppenderName = "istio"
 // - CircuitBreaker: n.Metadata[HasCB] = true
 // - Ingress Gateways: n.Metadata[IsIngressGateway] = Map of GatewayName => hosts
 // - VirtualService: n.Metadata[HasVS] = Map of VirtualServiceName => hosts
+// - K8s HTTPRoute: n.Metadata[HasRequestRouting|HasTrafficShifting] = true for backend services
 // Name: istio
 type IstioAppender struct {
 	AccessibleNamespaces map[string]time.Time
@@ -58,12 +59,14 @@ func addBadging(trafficMap graph.TrafficMap, globalInfo *graph.AppenderGlobalInf
 	graph.CheckError(err)
 	istioCfgVirtualServices, err := globalInfo.Business.IstioConfig.GetIstioConfigList(context.TODO(), business.IstioConfigCriteria{
 		IncludeVirtualServices: true,
+		IncludeK8sHTTPRoutes:   true,
 		AllNamespaces:          true,
 	})
 	graph.CheckError(err)
 
 	applyCircuitBreakers(trafficMap, namespaceInfo.Namespace, istioCfgDestionationRules)
 	applyVirtualServices(trafficMap, namespaceInfo.Namespace, istioCfgVirtualServices)
+	applyK8sHTTPRoutes(trafficMap, istioCfgVirtualServices)
 }
 
 func applyCircuitBreakers(trafficMap graph.TrafficMap, namespace string, istioCfg models.IstioConfigList) {
@@ -162,6 +165,28 @@ NODES:
 	}
 }
 
+func applyK8sHTTPRoutes(trafficMap graph.TrafficMap, istioCfg models.IstioConfigList) {
+NODES:
+	for _, n := range trafficMap {
+		if n.NodeType != graph.NodeTypeService {
+			continue
+		}
+		for _, route := range istioCfg.K8sHTTPRoutes {
+			if models.IsK8sHTTPRouteValidBackend(&route, n.Namespace, n.Service) {
+				if models.HasK8sHTTPRouteRequestRouting(&route) {
+					n.Metadata[graph.HasRequestRouting] = true
+				}
+
+				if models.HasK8sHTTPRouteTrafficShifting(&route) {
+					n.Metadata[graph.HasTrafficShifting] = true
+				}
+
+				continue NODES
+			}
+		}
+	}
+}
+
 // addLabels is a chance to add any missing label info to nodes when the telemetry does not provide enough information.
 // For example, service injection has this problem.
 func addLabels(trafficMap graph.TrafficMap, globalInfo *graph.AppenderGlobalInfo, sdl *models.ServiceList) {
@@ -149,6 +149,19 @@ func applyResponseTime(trafficMap graph.TrafficMap, responseTimeMap map[string]f
 }
 
 func (a ResponseTimeAppender) populateResponseTimeMap(responseTimeMap map[string]float64, vector *model.Vector) {
+	a.resolveEdges(vector, false, func(key string, val float64) {
+		// For edges within the namespace we may get a responseTime reported from both the incoming and outgoing
+		// traffic queries.  We assume here the first reported value is preferred (i.e. defer to query order)
+		if _, found := responseTimeMap[key]; !found {
+			responseTimeMap[key] = val
+		}
+	})
+}
+
+// resolveEdges resolves the edge of every sample of the vector, and passes its key ("<sourceID> <destID> <protocol>")
+// and value to add. Several samples can resolve to the same edge, i.e. the versions of an app in an app graph.
+// When a service node is injected, only the outgoing edge of the service is resolved, unless incoming is set.
+func (a ResponseTimeAppender) resolveEdges(vector *model.Vector, incoming bool, add func(key string, val float64)) {
 	skipRequestsGrpc := a.Rates.Grpc != graph.RateRequests
 	skipRequestsHttp := a.Rates.Http != graph.RateRequests
 
@@ -170,7 +183,7 @@ func (a ResponseTimeAppender) populateResponseTimeMap(responseTimeMap map[string
 		lProtocol, protocolOk := m["request_protocol"]
 
 		if !sourceWlNsOk || !sourceWlOk || !sourceAppOk || !sourceVerOk || !destSvcNsOk || !destSvcNameOk || !destSvcOk || !destWlNsOk || !destWlOk || !destAppOk || !destVerOk || !protocolOk {
-			log.Warningf("populateResponseTimeMap: Skipping %s, missing expected labels", m.String())
+			log.Warningf("resolveEdges: Skipping %s, missing expected labels", m.String())
 			continue
 		}
 
@@ -218,21 +231,18 @@ func (a ResponseTimeAppender) populateResponseTimeMap(responseTimeMap map[string
 
 		if inject {
 			// Only set response time on the outgoing edge. On the incoming edge, we can't validly aggregate response times of the outgoing edges (kiali-2297)
-			a.addResponseTime(responseTimeMap, val, protocol, destCluster, destSvcNs, destSvcName, "", "", "", destCluster, destSvcNs, destSvcName, destWlNs, destWl, destApp, destVer)
+			add(a.edgeKey(protocol, destCluster, destSvcNs, destSvcName, "", "", "", destCluster, destSvcNs, destSvcName, destWlNs, destWl, destApp, destVer), val)
+			if incoming {
+				add(a.edgeKey(protocol, sourceCluster, sourceWlNs, "", sourceWl, sourceApp, sourceVer, destCluster, destSvcNs, destSvcName, "", "", "", ""), val)
+			}
 		} else {
-			a.addResponseTime(responseTimeMap, val, protocol, sourceCluster, sourceWlNs, "", sourceWl, sourceApp, sourceVer, destCluster, destSvcNs, destSvcName, destWlNs, destWl, destApp, destVer)
+			add(a.edgeKey(protocol, sourceCluster, sourceWlNs, "", sourceWl, sourceApp, sourceVer, destCluster, destSvcNs, destSvcName, destWlNs, destWl, destApp, destVer), val)
 		}
 	}
 }
 
-func (a ResponseTimeAppender) addResponseTime(responseTimeMap map[string]float64, val float64, protocol, sourceCluster, sourceNs, sourceSvc, sourceWl, sourceApp, sourceVer, destCluster, destSvcNs, destSvc, destWlNs, destWl, destApp, destVer string) {
+func (a ResponseTimeAppender) edgeKey(protocol, sourceCluster, sourceNs, sourceSvc, sourceWl, sourceApp, sourceVer, destCluster, destSvcNs, destSvc, destWlNs, destWl, destApp, destVer string) string {
 	sourceID, _ := graph.Id(sourceCluster, sourceNs, sourceSvc, sourceNs, sourceWl, sourceApp, sourceVer, a.GraphType)
 	destID, _ := graph.Id(destCluster, destSvcNs, destSvc, destWlNs, destWl, destApp, destVer, a.GraphType)
-	key := fmt.Sprintf("%s %s %s", sourceID, destID, protocol)
-
-	// For edges within the namespace we may get a responseTime reported from both the incoming and outgoing
-	// traffic queries.  We assume here the first reported value is preferred (i.e. defer to query order)
-	if _, found := responseTimeMap[key]; !found {
-		responseTimeMap[key] = val
-	}
+	return fmt.Sprintf("%s %s %s", sourceID, destID, protocol)
 }
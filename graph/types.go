@@ -69,6 +69,18 @@ type WEInfo struct {
 	Name string `json:"name"`
 }
 
+// AnomalyInfo describes the regressions detected on an edge, with respect to a baseline window
+type AnomalyInfo struct {
+	ErrorRate    *Regression `json:"errorRate,omitempty"`    // percentage of failed requests
+	ResponseTime *Regression `json:"responseTime,omitempty"` // p95 response time, in millis
+}
+
+// Regression holds the baseline and current values of a regressed metric
+type Regression struct {
+	Baseline float64 `json:"baseline"`
+	Current  float64 `json:"current"`
+}
+
 // SEInfo provides static information about the service entry
 type SEInfo struct {
 	Hosts     []string `json:"hosts"`     // configured list of hosts
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/business/audit"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/util"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+
+	// maxAuditBodySize is the number of bytes of the request body kept in an audit entry
+	maxAuditBodySize = 64 * 1024
+)
+
+// auditResponseWriter keeps the status code and the error responses of an audited handler
+type auditResponseWriter struct {
+	http.ResponseWriter
+	body       bytes.Buffer
+	statusCode int
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	w.statusCode = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.statusCode >= http.StatusBadRequest && w.body.Len() < maxAuditBodySize {
+		w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Audit wraps a handler performing a write operation, so that every call is recorded in the audit log
+// with the user of the session, the target object, the request body and the outcome of the operation.
+// Only the first maxAuditBodySize bytes of the body are recorded, the handler still gets the whole body.
+func Audit(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !config.Get().Server.AuditLog {
+			next(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxAuditBodySize+1))
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Request body could not be read: "+err.Error())
+			return
+		}
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+		arw := &auditResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next(arw, r)
+
+		entry := newAuditEntry(r, body, arw)
+		log.Infof("AUDIT User [%s] Msg [%s on Namespace: %s Type: %s Name: %s Status: %d]", entry.User, entry.Method, entry.Namespace, entry.ObjectType, entry.ObjectName, entry.StatusCode)
+		audit.Record(entry)
+	}
+}
+
+func newAuditEntry(r *http.Request, body []byte, arw *auditResponseWriter) audit.Entry {
+	entry := audit.Entry{
+		Timestamp:    util.Clock.Now(),
+		User:         r.Header.Get("Kiali-User"),
+		AuthStrategy: config.Get().Auth.Strategy,
+		Method:       r.Method,
+		Path:         r.URL.Path,
+		Query:        r.URL.RawQuery,
+		Body:         auditBody(body),
+		Outcome:      audit.OutcomeSuccess,
+		StatusCode:   arw.statusCode,
+	}
+	if route := mux.CurrentRoute(r); route != nil {
+		entry.Operation = route.GetName()
+	}
+
+	vars := mux.Vars(r)
+	entry.Namespace = vars["namespace"]
+	switch {
+	case vars["object_type"] != "":
+		entry.ObjectType = vars["object_type"]
+		entry.ObjectName = vars["object"]
+		if entry.ObjectName == "" {
+			// creations carry the name in the object
+			var object struct {
+				Metadata struct {
+					Name string `json:"name"`
+				} `json:"metadata"`
+			}
+			if err := json.Unmarshal(body, &object); err == nil {
+				entry.ObjectName = object.Metadata.Name
+			}
+		}
+	case vars["workload"] != "":
+		entry.ObjectType = "workloads"
+		entry.ObjectName = vars["workload"]
+	case vars["service"] != "":
+		entry.ObjectType = "services"
+		entry.ObjectName = vars["service"]
+	case vars["pod"] != "":
+		entry.ObjectType = "pods"
+		entry.ObjectName = vars["pod"]
+	case vars["session"] != "":
+		entry.ObjectType = "sessions"
+		entry.ObjectName = vars["session"]
+	case entry.Namespace != "":
+		entry.ObjectType = "namespaces"
+		entry.ObjectName = entry.Namespace
+	}
+
+	if arw.statusCode >= http.StatusBadRequest {
+		entry.Outcome = audit.OutcomeFailure
+		var response responseError
+		if err := json.Unmarshal(arw.body.Bytes(), &response); err == nil && response.Error != "" {
+			entry.Error = response.Error
+		} else {
+			entry.Error = http.StatusText(arw.statusCode)
+		}
+	}
+	return entry
+}
+
+// auditBody returns the body recorded in an audit entry, marking the bodies exceeding maxAuditBodySize as truncated
+func auditBody(body []byte) string {
+	if len(body) > maxAuditBodySize {
+		return string(body[:maxAuditBodySize]) + "...[truncated]"
+	}
+	return string(body)
+}
+
+// AuditLog is the API handler to search the audit log. The entries are filtered by the user, namespace, objectType,
+// from and to (unix time in seconds) query params, and paginated with the offset and limit query params. Users only
+// get the entries of the namespaces they can access, and their own entries not bound to a namespace.
+func AuditLog(w http.ResponseWriter, r *http.Request) {
+	if !config.Get().Server.AuditLog {
+		RespondWithError(w, http.StatusNotFound, "The audit log is disabled")
+		return
+	}
+
+	params := r.URL.Query()
+	query := audit.Query{
+		User:       params.Get("user"),
+		Namespace:  params.Get("namespace"),
+		ObjectType: params.Get("objectType"),
+		Limit:      defaultAuditLimit,
+	}
+	var err error
+	if query.From, err = parseAuditTime(params.Get("from")); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid from: "+err.Error())
+		return
+	}
+	if query.To, err = parseAuditTime(params.Get("to")); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid to: "+err.Error())
+		return
+	}
+	if offset := params.Get("offset"); offset != "" {
+		if query.Offset, err = strconv.Atoi(offset); err != nil || query.Offset < 0 {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid offset [%s]", offset))
+			return
+		}
+	}
+	if limit := params.Get("limit"); limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil || query.Limit <= 0 || query.Limit > maxAuditLimit {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit [%s], it must be between 1 and %d", limit, maxAuditLimit))
+			return
+		}
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Audit initialization error: "+err.Error())
+		return
+	}
+	namespaces, err := business.Namespace.GetNamespaces(r.Context())
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	accessible := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		accessible[ns.Name] = true
+	}
+	user := r.Header.Get("Kiali-User")
+	query.Visible = func(e audit.Entry) bool {
+		if e.Namespace == "" {
+			return e.User == user
+		}
+		return accessible[e.Namespace]
+	}
+
+	page, err := audit.GetSink().Search(query)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Audit log search error: "+err.Error())
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, page)
+}
+
+func parseAuditTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(seconds, 0), nil
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/business/audit"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/util"
+)
+
+func setupAuditTestServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, audit.Sink) {
+	conf := config.NewConfig()
+	conf.Server.AuditLog = true
+	config.Set(conf)
+	util.Clock = util.ClockMock{Time: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)}
+
+	sink := audit.NewMemorySink(10)
+	audit.SetSink(sink)
+
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/{object_type}", Audit(handler)).Name("IstioConfigCreate")
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/{object_type}/{object}", Audit(handler)).Name("IstioConfigUpdate")
+	ts := httptest.NewServer(mr)
+	t.Cleanup(ts.Close)
+
+	return ts, sink
+}
+
+func TestAuditRecordsSuccessfulOperation(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	var received []byte
+	ts, sink := setupAuditTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+		RespondWithJSON(w, http.StatusOK, "updated")
+	})
+
+	patch := `{"spec":{"hosts":["reviews"]}}`
+	req, err := http.NewRequest(http.MethodPatch, ts.URL+"/api/namespaces/bookinfo/istio/virtualservices/reviews", bytes.NewBufferString(patch))
+	require.NoError(err)
+	req.Header.Set("Kiali-User", "alice")
+	resp, err := ts.Client().Do(req)
+	require.NoError(err)
+	resp.Body.Close()
+
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal(patch, string(received), "the audited handler must get the request body")
+
+	page, err := sink.Search(audit.Query{})
+	require.NoError(err)
+	require.Len(page.Entries, 1)
+	entry := page.Entries[0]
+	assert.Equal("alice", entry.User)
+	assert.Equal(config.Get().Auth.Strategy, entry.AuthStrategy)
+	assert.Equal("IstioConfigUpdate", entry.Operation)
+	assert.Equal(http.MethodPatch, entry.Method)
+	assert.Equal("bookinfo", entry.Namespace)
+	assert.Equal("virtualservices", entry.ObjectType)
+	assert.Equal("reviews", entry.ObjectName)
+	assert.Equal(patch, entry.Body)
+	assert.Equal(audit.OutcomeSuccess, entry.Outcome)
+	assert.Equal(http.StatusOK, entry.StatusCode)
+	assert.Empty(entry.Error)
+}
+
+func TestAuditRecordsFailedOperation(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ts, sink := setupAuditTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		RespondWithError(w, http.StatusForbidden, "user cannot create virtualservices")
+	})
+
+	object := `{"metadata":{"name":"ratings"}}`
+	resp, err := ts.Client().Post(ts.URL+"/api/namespaces/bookinfo/istio/virtualservices", "application/json", bytes.NewBufferString(object))
+	require.NoError(err)
+	resp.Body.Close()
+
+	assert.Equal(http.StatusForbidden, resp.StatusCode)
+
+	page, err := sink.Search(audit.Query{})
+	require.NoError(err)
+	require.Len(page.Entries, 1)
+	entry := page.Entries[0]
+	assert.Equal("IstioConfigCreate", entry.Operation)
+	assert.Equal("ratings", entry.ObjectName)
+	assert.Equal(audit.OutcomeFailure, entry.Outcome)
+	assert.Equal(http.StatusForbidden, entry.StatusCode)
+	assert.Equal("user cannot create virtualservices", entry.Error)
+}
+
+func TestAuditTruncatesLargeBody(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	var received []byte
+	ts, sink := setupAuditTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+		RespondWithJSON(w, http.StatusOK, "updated")
+	})
+
+	patch := `{"metadata":{"annotations":{"large":"` + strings.Repeat("x", maxAuditBodySize) + `"}}}`
+	req, err := http.NewRequest(http.MethodPatch, ts.URL+"/api/namespaces/bookinfo/istio/virtualservices/reviews", bytes.NewBufferString(patch))
+	require.NoError(err)
+	resp, err := ts.Client().Do(req)
+	require.NoError(err)
+	resp.Body.Close()
+
+	assert.Equal(patch, string(received), "the audited handler must get the whole request body")
+
+	page, err := sink.Search(audit.Query{})
+	require.NoError(err)
+	require.Len(page.Entries, 1)
+	assert.Equal(patch[:maxAuditBodySize]+"...[truncated]", page.Entries[0].Body)
+}
+
+func TestAuditDisabled(t *testing.T) {
+	require := require.New(t)
+
+	ts, sink := setupAuditTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		RespondWithCode(w, http.StatusOK)
+	})
+	conf := config.NewConfig()
+	conf.Server.AuditLog = false
+	config.Set(conf)
+
+	resp, err := ts.Client().Post(ts.URL+"/api/namespaces/bookinfo/istio/virtualservices", "application/json", nil)
+	require.NoError(err)
+	resp.Body.Close()
+
+	page, err := sink.Search(audit.Query{})
+	require.NoError(err)
+	require.Empty(page.Entries)
+}
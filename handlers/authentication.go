@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/gorilla/mux"
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/kiali/kiali/business/authentication"
@@ -183,3 +184,61 @@ func Logout(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// checkSessionsAdmin verifies that sessions are kept server-side and that the user of the request is
+// configured as a sessions administrator. If the check fails, an error response is sent and nil is returned.
+func checkSessionsAdmin(w http.ResponseWriter, r *http.Request) *authentication.ServerSessionPersistor {
+	persistor := authentication.GetServerSessionPersistor()
+	if persistor == nil {
+		RespondWithError(w, http.StatusBadRequest, "Sessions are stored in browser cookies and cannot be managed. Configure a server-side session store.")
+		return nil
+	}
+
+	session, err := authentication.GetAuthController().ValidateSession(r, w)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return nil
+	}
+	if session == nil {
+		RespondWithError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		return nil
+	}
+
+	for _, admin := range config.Get().Auth.Session.Admins {
+		if admin == session.Username {
+			return persistor
+		}
+	}
+	RespondWithError(w, http.StatusForbidden, fmt.Sprintf("User [%s] is not allowed to manage sessions", session.Username))
+	return nil
+}
+
+// ListSessions is the API handler to list the active sessions of a server-side session store
+func ListSessions(w http.ResponseWriter, r *http.Request) {
+	persistor := checkSessionsAdmin(w, r)
+	if persistor == nil {
+		return
+	}
+
+	sessions, err := persistor.ListSessions()
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, sessions)
+}
+
+// RevokeSession is the API handler to terminate an active session of a server-side session store
+func RevokeSession(w http.ResponseWriter, r *http.Request) {
+	persistor := checkSessionsAdmin(w, r)
+	if persistor == nil {
+		return
+	}
+
+	id := mux.Vars(r)["session"]
+	if err := persistor.RevokeSession(id); err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	RespondWithCode(w, http.StatusNoContent)
+}
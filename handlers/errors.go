@@ -25,6 +25,8 @@ func handleErrorResponse(w http.ResponseWriter, err error, extraMesg ...string)
 		RespondWithError(w, http.StatusNotFound, errorMsg)
 	} else if errors.IsServiceUnavailable(err) {
 		RespondWithError(w, http.StatusServiceUnavailable, errorMsg)
+	} else if errors.IsRequestEntityTooLargeError(err) {
+		RespondWithError(w, http.StatusRequestEntityTooLarge, errorMsg)
 	} else if statusError, isStatus := err.(*errors.StatusError); isStatus {
 		errorMsg = statusError.ErrStatus.Message
 		RespondWithError(w, http.StatusInternalServerError, errorMsg)
@@ -15,10 +15,16 @@ package handlers
 // The current Handlers:
 //   GraphNamespaces: Generate a graph for one or more requested namespaces.
 //   GraphNode:       Generate a graph for a specific node, detailing the immediate incoming and outgoing traffic.
+//   GraphDependencies: Query the upstream or downstream dependencies of a node of a namespaces graph.
+//   GraphPath:       Query the shortest traffic path between two nodes of a namespaces graph.
+//   GraphCycles:     Query the traffic cycles of a namespaces graph.
+//   GraphNamespacesStream: Stream a namespaces graph, refreshed every refreshInterval, as server-sent events.
 //
 // The handlers accept the following query parameters (see notes below)
 //   appenders:       Comma-separated list of TelemetryVendor-specific appenders to run. (default: all)
-//   configVendor:    default: cytoscape
+//   compareOffset:   Compare with a baseline window ending compareOffset before queryTime (e.g. 1h). (default: no comparison)
+//   compareTime:     Compare with a baseline window ending at this Unix time (seconds). (default: no comparison)
+//   configVendor:    cytoscape | dot | graphml | mermaid (default: cytoscape)
 //   duration:        time.Duration indicating desired query range duration, (default: 10m)
 //   graphType:       Determines how to present the telemetry data. app | service | versionedApp | workload (default: workload)
 //   boxBy:           If supported by vendor, visually box by a specified node attribute (default: none)
@@ -30,9 +36,13 @@ package handlers
 //  Note: vendors may support additional, vendor-specific query parameters.
 //
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"runtime/debug"
+	"time"
+
+	"github.com/prometheus/common/model"
 
 	"github.com/kiali/kiali/graph"
 	"github.com/kiali/kiali/graph/api"
@@ -52,6 +62,80 @@ func GraphNamespaces(w http.ResponseWriter, r *http.Request) {
 	respond(w, code, payload)
 }
 
+const (
+	defaultGraphStreamInterval = 10 * time.Second
+	minGraphStreamInterval     = 5 * time.Second
+	// graphStreamLifetime ends the streams before the server write timeout. Clients reconnect
+	// with the Last-Event-ID header and keep receiving deltas.
+	graphStreamLifetime = 25 * time.Second
+)
+
+// GraphNamespacesStream is a REST http.HandlerFunc streaming a namespaces graph as server-sent events. The first
+// event holds the full graph, the following events hold the node and edge changes of every refresh. The graph is
+// refreshed every refreshInterval (default 10s, minimum 5s) and is shared by all the requests with the same options.
+func GraphNamespacesStream(w http.ResponseWriter, r *http.Request) {
+	defer handlePanic(w)
+
+	o := graph.NewOptions(r)
+
+	interval := defaultGraphStreamInterval
+	if intervalString := r.URL.Query().Get("refreshInterval"); intervalString != "" {
+		duration, err := model.ParseDuration(intervalString)
+		if err != nil || time.Duration(duration) < minGraphStreamInterval {
+			graph.BadRequest(fmt.Sprintf("Invalid refreshInterval [%s], it must be a duration of at least %v", intervalString, minGraphStreamInterval))
+		}
+		interval = time.Duration(duration)
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		graph.Error("Streaming is not supported by the connection")
+	}
+
+	business, err := getBusiness(r)
+	graph.CheckError(err)
+
+	events, unsubscribe := api.SubscribeGraphNamespaces(business, o, interval, r.Header.Get("Last-Event-ID"))
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	lifetime := time.NewTimer(graphStreamLifetime)
+	defer lifetime.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-lifetime.C:
+			return
+		case event := <-events:
+			if err := writeGraphStreamEvent(w, event); err != nil {
+				log.Debugf("Closing graph stream: %v", err)
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeGraphStreamEvent writes the event with the server-sent events format
+func writeGraphStreamEvent(w http.ResponseWriter, event api.GraphStreamEvent) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+	if event.ID != "" {
+		if _, err = fmt.Fprintf(w, "id: %s\n", event.ID); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
+
 // GraphNode is a REST http.HandlerFunc handling node-detail graph config generation.
 func GraphNode(w http.ResponseWriter, r *http.Request) {
 	defer handlePanic(w)
@@ -65,6 +149,62 @@ func GraphNode(w http.ResponseWriter, r *http.Request) {
 	respond(w, code, payload)
 }
 
+// GraphDependencies is a REST http.HandlerFunc handling dependency queries over a namespaces graph.
+// It requires the node query param, <app|service|workload>:<namespace>/<name>[/<version>], and accepts
+// the direction query param, downstream (default) or upstream.
+func GraphDependencies(w http.ResponseWriter, r *http.Request) {
+	defer handlePanic(w)
+
+	o := graph.NewOptions(r)
+
+	query := r.URL.Query()
+	node := api.ParseNodeRef("node", query.Get("node"))
+	direction := query.Get("direction")
+	if direction == "" {
+		direction = graph.Downstream
+	}
+	if direction != graph.Downstream && direction != graph.Upstream {
+		graph.BadRequest(fmt.Sprintf("Invalid direction [%s]", direction))
+	}
+
+	business, err := getBusiness(r)
+	graph.CheckError(err)
+
+	code, payload := api.GraphDependencies(r.Context(), business, o, node, direction)
+	respond(w, code, payload)
+}
+
+// GraphPath is a REST http.HandlerFunc handling shortest path queries over a namespaces graph.
+// It requires the source and target query params, <app|service|workload>:<namespace>/<name>[/<version>].
+func GraphPath(w http.ResponseWriter, r *http.Request) {
+	defer handlePanic(w)
+
+	o := graph.NewOptions(r)
+
+	query := r.URL.Query()
+	source := api.ParseNodeRef("source", query.Get("source"))
+	target := api.ParseNodeRef("target", query.Get("target"))
+
+	business, err := getBusiness(r)
+	graph.CheckError(err)
+
+	code, payload := api.GraphPath(r.Context(), business, o, source, target)
+	respond(w, code, payload)
+}
+
+// GraphCycles is a REST http.HandlerFunc handling traffic cycle queries over a namespaces graph.
+func GraphCycles(w http.ResponseWriter, r *http.Request) {
+	defer handlePanic(w)
+
+	o := graph.NewOptions(r)
+
+	business, err := getBusiness(r)
+	graph.CheckError(err)
+
+	code, payload := api.GraphCycles(r.Context(), business, o)
+	respond(w, code, payload)
+}
+
 func handlePanic(w http.ResponseWriter) {
 	code := http.StatusInternalServerError
 	if r := recover(); r != nil {
@@ -94,6 +234,13 @@ func handlePanic(w http.ResponseWriter) {
 
 func respond(w http.ResponseWriter, code int, payload interface{}) {
 	if code == http.StatusOK {
+		if textConfig, ok := payload.(graph.TextConfig); ok {
+			response := textConfig.String()
+			w.Header().Set("Content-Type", textConfig.ContentType())
+			w.WriteHeader(code)
+			_, _ = w.Write([]byte(response))
+			return
+		}
 		RespondWithJSONIndent(w, code, payload)
 		return
 	}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -10,10 +11,16 @@ import (
 
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
 	"github.com/kiali/kiali/util"
 )
 
-const defaultHealthRateInterval = "10m"
+const (
+	defaultHealthRateInterval = "10m"
+	// maxHealthTimelinePoints bounds the number of steps of a health timeline
+	maxHealthTimelinePoints = 1000
+)
 
 // NamespaceHealth is the API handler to get app-based health of every services in the given namespace
 func NamespaceHealth(w http.ResponseWriter, r *http.Request) {
@@ -63,6 +70,65 @@ func NamespaceHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// AppHealthTimeline is the API handler to get the request health of an app at every step of a time range
+func AppHealthTimeline(w http.ResponseWriter, r *http.Request) {
+	healthTimeline(w, r, func(layer *business.Layer, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+		vars := mux.Vars(r)
+		return layer.Health.GetAppHealthTimeline(r.Context(), vars["namespace"], vars["app"], q)
+	})
+}
+
+// ServiceHealthTimeline is the API handler to get the request health of a service at every step of a time range
+func ServiceHealthTimeline(w http.ResponseWriter, r *http.Request) {
+	healthTimeline(w, r, func(layer *business.Layer, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+		vars := mux.Vars(r)
+		return layer.Health.GetServiceHealthTimeline(r.Context(), vars["namespace"], vars["service"], q)
+	})
+}
+
+// WorkloadHealthTimeline is the API handler to get the request health of a workload at every step of a time range
+func WorkloadHealthTimeline(w http.ResponseWriter, r *http.Request) {
+	healthTimeline(w, r, func(layer *business.Layer, q prometheus.RangeQuery) (*models.HealthTimeline, error) {
+		vars := mux.Vars(r)
+		return layer.Health.GetWorkloadHealthTimeline(r.Context(), vars["namespace"], vars["workload"], q)
+	})
+}
+
+func healthTimeline(w http.ResponseWriter, r *http.Request, fetch func(*business.Layer, prometheus.RangeQuery) (*models.HealthTimeline, error)) {
+	// Get business layer
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+	namespaceInfo, err := layer.Namespace.GetNamespace(r.Context(), mux.Vars(r)["namespace"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	// Defaults to the last hour, evaluated every minute
+	q := prometheus.RangeQuery{}
+	q.FillDefaults()
+	q.Start = q.End.Add(-time.Hour)
+	q.Step = time.Minute
+	if err := extractBaseMetricsQueryParams(r.URL.Query(), &q, namespaceInfo); err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if q.Step <= 0 || q.End.Sub(q.Start)/q.Step >= maxHealthTimelinePoints {
+		RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("bad request, the health timeline is limited to %d steps", maxHealthTimelinePoints))
+		return
+	}
+
+	timeline, err := fetch(layer, q)
+	if err != nil {
+		handleErrorResponse(w, err, "Error while fetching health timeline: "+err.Error())
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, timeline)
+}
+
 type baseHealthParams struct {
 	// The namespace scope
 	//
@@ -2,16 +2,17 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/gorilla/mux"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/kiali/kiali/business"
-	"github.com/kiali/kiali/config"
-	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/models"
 )
 
@@ -196,7 +197,6 @@ func IstioConfigDelete(w http.ResponseWriter, r *http.Request) {
 		handleErrorResponse(w, err)
 		return
 	} else {
-		audit(r, "DELETE on Namespace: "+namespace+" Type: "+objectType+" Name: "+object)
 		RespondWithCode(w, http.StatusOK)
 	}
 }
@@ -224,6 +224,17 @@ func IstioConfigUpdate(w http.ResponseWriter, r *http.Request) {
 		RespondWithError(w, http.StatusBadRequest, "Update request with bad update patch: "+err.Error())
 	}
 	jsonPatch := string(body)
+
+	if dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dryRun")); dryRun {
+		preview, err := business.IstioConfig.PreviewIstioConfigUpdate(r.Context(), namespace, objectType, object, jsonPatch)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		RespondWithJSON(w, http.StatusOK, preview)
+		return
+	}
+
 	updatedConfigDetails, err := business.IstioConfig.UpdateIstioConfigDetail(namespace, objectType, object, jsonPatch)
 
 	if err != nil {
@@ -231,7 +242,6 @@ func IstioConfigUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	audit(r, "UPDATE on Namespace: "+namespace+" Type: "+objectType+" Name: "+object+" Patch: "+jsonPatch)
 	RespondWithJSON(w, http.StatusOK, updatedConfigDetails)
 }
 
@@ -258,25 +268,239 @@ func IstioConfigCreate(w http.ResponseWriter, r *http.Request) {
 		RespondWithError(w, http.StatusBadRequest, "Create request could not be read: "+err.Error())
 	}
 
+	if dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dryRun")); dryRun {
+		preview, err := business.IstioConfig.PreviewIstioConfigCreate(r.Context(), namespace, objectType, body)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		RespondWithJSON(w, http.StatusOK, preview)
+		return
+	}
+
 	createdConfigDetails, err := business.IstioConfig.CreateIstioConfigDetail(namespace, objectType, body)
 	if err != nil {
 		handleErrorResponse(w, err)
 		return
 	}
 
-	audit(r, "CREATE on Namespace: "+namespace+" Type: "+objectType+" Object: "+string(body))
 	RespondWithJSON(w, http.StatusOK, createdConfigDetails)
 }
 
-func checkObjectType(objectType string) bool {
-	return business.GetIstioAPI(objectType)
+// IstioConfigHistory is the API handler to list the revisions kept for an Istio object updated or deleted through Kiali
+func IstioConfigHistory(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	namespace := params["namespace"]
+	objectType := params["object_type"]
+	object := params["object"]
+
+	if !checkObjectType(objectType) {
+		RespondWithError(w, http.StatusBadRequest, "Object type not managed: "+objectType)
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	revisions, err := business.IstioConfig.GetIstioConfigRevisions(r.Context(), namespace, objectType, object)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, revisions)
+}
+
+// IstioConfigRevision is the API handler to get a revision of an Istio object
+func IstioConfigRevision(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	namespace := params["namespace"]
+	objectType := params["object_type"]
+	object := params["object"]
+
+	if !checkObjectType(objectType) {
+		RespondWithError(w, http.StatusBadRequest, "Object type not managed: "+objectType)
+		return
+	}
+	revision, err := strconv.Atoi(params["revision"])
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid revision: "+params["revision"])
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	istioConfigRevision, err := business.IstioConfig.GetIstioConfigRevision(r.Context(), namespace, objectType, object, revision)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, istioConfigRevision)
+}
+
+// IstioConfigRollback is the API handler to re-apply a revision of an Istio object. The rollback is rejected if it
+// would introduce validation errors, unless the force query param is set.
+func IstioConfigRollback(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	namespace := params["namespace"]
+	objectType := params["object_type"]
+	object := params["object"]
+
+	if !checkObjectType(objectType) {
+		RespondWithError(w, http.StatusBadRequest, "Object type not managed: "+objectType)
+		return
+	}
+	revision, err := strconv.Atoi(params["revision"])
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid revision: "+params["revision"])
+		return
+	}
+	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	rolledBackConfigDetails, err := business.IstioConfig.RollbackIstioConfig(r.Context(), namespace, objectType, object, revision, force)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, rolledBackConfigDetails)
+}
+
+// IstioConfigImport is the API handler to create or replace the Istio objects of a multi-document YAML bundle, or of
+// a tar archive of manifests. The objects without namespace are imported in the namespace query param.
+func IstioConfigImport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	namespace := query.Get("namespace")
+	dryRun, _ := strconv.ParseBool(query.Get("dryRun"))
+	force, _ := strconv.ParseBool(query.Get("force"))
+
+	body, ok := readBundle(w, r, "Import request")
+	if !ok {
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	result, err := business.IstioConfig.ImportIstioConfig(r.Context(), body, namespace, dryRun, force)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	if !dryRun && !result.Applied {
+		RespondWithJSON(w, http.StatusUnprocessableEntity, result)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, result)
 }
 
-func audit(r *http.Request, message string) {
-	if config.Get().Server.AuditLog {
-		user := r.Header.Get("Kiali-User")
-		log.Infof("AUDIT User [%s] Msg [%s]", user, message)
+// IstioConfigExport is the API handler to export the Istio objects of the requested namespaces, all the accessible
+// namespaces by default, as an apply-ready multi-document YAML
+func IstioConfigExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	nss := []string{}
+	if namespaces := query.Get("namespaces"); len(namespaces) > 0 {
+		nss = strings.Split(namespaces, ",")
 	}
+	objects := strings.ToLower(query.Get("objects"))
+	for _, objectType := range strings.Split(objects, ",") {
+		if objectType != "" && !checkObjectType(objectType) {
+			RespondWithError(w, http.StatusBadRequest, "Object type not managed: "+objectType)
+			return
+		}
+	}
+
+	criteria := business.ParseIstioConfigCriteria("", objects, query.Get("labelSelector"), query.Get("workloadSelector"), false)
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	bundle, err := business.IstioConfig.ExportIstioConfig(r.Context(), nss, criteria)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(bundle)
+}
+
+// IstioConfigOfflineValidations is the API handler to run all the checkers on the Services, Deployments and Istio
+// objects of a bundle of manifests, without looking up the cluster. The findings are returned as JSON, SARIF or JUnit XML.
+func IstioConfigOfflineValidations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	namespace := query.Get("namespace")
+	format := query.Get("format")
+	contentType := "application/json"
+	switch format {
+	case "", models.OfflineValidationJSON:
+	case models.OfflineValidationSARIF:
+		contentType = "application/sarif+json"
+	case models.OfflineValidationJUnit:
+		contentType = "application/xml"
+	default:
+		RespondWithError(w, http.StatusBadRequest, "Report format not supported: "+format)
+		return
+	}
+
+	body, ok := readBundle(w, r, "Validation request")
+	if !ok {
+		return
+	}
+
+	report, err := business.ValidateManifests(body, namespace)
+	if errors.IsBadRequest(err) {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	out, err := report.Render(format)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(out)
+}
+
+// readBundle reads a bundle of manifests from the request body, responding with an error if it can't be read or if it
+// exceeds the maximum bundle size
+func readBundle(w http.ResponseWriter, r *http.Request, request string) ([]byte, bool) {
+	maxSize := business.MaxBundleSize()
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSize))
+	if err != nil {
+		if int64(len(body)) >= maxSize {
+			RespondWithError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("%s exceeds the maximum size of %d bytes", request, maxSize))
+		} else {
+			RespondWithError(w, http.StatusBadRequest, request+" could not be read: "+err.Error())
+		}
+		return nil, false
+	}
+	return body, true
+}
+
+func checkObjectType(objectType string) bool {
+	return business.GetIstioAPI(objectType)
 }
 
 func IstioConfigPermissions(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+)
+
+const offlineManifest = `apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: ratings
+spec:
+  hosts:
+  - ratings
+  http:
+  - route:
+    - destination:
+        host: ratings
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: reviews
+spec:
+  ports:
+  - name: http
+    port: 9080
+`
+
+func TestIstioConfigOfflineValidations(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/istio/validations/offline", IstioConfigOfflineValidations)
+	ts := httptest.NewServer(mr)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/api/istio/validations/offline?namespace=bookinfo&format=sarif", "application/yaml", bytes.NewBufferString(offlineManifest))
+	require.NoError(err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("application/sarif+json", resp.Header.Get("Content-Type"))
+
+	var sarif map[string]interface{}
+	require.NoError(json.Unmarshal(body, &sarif))
+	assert.Equal("2.1.0", sarif["version"])
+	assert.Contains(string(body), `"ruleId": "KIA1101"`)
+
+	resp, err = http.Post(ts.URL+"/api/istio/validations/offline?format=html", "application/yaml", bytes.NewBufferString(offlineManifest))
+	require.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// objects without namespace
+	resp, err = http.Post(ts.URL+"/api/istio/validations/offline", "application/yaml", bytes.NewBufferString(offlineManifest))
+	require.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestIstioConfigOfflineValidationsTooLarge(t *testing.T) {
+	conf := config.NewConfig()
+	conf.Server.MaxBundleSize = 1
+	config.Set(conf)
+	defer config.Set(config.NewConfig())
+
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/istio/validations/offline", IstioConfigOfflineValidations)
+	ts := httptest.NewServer(mr)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/api/istio/validations/offline?namespace=bookinfo", "application/yaml", strings.NewReader(strings.Repeat("#\n", 1024*1024)))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+}
+
+func TestIstioConfigImportTooLarge(t *testing.T) {
+	conf := config.NewConfig()
+	conf.Server.MaxBundleSize = 1
+	config.Set(conf)
+	defer config.Set(config.NewConfig())
+
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/istio/import", IstioConfigImport)
+	ts := httptest.NewServer(mr)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/api/istio/import?namespace=bookinfo", "application/yaml", strings.NewReader(strings.Repeat("#\n", 1024*1024)))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+}
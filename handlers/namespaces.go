@@ -104,6 +104,5 @@ func NamespaceUpdate(w http.ResponseWriter, r *http.Request) {
 		handleErrorResponse(w, err)
 		return
 	}
-	audit(r, "UPDATE on Namespace: "+namespace+" Patch: "+jsonPatch)
 	RespondWithJSON(w, http.StatusOK, ns)
 }
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util"
+)
+
+// ServiceSLOs is the API handler to get the compliance, the remaining error budget and the burn rates of the
+// objectives of a service
+func ServiceSLOs(w http.ResponseWriter, r *http.Request) {
+	slos(w, r, func(layer *business.Layer, queryTime time.Time) (*models.SLOList, error) {
+		vars := mux.Vars(r)
+		return layer.SLO.GetServiceSLOs(r.Context(), vars["namespace"], vars["service"], queryTime)
+	})
+}
+
+// WorkloadSLOs is the API handler to get the compliance, the remaining error budget and the burn rates of the
+// objectives of a workload
+func WorkloadSLOs(w http.ResponseWriter, r *http.Request) {
+	slos(w, r, func(layer *business.Layer, queryTime time.Time) (*models.SLOList, error) {
+		vars := mux.Vars(r)
+		return layer.SLO.GetWorkloadSLOs(r.Context(), vars["namespace"], vars["workload"], queryTime)
+	})
+}
+
+func slos(w http.ResponseWriter, r *http.Request, fetch func(*business.Layer, time.Time) (*models.SLOList, error)) {
+	queryTime := util.Clock.Now()
+	if qt := r.URL.Query().Get("queryTime"); qt != "" {
+		unix, err := strconv.ParseInt(qt, 10, 64)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid queryTime: "+err.Error())
+			return
+		}
+		queryTime = time.Unix(unix, 0)
+	}
+
+	// Get business layer
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "SLOs initialization error: "+err.Error())
+		return
+	}
+
+	sloList, err := fetch(layer, queryTime)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, sloList)
+}
@@ -1,14 +1,21 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/models"
 )
 
@@ -177,10 +184,38 @@ func WorkloadUpdate(w http.ResponseWriter, r *http.Request) {
 		handleErrorResponse(w, err)
 		return
 	}
-	audit(r, "UPDATE on Namespace: "+namespace+" Workload name: "+workload+" Type: "+workloadType+" Patch: "+jsonPatch)
 	RespondWithJSON(w, http.StatusOK, workloadDetails)
 }
 
+// WorkloadAuthorizationSimulation is the API handler to evaluate a request to a workload against its AuthorizationPolicies
+func WorkloadAuthorizationSimulation(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	var request models.AuthorizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Authorization request is not valid: "+err.Error())
+		return
+	}
+	if request.Port <= 0 {
+		RespondWithError(w, http.StatusBadRequest, "Authorization request without port")
+		return
+	}
+
+	// Get business layer
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Workloads initialization error: "+err.Error())
+		return
+	}
+
+	decision, err := business.Workload.SimulateAuthorization(r.Context(), params["namespace"], params["workload"], request)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, decision)
+}
+
 // PodDetails is the API handler to fetch all details to be displayed, related to a single pod
 func PodDetails(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -242,3 +277,166 @@ func PodLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+const (
+	defaultPodLogsStreamTailLines   = 100
+	defaultPodLogsStreamIdleTimeout = 20 * time.Second
+	// podLogsStreamLifetime ends the streams before the server write timeout. Clients reconnect with the
+	// Last-Event-ID header and receive the lines logged after the last one they got.
+	podLogsStreamLifetime = 25 * time.Second
+)
+
+// PodLogsStream is the API handler to follow the logs of containers of a pod, streamed as server-sent events. Each
+// log event holds a line of one of the containers. The stream ends with an idle event when no line is logged for
+// idleTimeout, so clients can back off before reconnecting.
+func PodLogsStream(w http.ResponseWriter, r *http.Request) {
+	if config.IsFeatureDisabled(config.FeatureLogView) {
+		RespondWithError(w, http.StatusForbidden, "Pod Logs access is disabled")
+		return
+	}
+	vars := mux.Vars(r)
+	queryParams := r.URL.Query()
+	namespace := vars["namespace"]
+	pod := vars["pod"]
+
+	opts := business.FollowLogOptions{}
+	if containers := queryParams.Get("containers"); containers != "" {
+		opts.Containers = strings.Split(containers, ",")
+	}
+	tailLines := int64(defaultPodLogsStreamTailLines)
+	if tail := queryParams.Get("tailLines"); tail != "" {
+		var err error
+		if tailLines, err = strconv.ParseInt(tail, 10, 64); err != nil || tailLines < 0 {
+			RespondWithError(w, http.StatusBadRequest, "Invalid tailLines: "+tail)
+			return
+		}
+	}
+	opts.TailLines = &tailLines
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		after, err := time.Parse(time.RFC3339Nano, lastEventID)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid Last-Event-ID: "+lastEventID)
+			return
+		}
+		opts.After = &after
+	}
+	idleTimeout := defaultPodLogsStreamIdleTimeout
+	if idle := queryParams.Get("idleTimeout"); idle != "" {
+		var err error
+		if idleTimeout, err = time.ParseDuration(idle); err != nil || idleTimeout <= 0 {
+			RespondWithError(w, http.StatusBadRequest, "Invalid idleTimeout: "+idle)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		RespondWithError(w, http.StatusInternalServerError, "Streaming is not supported by the connection")
+		return
+	}
+
+	// Get business layer
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Pod Logs initialization error: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	entries, err := business.Workload.FollowPodLogs(ctx, namespace, pod, opts)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	lifetime := time.NewTimer(podLogsStreamLifetime)
+	defer lifetime.Stop()
+	idle := time.NewTimer(idleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-lifetime.C:
+			return
+		case <-idle.C:
+			if _, err := fmt.Fprint(w, "event: idle\ndata: {}\n\n"); err == nil {
+				flusher.Flush()
+			}
+			return
+		case entry, ok := <-entries:
+			if !ok {
+				// all the containers ended
+				return
+			}
+			if err := writePodLogsStreamEvent(w, entry); err != nil {
+				log.Debugf("Closing pod logs stream: %v", err)
+				return
+			}
+			flusher.Flush()
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(idleTimeout)
+		}
+	}
+}
+
+// writePodLogsStreamEvent writes the log entry with the server-sent events format
+func writePodLogsStreamEvent(w http.ResponseWriter, entry business.FollowedLogEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: log\ndata: %s\n\n", entry.Time.Format(time.RFC3339Nano), data)
+	return err
+}
+
+// WorkloadAccessLogs is the API handler to search the access logs of all the pods of a workload
+func WorkloadAccessLogs(w http.ResponseWriter, r *http.Request) {
+	accessLogs(w, r, func(b *business.Layer, criteria business.AccessLogCriteria) (*business.AccessLogs, error) {
+		vars := mux.Vars(r)
+		return b.Workload.GetWorkloadAccessLogs(r.Context(), vars["namespace"], vars["workload"], criteria)
+	})
+}
+
+// AppAccessLogs is the API handler to search the access logs of all the pods of the workloads of an app
+func AppAccessLogs(w http.ResponseWriter, r *http.Request) {
+	accessLogs(w, r, func(b *business.Layer, criteria business.AccessLogCriteria) (*business.AccessLogs, error) {
+		vars := mux.Vars(r)
+		return b.Workload.GetAppAccessLogs(r.Context(), vars["namespace"], vars["app"], criteria)
+	})
+}
+
+func accessLogs(w http.ResponseWriter, r *http.Request, search func(*business.Layer, business.AccessLogCriteria) (*business.AccessLogs, error)) {
+	if config.IsFeatureDisabled(config.FeatureLogView) {
+		RespondWithError(w, http.StatusForbidden, "Pod Logs access is disabled")
+		return
+	}
+
+	criteria, err := business.BuildAccessLogCriteria(r.URL.Query())
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Get business layer
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Access Logs initialization error: "+err.Error())
+		return
+	}
+
+	accessLogs, err := search(layer, *criteria)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, accessLogs)
+}
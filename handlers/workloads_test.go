@@ -339,3 +339,26 @@ func setupWorkloadMetricsEndpoint(t *testing.T) (*httptest.Server, *prometheuste
 
 	return ts, xapi, k8s
 }
+
+func TestWorkloadAuthorizationSimulationBadRequest(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/workloads/{workload}/authorization", WorkloadAuthorizationSimulation)
+	ts := httptest.NewServer(mr)
+	defer ts.Close()
+
+	url := ts.URL + "/api/namespaces/bookinfo/workloads/reviews-v1/authorization"
+	resp, err := http.Post(url, "application/json", strings.NewReader(`{"port": "http"}`))
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(url, "application/json", strings.NewReader(`{"source": {"namespace": "bookinfo"}, "method": "GET"}`))
+	assert.NoError(err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(string(body), "without port")
+}
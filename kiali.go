@@ -18,6 +18,8 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"regexp"
@@ -25,9 +27,11 @@ import (
 
 	_ "go.uber.org/automaxprocs"
 
+	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/business/authentication"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
 	"github.com/kiali/kiali/prometheus/internalmetrics"
 	"github.com/kiali/kiali/server"
 	"github.com/kiali/kiali/status"
@@ -56,6 +60,11 @@ func main() {
 	log.InitializeLogger()
 	util.Clock = util.RealClock{}
 
+	// validate manifests offline instead of starting the server
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(validateManifests(os.Args[2:], os.Stdout))
+	}
+
 	// process command line
 	flag.Parse()
 	validateFlags()
@@ -92,7 +101,9 @@ func main() {
 	// The complete compatible version matrix is recorded in version-compatibility-matrix.yaml
 	status.CheckVersionCompatibility()
 
-	authentication.InitializeAuthenticationController(cfg.Auth.Strategy)
+	if err := authentication.InitializeAuthenticationController(cfg.Auth.Strategy); err != nil {
+		log.Fatal(err)
+	}
 
 	// prepare our internal metrics so Prometheus can scrape them
 	internalmetrics.RegisterInternalMetrics()
@@ -164,6 +175,16 @@ func validateConfig() error {
 		return fmt.Errorf("Invalid authentication strategy [%v]", auth.Strategy)
 	}
 
+	switch auth.Session.Store {
+	case config.SessionStoreCookie, config.SessionStoreMemory:
+	case config.SessionStoreFile:
+		if auth.Session.FilePath == "" {
+			return fmt.Errorf("a file path is required when using the [%v] session store", auth.Session.Store)
+		}
+	default:
+		return fmt.Errorf("Invalid session store [%v]", auth.Session.Store)
+	}
+
 	// Check the ciphering key for sessions
 	signingKey := cfg.LoginToken.SigningKey
 	if err := config.ValidateSigningKey(signingKey, auth.Strategy); err != nil {
@@ -198,6 +219,63 @@ func validateFlags() {
 	}
 }
 
+// validateManifests runs the validate subcommand: it validates the manifests of a directory without cluster and writes
+// the report. It returns the exit code: 1 when the manifests have validation errors, 2 when they can't be validated.
+func validateManifests(args []string, stdout io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	configFile := flags.String("config", "", "Path to the YAML configuration file, for the validation settings. If not specified, the defaults are used.")
+	namespace := flags.String("namespace", "default", "Namespace of the objects without namespace.")
+	format := flags.String("format", models.OfflineValidationJSON, "Format of the report: json, sarif or junit.")
+	output := flags.String("output", "", "Path of the report file. If not specified, the report is written to the standard output.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: kiali validate [flags] <manifests directory>\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	conf := config.NewConfig()
+	if *configFile != "" {
+		c, err := config.LoadFromFile(*configFile)
+		if err != nil {
+			log.Error(err)
+			return 2
+		}
+		conf = c
+	}
+	config.Set(conf)
+
+	report, err := business.ValidateManifestsDir(flags.Arg(0), *namespace)
+	if err != nil {
+		log.Errorf("Manifests of [%s] could not be validated: %v", flags.Arg(0), err)
+		return 2
+	}
+	out, err := report.Render(*format)
+	if err != nil {
+		log.Error(err)
+		return 2
+	}
+	if *output != "" {
+		err = ioutil.WriteFile(*output, out, 0644)
+	} else {
+		_, err = stdout.Write(append(out, '\n'))
+	}
+	if err != nil {
+		log.Errorf("Report could not be written: %v", err)
+		return 2
+	}
+
+	if report.Summary.Errors > 0 {
+		return 1
+	}
+	return 0
+}
+
 // determineContainerVersion will return the version of the image container.
 // It does this by looking at an ENV defined in the Dockerfile when the image is built.
 // If the ENV is not defined, the version is assumed the same as the given default value.
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"bytes"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -88,3 +90,20 @@ func TestValidateAuthStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateManifests(t *testing.T) {
+	var out bytes.Buffer
+	if code := validateManifests([]string{"-format", "junit", "-namespace", "bookinfo", "tests/data/offline"}, &out); code != 1 {
+		t.Errorf("The manifests have validation errors, expected exit code 1 but got %d", code)
+	}
+	if !strings.Contains(out.String(), `<testcase classname="bookinfo.virtualservice" name="ratings" file="bookinfo/routing.yaml">`) {
+		t.Errorf("Unexpected report: %s", out.String())
+	}
+
+	if code := validateManifests([]string{"-format", "html", "tests/data/offline"}, &out); code != 2 {
+		t.Errorf("The report format is not supported, expected exit code 2 but got %d", code)
+	}
+	if code := validateManifests([]string{}, &out); code != 2 {
+		t.Errorf("The manifests directory is missing, expected exit code 2 but got %d", code)
+	}
+}
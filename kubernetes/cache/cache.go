@@ -6,11 +6,13 @@ import (
 	"sync"
 	"time"
 
+	"k8s.io/client-go/dynamic"
 	kube "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
 	istio "istio.io/client-go/pkg/clientset/versioned"
+	gatewayapi "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned"
 
 	kialiConfig "github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
@@ -63,6 +65,8 @@ type (
 		istioClient            kubernetes.K8SClient
 		k8sApi                 kube.Interface
 		istioApi               istio.Interface
+		gatewayApi             gatewayapi.Interface
+		dynamicApi             dynamic.Interface
 		refreshDuration        time.Duration
 		cacheNamespacesRegexps []regexp.Regexp
 		cacheIstioTypes        map[string]bool
@@ -148,6 +152,8 @@ func NewKialiCache() (KialiCache, error) {
 
 	kialiCacheImpl.k8sApi = istioClient.GetK8sApi()
 	kialiCacheImpl.istioApi = istioClient.Istio()
+	kialiCacheImpl.gatewayApi = istioClient.GatewayAPI()
+	kialiCacheImpl.dynamicApi = istioClient.Dynamic()
 
 	log.Infof("Kiali Cache is active for namespaces %v", cacheNamespaces)
 	return &kialiCacheImpl, nil
@@ -171,6 +177,7 @@ func (c *kialiCacheImpl) createCache(namespace string) bool {
 	c.registryRefreshHandler = NewRegistryHandler(c, namespace)
 	c.createKubernetesInformers(namespace, &informer)
 	c.createIstioInformers(namespace, &informer)
+	c.createGatewayInformers(namespace, &informer)
 	c.nsCache[namespace] = informer
 
 	if _, exist := c.stopChan[namespace]; !exist {
@@ -4,11 +4,15 @@ import (
 	"reflect"
 	"strings"
 
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
 	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/cache"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/log"
@@ -74,6 +78,20 @@ func (sh RegistryRefreshHandler) parseResourceVersion(oldObj, newObj interface{}
 			oldResourceVersion = oldConv.ResourceVersion
 			newResourceVersion = newConv.ResourceVersion
 		}
+	case kubernetes.TelemetryType:
+		oldConv, ok1 := oldObj.(*telemetry_v1alpha1.Telemetry)
+		newConv, ok2 := newObj.(*telemetry_v1alpha1.Telemetry)
+		if ok1 && ok2 {
+			oldResourceVersion = oldConv.ResourceVersion
+			newResourceVersion = newConv.ResourceVersion
+		}
+	case kubernetes.WasmPluginType:
+		oldConv, ok1 := oldObj.(*extensions_v1alpha1.WasmPlugin)
+		newConv, ok2 := newObj.(*extensions_v1alpha1.WasmPlugin)
+		if ok1 && ok2 {
+			oldResourceVersion = oldConv.ResourceVersion
+			newResourceVersion = newConv.ResourceVersion
+		}
 	case kubernetes.EnvoyFilterType:
 		oldConv, ok1 := oldObj.(*networking_v1alpha3.EnvoyFilter)
 		newConv, ok2 := newObj.(*networking_v1alpha3.EnvoyFilter)
@@ -88,6 +106,35 @@ func (sh RegistryRefreshHandler) parseResourceVersion(oldObj, newObj interface{}
 			oldResourceVersion = oldConv.ResourceVersion
 			newResourceVersion = newConv.ResourceVersion
 		}
+		// Istio and Gateway API Gateways share the same type name
+		oldK8sConv, ok1 := oldObj.(*k8s_networking_v1alpha2.Gateway)
+		newK8sConv, ok2 := newObj.(*k8s_networking_v1alpha2.Gateway)
+		if ok1 && ok2 {
+			oldResourceVersion = oldK8sConv.ResourceVersion
+			newResourceVersion = newK8sConv.ResourceVersion
+		}
+	case kubernetes.K8sActualHTTPRouteType:
+		oldConv, ok1 := oldObj.(*k8s_networking_v1alpha2.HTTPRoute)
+		newConv, ok2 := newObj.(*k8s_networking_v1alpha2.HTTPRoute)
+		if ok1 && ok2 {
+			oldResourceVersion = oldConv.ResourceVersion
+			newResourceVersion = newConv.ResourceVersion
+		}
+	case kubernetes.K8sActualTCPRouteType:
+		oldConv, ok1 := oldObj.(*k8s_networking_v1alpha2.TCPRoute)
+		newConv, ok2 := newObj.(*k8s_networking_v1alpha2.TCPRoute)
+		if ok1 && ok2 {
+			oldResourceVersion = oldConv.ResourceVersion
+			newResourceVersion = newConv.ResourceVersion
+		}
+	case "Unstructured":
+		// Resources without typed client (i.e. Gateway API GRPCRoutes)
+		oldConv, ok1 := oldObj.(*unstructured.Unstructured)
+		newConv, ok2 := newObj.(*unstructured.Unstructured)
+		if ok1 && ok2 {
+			oldResourceVersion = oldConv.GetResourceVersion()
+			newResourceVersion = newConv.GetResourceVersion()
+		}
 	case kubernetes.ServiceEntryType:
 		oldConv, ok1 := oldObj.(*networking_v1beta1.ServiceEntry)
 		newConv, ok2 := newObj.(*networking_v1beta1.ServiceEntry)
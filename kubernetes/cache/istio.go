@@ -4,11 +4,17 @@ import (
 	"errors"
 	"fmt"
 
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
 	istio "istio.io/client-go/pkg/informers/externalversions"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gateway "sigs.k8s.io/gateway-api/pkg/client/informers/gateway/externalversions"
 
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/log"
@@ -35,12 +41,27 @@ type (
 		GetWorkloadGroup(namespace, name string) (*networking_v1beta1.WorkloadGroup, error)
 		GetWorkloadGroups(namespace, labelSelector string) ([]networking_v1beta1.WorkloadGroup, error)
 
+		GetK8sGateway(namespace, name string) (*k8s_networking_v1alpha2.Gateway, error)
+		GetK8sGateways(namespace, labelSelector string) ([]k8s_networking_v1alpha2.Gateway, error)
+		GetK8sHTTPRoute(namespace, name string) (*k8s_networking_v1alpha2.HTTPRoute, error)
+		GetK8sHTTPRoutes(namespace, labelSelector string) ([]k8s_networking_v1alpha2.HTTPRoute, error)
+		GetK8sTCPRoute(namespace, name string) (*k8s_networking_v1alpha2.TCPRoute, error)
+		GetK8sTCPRoutes(namespace, labelSelector string) ([]k8s_networking_v1alpha2.TCPRoute, error)
+		GetK8sGRPCRoute(namespace, name string) (*unstructured.Unstructured, error)
+		GetK8sGRPCRoutes(namespace, labelSelector string) ([]unstructured.Unstructured, error)
+
 		GetAuthorizationPolicy(namespace, name string) (*security_v1beta1.AuthorizationPolicy, error)
 		GetAuthorizationPolicies(namespace, labelSelector string) ([]security_v1beta1.AuthorizationPolicy, error)
 		GetPeerAuthentication(namespace, name string) (*security_v1beta1.PeerAuthentication, error)
 		GetPeerAuthentications(namespace, labelSelector string) ([]security_v1beta1.PeerAuthentication, error)
 		GetRequestAuthentication(namespace, name string) (*security_v1beta1.RequestAuthentication, error)
 		GetRequestAuthentications(namespace, labelSelector string) ([]security_v1beta1.RequestAuthentication, error)
+
+		GetTelemetry(namespace, name string) (*telemetry_v1alpha1.Telemetry, error)
+		GetTelemetries(namespace, labelSelector string) ([]telemetry_v1alpha1.Telemetry, error)
+
+		GetWasmPlugin(namespace, name string) (*extensions_v1alpha1.WasmPlugin, error)
+		GetWasmPlugins(namespace, labelSelector string) ([]extensions_v1alpha1.WasmPlugin, error)
 	}
 )
 
@@ -97,6 +118,49 @@ func (c *kialiCacheImpl) createIstioInformers(namespace string, informer *typeCa
 		(*informer)[kubernetes.RequestAuthenticationsType] = sharedInformers.Security().V1beta1().RequestAuthentications().Informer()
 		(*informer)[kubernetes.RequestAuthenticationsType].AddEventHandler(c.registryRefreshHandler)
 	}
+
+	if c.CheckIstioResource(kubernetes.Telemetries) {
+		(*informer)[kubernetes.TelemetryType] = sharedInformers.Telemetry().V1alpha1().Telemetries().Informer()
+		(*informer)[kubernetes.TelemetryType].AddEventHandler(c.registryRefreshHandler)
+	}
+
+	if c.CheckIstioResource(kubernetes.WasmPlugins) {
+		(*informer)[kubernetes.WasmPluginType] = sharedInformers.Extensions().V1alpha1().WasmPlugins().Informer()
+		(*informer)[kubernetes.WasmPluginType].AddEventHandler(c.registryRefreshHandler)
+	}
+}
+
+// isK8sGatewayAPICached checks that a Gateway API resource is configured to be cached and that the CRDs exist in the cluster,
+// otherwise the informers would never sync
+func (c *kialiCacheImpl) isK8sGatewayAPICached(resourceType string) bool {
+	if resourceType == kubernetes.K8sGRPCRoutes && !c.istioClient.IsGRPCRouteAPI() {
+		return false
+	}
+	if resourceType == kubernetes.K8sTCPRoutes && !c.istioClient.IsTCPRouteAPI() {
+		return false
+	}
+	return c.CheckIstioResource(resourceType) && c.istioClient.IsGatewayAPI()
+}
+
+func (c *kialiCacheImpl) createGatewayInformers(namespace string, informer *typeCache) {
+	sharedInformers := gateway.NewSharedInformerFactoryWithOptions(c.gatewayApi, c.refreshDuration, gateway.WithNamespace(namespace))
+	if c.isK8sGatewayAPICached(kubernetes.K8sGateways) {
+		(*informer)[kubernetes.K8sGatewayType] = sharedInformers.Gateway().V1alpha2().Gateways().Informer()
+		(*informer)[kubernetes.K8sGatewayType].AddEventHandler(c.registryRefreshHandler)
+	}
+	if c.isK8sGatewayAPICached(kubernetes.K8sHTTPRoutes) {
+		(*informer)[kubernetes.K8sHTTPRouteType] = sharedInformers.Gateway().V1alpha2().HTTPRoutes().Informer()
+		(*informer)[kubernetes.K8sHTTPRouteType].AddEventHandler(c.registryRefreshHandler)
+	}
+	if c.isK8sGatewayAPICached(kubernetes.K8sTCPRoutes) {
+		(*informer)[kubernetes.K8sTCPRouteType] = sharedInformers.Gateway().V1alpha2().TCPRoutes().Informer()
+		(*informer)[kubernetes.K8sTCPRouteType].AddEventHandler(c.registryRefreshHandler)
+	}
+	if c.isK8sGatewayAPICached(kubernetes.K8sGRPCRoutes) {
+		dynamicInformers := dynamicinformer.NewFilteredDynamicSharedInformerFactory(c.dynamicApi, c.refreshDuration, namespace, nil)
+		(*informer)[kubernetes.K8sGRPCRouteType] = dynamicInformers.ForResource(kubernetes.K8sGRPCRouteGroupVersionResource).Informer()
+		(*informer)[kubernetes.K8sGRPCRouteType].AddEventHandler(c.registryRefreshHandler)
+	}
 }
 
 func (c *kialiCacheImpl) isIstioSynced(namespace string) bool {
@@ -137,6 +201,14 @@ func (c *kialiCacheImpl) isIstioSynced(namespace string) bool {
 		if c.CheckIstioResource(kubernetes.RequestAuthentications) {
 			isSynced = isSynced && nsCache[kubernetes.RequestAuthenticationsType].HasSynced()
 		}
+
+		if c.CheckIstioResource(kubernetes.Telemetries) {
+			isSynced = isSynced && nsCache[kubernetes.TelemetryType].HasSynced()
+		}
+
+		if c.CheckIstioResource(kubernetes.WasmPlugins) {
+			isSynced = isSynced && nsCache[kubernetes.WasmPluginType].HasSynced()
+		}
 	} else {
 		isSynced = false
 	}
@@ -633,6 +705,250 @@ func (c *kialiCacheImpl) GetWorkloadGroups(namespace, labelSelector string) ([]n
 	return []networking_v1beta1.WorkloadGroup{}, nil
 }
 
+func (c *kialiCacheImpl) GetK8sGateway(namespace, name string) (*k8s_networking_v1alpha2.Gateway, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sGateways) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sGatewayType)
+	}
+	if nsCache, ok := c.nsCache[namespace]; ok {
+		// Cache stores natively items with namespace/name pattern, we can skip the Indexer by name and make a direct call
+		key := namespace + "/" + name
+		obj, exist, err := nsCache[kubernetes.K8sGatewayType].GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			l, ok := obj.(*k8s_networking_v1alpha2.Gateway)
+			if !ok {
+				return nil, errors.New("bad K8sGateway type found in cache")
+			}
+			l.Kind = kubernetes.K8sActualGatewayType
+			log.Tracef("[Kiali Cache] Get [resource: K8sGateway] for [namespace: %s] [name: %s]", namespace, name)
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kialiCacheImpl) GetK8sGateways(namespace, labelSelector string) ([]k8s_networking_v1alpha2.Gateway, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sGateways) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sGatewayType)
+	}
+	if nsCache, nsOk := c.nsCache[namespace]; nsOk {
+		l := nsCache[kubernetes.K8sGatewayType].GetStore().List()
+		lenL := len(l)
+		if lenL > 0 {
+			_, ok := l[0].(*k8s_networking_v1alpha2.Gateway)
+			if !ok {
+				return []k8s_networking_v1alpha2.Gateway{}, errors.New("bad K8sGateway type found in cache")
+			}
+			nsL := make([]k8s_networking_v1alpha2.Gateway, lenL)
+			for i, li := range l {
+				nsL[i] = *(li.(*k8s_networking_v1alpha2.Gateway))
+				nsL[i].Kind = kubernetes.K8sActualGatewayType
+			}
+			log.Tracef("[Kiali Cache] Get [resource: K8sGateway] for [namespace: %s] = %d", namespace, lenL)
+			if labelSelector == "" {
+				return nsL, nil
+			}
+			var filteredL []k8s_networking_v1alpha2.Gateway
+			selector, selErr := labels.Parse(labelSelector)
+			if selErr != nil {
+				return []k8s_networking_v1alpha2.Gateway{}, fmt.Errorf("%s can not be processed as selector: %v", labelSelector, selErr)
+			}
+			for _, li := range nsL {
+				if selector.Matches(labels.Set(li.Labels)) {
+					filteredL = append(filteredL, li)
+				}
+			}
+			return filteredL, nil
+		}
+	}
+	return []k8s_networking_v1alpha2.Gateway{}, nil
+}
+
+func (c *kialiCacheImpl) GetK8sHTTPRoute(namespace, name string) (*k8s_networking_v1alpha2.HTTPRoute, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sHTTPRoutes) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sHTTPRouteType)
+	}
+	if nsCache, ok := c.nsCache[namespace]; ok {
+		// Cache stores natively items with namespace/name pattern, we can skip the Indexer by name and make a direct call
+		key := namespace + "/" + name
+		obj, exist, err := nsCache[kubernetes.K8sHTTPRouteType].GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			l, ok := obj.(*k8s_networking_v1alpha2.HTTPRoute)
+			if !ok {
+				return nil, errors.New("bad K8sHTTPRoute type found in cache")
+			}
+			l.Kind = kubernetes.K8sActualHTTPRouteType
+			log.Tracef("[Kiali Cache] Get [resource: K8sHTTPRoute] for [namespace: %s] [name: %s]", namespace, name)
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kialiCacheImpl) GetK8sHTTPRoutes(namespace, labelSelector string) ([]k8s_networking_v1alpha2.HTTPRoute, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sHTTPRoutes) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sHTTPRouteType)
+	}
+	if nsCache, nsOk := c.nsCache[namespace]; nsOk {
+		l := nsCache[kubernetes.K8sHTTPRouteType].GetStore().List()
+		lenL := len(l)
+		if lenL > 0 {
+			_, ok := l[0].(*k8s_networking_v1alpha2.HTTPRoute)
+			if !ok {
+				return []k8s_networking_v1alpha2.HTTPRoute{}, errors.New("bad K8sHTTPRoute type found in cache")
+			}
+			nsL := make([]k8s_networking_v1alpha2.HTTPRoute, lenL)
+			for i, li := range l {
+				nsL[i] = *(li.(*k8s_networking_v1alpha2.HTTPRoute))
+				nsL[i].Kind = kubernetes.K8sActualHTTPRouteType
+			}
+			log.Tracef("[Kiali Cache] Get [resource: K8sHTTPRoute] for [namespace: %s] = %d", namespace, lenL)
+			if labelSelector == "" {
+				return nsL, nil
+			}
+			var filteredL []k8s_networking_v1alpha2.HTTPRoute
+			selector, selErr := labels.Parse(labelSelector)
+			if selErr != nil {
+				return []k8s_networking_v1alpha2.HTTPRoute{}, fmt.Errorf("%s can not be processed as selector: %v", labelSelector, selErr)
+			}
+			for _, li := range nsL {
+				if selector.Matches(labels.Set(li.Labels)) {
+					filteredL = append(filteredL, li)
+				}
+			}
+			return filteredL, nil
+		}
+	}
+	return []k8s_networking_v1alpha2.HTTPRoute{}, nil
+}
+
+func (c *kialiCacheImpl) GetK8sTCPRoute(namespace, name string) (*k8s_networking_v1alpha2.TCPRoute, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sTCPRoutes) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sTCPRouteType)
+	}
+	if nsCache, ok := c.nsCache[namespace]; ok {
+		// Cache stores natively items with namespace/name pattern, we can skip the Indexer by name and make a direct call
+		key := namespace + "/" + name
+		obj, exist, err := nsCache[kubernetes.K8sTCPRouteType].GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			l, ok := obj.(*k8s_networking_v1alpha2.TCPRoute)
+			if !ok {
+				return nil, errors.New("bad K8sTCPRoute type found in cache")
+			}
+			l.Kind = kubernetes.K8sActualTCPRouteType
+			log.Tracef("[Kiali Cache] Get [resource: K8sTCPRoute] for [namespace: %s] [name: %s]", namespace, name)
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kialiCacheImpl) GetK8sTCPRoutes(namespace, labelSelector string) ([]k8s_networking_v1alpha2.TCPRoute, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sTCPRoutes) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sTCPRouteType)
+	}
+	if nsCache, nsOk := c.nsCache[namespace]; nsOk {
+		l := nsCache[kubernetes.K8sTCPRouteType].GetStore().List()
+		lenL := len(l)
+		if lenL > 0 {
+			_, ok := l[0].(*k8s_networking_v1alpha2.TCPRoute)
+			if !ok {
+				return []k8s_networking_v1alpha2.TCPRoute{}, errors.New("bad K8sTCPRoute type found in cache")
+			}
+			nsL := make([]k8s_networking_v1alpha2.TCPRoute, lenL)
+			for i, li := range l {
+				nsL[i] = *(li.(*k8s_networking_v1alpha2.TCPRoute))
+				nsL[i].Kind = kubernetes.K8sActualTCPRouteType
+			}
+			log.Tracef("[Kiali Cache] Get [resource: K8sTCPRoute] for [namespace: %s] = %d", namespace, lenL)
+			if labelSelector == "" {
+				return nsL, nil
+			}
+			var filteredL []k8s_networking_v1alpha2.TCPRoute
+			selector, selErr := labels.Parse(labelSelector)
+			if selErr != nil {
+				return []k8s_networking_v1alpha2.TCPRoute{}, fmt.Errorf("%s can not be processed as selector: %v", labelSelector, selErr)
+			}
+			for _, li := range nsL {
+				if selector.Matches(labels.Set(li.Labels)) {
+					filteredL = append(filteredL, li)
+				}
+			}
+			return filteredL, nil
+		}
+	}
+	return []k8s_networking_v1alpha2.TCPRoute{}, nil
+}
+
+func (c *kialiCacheImpl) GetK8sGRPCRoute(namespace, name string) (*unstructured.Unstructured, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sGRPCRoutes) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sGRPCRouteType)
+	}
+	if nsCache, ok := c.nsCache[namespace]; ok {
+		// Cache stores natively items with namespace/name pattern, we can skip the Indexer by name and make a direct call
+		key := namespace + "/" + name
+		obj, exist, err := nsCache[kubernetes.K8sGRPCRouteType].GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			l, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return nil, errors.New("bad K8sGRPCRoute type found in cache")
+			}
+			l.SetKind(kubernetes.K8sActualGRPCRouteType)
+			log.Tracef("[Kiali Cache] Get [resource: K8sGRPCRoute] for [namespace: %s] [name: %s]", namespace, name)
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kialiCacheImpl) GetK8sGRPCRoutes(namespace, labelSelector string) ([]unstructured.Unstructured, error) {
+	if !c.isK8sGatewayAPICached(kubernetes.K8sGRPCRoutes) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.K8sGRPCRouteType)
+	}
+	if nsCache, nsOk := c.nsCache[namespace]; nsOk {
+		l := nsCache[kubernetes.K8sGRPCRouteType].GetStore().List()
+		lenL := len(l)
+		if lenL > 0 {
+			_, ok := l[0].(*unstructured.Unstructured)
+			if !ok {
+				return []unstructured.Unstructured{}, errors.New("bad K8sGRPCRoute type found in cache")
+			}
+			nsL := make([]unstructured.Unstructured, lenL)
+			for i, li := range l {
+				nsL[i] = *(li.(*unstructured.Unstructured))
+				nsL[i].SetKind(kubernetes.K8sActualGRPCRouteType)
+			}
+			log.Tracef("[Kiali Cache] Get [resource: K8sGRPCRoute] for [namespace: %s] = %d", namespace, lenL)
+			if labelSelector == "" {
+				return nsL, nil
+			}
+			var filteredL []unstructured.Unstructured
+			selector, selErr := labels.Parse(labelSelector)
+			if selErr != nil {
+				return []unstructured.Unstructured{}, fmt.Errorf("%s can not be processed as selector: %v", labelSelector, selErr)
+			}
+			for _, li := range nsL {
+				if selector.Matches(labels.Set(li.GetLabels())) {
+					filteredL = append(filteredL, li)
+				}
+			}
+			return filteredL, nil
+		}
+	}
+	return []unstructured.Unstructured{}, nil
+}
+
 func (c *kialiCacheImpl) GetAuthorizationPolicy(namespace, name string) (*security_v1beta1.AuthorizationPolicy, error) {
 	if !c.CheckIstioResource(kubernetes.AuthorizationPolicies) {
 		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.AuthorizationPoliciesType)
@@ -815,3 +1131,125 @@ func (c *kialiCacheImpl) GetRequestAuthentications(namespace, labelSelector stri
 	}
 	return []security_v1beta1.RequestAuthentication{}, nil
 }
+
+func (c *kialiCacheImpl) GetTelemetry(namespace, name string) (*telemetry_v1alpha1.Telemetry, error) {
+	if !c.CheckIstioResource(kubernetes.Telemetries) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.TelemetryType)
+	}
+	if nsCache, ok := c.nsCache[namespace]; ok {
+		// Cache stores natively items with namespace/name pattern, we can skip the Indexer by name and make a direct call
+		key := namespace + "/" + name
+		obj, exist, err := nsCache[kubernetes.TelemetryType].GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			l, ok := obj.(*telemetry_v1alpha1.Telemetry)
+			if !ok {
+				return nil, errors.New("bad Telemetry type found in cache")
+			}
+			l.Kind = kubernetes.TelemetryType
+			log.Tracef("[Kiali Cache] Get [resource: Telemetry] for [namespace: %s] [name: %s]", namespace, name)
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kialiCacheImpl) GetTelemetries(namespace, labelSelector string) ([]telemetry_v1alpha1.Telemetry, error) {
+	if !c.CheckIstioResource(kubernetes.Telemetries) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.TelemetryType)
+	}
+	if nsCache, nsOk := c.nsCache[namespace]; nsOk {
+		l := nsCache[kubernetes.TelemetryType].GetStore().List()
+		lenL := len(l)
+		if lenL > 0 {
+			_, ok := l[0].(*telemetry_v1alpha1.Telemetry)
+			if !ok {
+				return []telemetry_v1alpha1.Telemetry{}, errors.New("bad Telemetry type found in cache")
+			}
+			nsL := make([]telemetry_v1alpha1.Telemetry, lenL)
+			for i, li := range l {
+				nsL[i] = *(li.(*telemetry_v1alpha1.Telemetry))
+				nsL[i].Kind = kubernetes.TelemetryType
+			}
+			log.Tracef("[Kiali Cache] Get [resource: Telemetry] for [namespace: %s] = %d", namespace, lenL)
+			if labelSelector == "" {
+				return nsL, nil
+			}
+			var filteredL []telemetry_v1alpha1.Telemetry
+			selector, selErr := labels.Parse(labelSelector)
+			if selErr != nil {
+				return []telemetry_v1alpha1.Telemetry{}, fmt.Errorf("%s can not be processed as selector: %v", labelSelector, selErr)
+			}
+			for _, li := range nsL {
+				if selector.Matches(labels.Set(li.Labels)) {
+					filteredL = append(filteredL, li)
+				}
+			}
+			return filteredL, nil
+		}
+	}
+	return []telemetry_v1alpha1.Telemetry{}, nil
+}
+
+func (c *kialiCacheImpl) GetWasmPlugin(namespace, name string) (*extensions_v1alpha1.WasmPlugin, error) {
+	if !c.CheckIstioResource(kubernetes.WasmPlugins) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.WasmPluginType)
+	}
+	if nsCache, ok := c.nsCache[namespace]; ok {
+		// Cache stores natively items with namespace/name pattern, we can skip the Indexer by name and make a direct call
+		key := namespace + "/" + name
+		obj, exist, err := nsCache[kubernetes.WasmPluginType].GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			l, ok := obj.(*extensions_v1alpha1.WasmPlugin)
+			if !ok {
+				return nil, errors.New("bad WasmPlugin type found in cache")
+			}
+			l.Kind = kubernetes.WasmPluginType
+			log.Tracef("[Kiali Cache] Get [resource: WasmPlugin] for [namespace: %s] [name: %s]", namespace, name)
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *kialiCacheImpl) GetWasmPlugins(namespace, labelSelector string) ([]extensions_v1alpha1.WasmPlugin, error) {
+	if !c.CheckIstioResource(kubernetes.WasmPlugins) {
+		return nil, fmt.Errorf("Kiali cache doesn't support [resourceType: %s]", kubernetes.WasmPluginType)
+	}
+	if nsCache, nsOk := c.nsCache[namespace]; nsOk {
+		l := nsCache[kubernetes.WasmPluginType].GetStore().List()
+		lenL := len(l)
+		if lenL > 0 {
+			_, ok := l[0].(*extensions_v1alpha1.WasmPlugin)
+			if !ok {
+				return []extensions_v1alpha1.WasmPlugin{}, errors.New("bad WasmPlugin type found in cache")
+			}
+			nsL := make([]extensions_v1alpha1.WasmPlugin, lenL)
+			for i, li := range l {
+				nsL[i] = *(li.(*extensions_v1alpha1.WasmPlugin))
+				nsL[i].Kind = kubernetes.WasmPluginType
+			}
+			log.Tracef("[Kiali Cache] Get [resource: WasmPlugin] for [namespace: %s] = %d", namespace, lenL)
+			if labelSelector == "" {
+				return nsL, nil
+			}
+			var filteredL []extensions_v1alpha1.WasmPlugin
+			selector, selErr := labels.Parse(labelSelector)
+			if selErr != nil {
+				return []extensions_v1alpha1.WasmPlugin{}, fmt.Errorf("%s can not be processed as selector: %v", labelSelector, selErr)
+			}
+			for _, li := range nsL {
+				if selector.Matches(labels.Set(li.Labels)) {
+					filteredL = append(filteredL, li)
+				}
+			}
+			return filteredL, nil
+		}
+	}
+	return []extensions_v1alpha1.WasmPlugin{}, nil
+}
@@ -12,9 +12,11 @@ import (
 	istio "istio.io/client-go/pkg/clientset/versioned"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/dynamic"
 	kube "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd/api"
+	gatewayapiclient "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned"
 
 	kialiConfig "github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/log"
@@ -49,12 +51,25 @@ type K8SClient struct {
 	token          string
 	k8s            *kube.Clientset
 	istioClientset *istio.Clientset
+	// Client for the Kubernetes Gateway API resources (gateway.networking.k8s.io)
+	k8sGatewayAPIClientset *gatewayapiclient.Clientset
+	// Client for the resources without typed client (i.e. Gateway API GRPCRoutes)
+	dynamic dynamic.Interface
 	// Used in REST queries after bump to client-go v0.20.x
 	ctx context.Context
 	// isOpenShift private variable will check if kiali is deployed under an OpenShift cluster or not
 	// It is represented as a pointer to include the initialization phase.
 	// See kubernetes_service.go#IsOpenShift() for more details.
 	isOpenShift *bool
+	// isGatewayAPI private variable will check if the Gateway API CRDs are installed in the cluster.
+	// See istio.go#IsGatewayAPI() for more details.
+	isGatewayAPI *bool
+	// isGRPCRouteAPI private variable will check if the Gateway API GRPCRoute CRD is installed in the cluster.
+	// See istio.go#IsGRPCRouteAPI() for more details.
+	isGRPCRouteAPI *bool
+	// isTCPRouteAPI private variable will check if the Gateway API TCPRoute CRD is installed in the cluster.
+	// See istio.go#IsTCPRouteAPI() for more details.
+	isTCPRouteAPI *bool
 }
 
 // GetK8sApi returns the clientset referencing all K8s rest clients
@@ -157,6 +172,16 @@ func NewClientFromConfig(config *rest.Config) (*K8SClient, error) {
 		return nil, err
 	}
 
+	client.k8sGatewayAPIClientset, err = gatewayapiclient.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client.dynamic, err = dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	client.ctx = context.Background()
 	return &client, nil
 }
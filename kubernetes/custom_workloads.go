@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	"strings"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+)
+
+// GetCustomWorkloadType returns the custom workload type registered for a kind
+func GetCustomWorkloadType(kind string) (config.CustomWorkloadType, bool) {
+	for _, wt := range config.Get().KubernetesConfig.CustomWorkloadTypes {
+		if wt.Kind == kind {
+			return wt, true
+		}
+	}
+	return config.CustomWorkloadType{}, false
+}
+
+// GetCustomWorkload returns a custom controller of a registered custom workload type.
+// It returns an error on any problem.
+func (in *K8SClient) GetCustomWorkload(namespace string, name string, workloadType config.CustomWorkloadType) (*unstructured.Unstructured, error) {
+	return in.dynamic.Resource(customWorkloadResource(workloadType)).Namespace(namespace).Get(in.ctx, name, meta_v1.GetOptions{})
+}
+
+// GetCustomWorkloads returns the custom controllers of a registered custom workload type for a given namespace.
+// It returns an error on any problem, a NotFound error when the custom resource is not installed
+// and a Forbidden error when the user cannot list it.
+func (in *K8SClient) GetCustomWorkloads(namespace string, workloadType config.CustomWorkloadType) ([]unstructured.Unstructured, error) {
+	result, err := in.dynamic.Resource(customWorkloadResource(workloadType)).Namespace(namespace).List(in.ctx, meta_v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+func customWorkloadResource(workloadType config.CustomWorkloadType) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: workloadType.Group, Version: workloadType.Version, Resource: workloadType.Resource}
+}
+
+// GetCustomWorkloadTemplate returns the metadata of the pod template of a custom controller, or the metadata of the
+// controller itself when the type has no template path
+func GetCustomWorkloadTemplate(cw *unstructured.Unstructured, workloadType config.CustomWorkloadType) meta_v1.ObjectMeta {
+	if workloadType.TemplatePath == "" {
+		return meta_v1.ObjectMeta{Name: cw.GetName(), Labels: cw.GetLabels(), Annotations: cw.GetAnnotations()}
+	}
+	path := append(strings.Split(workloadType.TemplatePath, "."), "metadata")
+	tplLabels, _, _ := unstructured.NestedStringMap(cw.Object, append(path, "labels")...)
+	tplAnnotations, _, _ := unstructured.NestedStringMap(cw.Object, append(path, "annotations")...)
+	return meta_v1.ObjectMeta{Labels: tplLabels, Annotations: tplAnnotations}
+}
+
+// GetCustomWorkloadReplicas returns the replicas count found in a dot separated path of a custom controller,
+// 0 when it is not set
+func GetCustomWorkloadReplicas(cw *unstructured.Unstructured, path string) int32 {
+	if path == "" {
+		return 0
+	}
+	replicas, _, _ := unstructured.NestedInt64(cw.Object, strings.Split(path, ".")...)
+	return int32(replicas)
+}
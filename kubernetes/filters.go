@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -131,6 +133,46 @@ func FilterEnvoyFiltersBySelector(workloadSelector string, envoyfilters []networ
 	return filtered
 }
 
+func FilterTelemetriesBySelector(workloadSelector string, telemetries []telemetry_v1alpha1.Telemetry) []telemetry_v1alpha1.Telemetry {
+	filtered := []telemetry_v1alpha1.Telemetry{}
+	workloadLabels := mapWorkloadSelector(workloadSelector)
+	for _, tm := range telemetries {
+		wkLabelsS := []string{}
+		if tm.Spec.Selector != nil {
+			tmSelector := tm.Spec.Selector.MatchLabels
+			for k, v := range tmSelector {
+				wkLabelsS = append(wkLabelsS, k+"="+v)
+			}
+		}
+		if resourceSelector, err := labels.Parse(strings.Join(wkLabelsS, ",")); err == nil {
+			if resourceSelector.Matches(labels.Set(workloadLabels)) {
+				filtered = append(filtered, tm)
+			}
+		}
+	}
+	return filtered
+}
+
+func FilterWasmPluginsBySelector(workloadSelector string, wasmplugins []extensions_v1alpha1.WasmPlugin) []extensions_v1alpha1.WasmPlugin {
+	filtered := []extensions_v1alpha1.WasmPlugin{}
+	workloadLabels := mapWorkloadSelector(workloadSelector)
+	for _, wp := range wasmplugins {
+		wkLabelsS := []string{}
+		if wp.Spec.Selector != nil {
+			wpSelector := wp.Spec.Selector.MatchLabels
+			for k, v := range wpSelector {
+				wkLabelsS = append(wkLabelsS, k+"="+v)
+			}
+		}
+		if resourceSelector, err := labels.Parse(strings.Join(wkLabelsS, ",")); err == nil {
+			if resourceSelector.Matches(labels.Set(workloadLabels)) {
+				filtered = append(filtered, wp)
+			}
+		}
+	}
+	return filtered
+}
+
 func FilterGatewaysBySelector(workloadSelector string, gateways []networking_v1beta1.Gateway) []networking_v1beta1.Gateway {
 	filtered := []networking_v1beta1.Gateway{}
 	workloadLabels := mapWorkloadSelector(workloadSelector)
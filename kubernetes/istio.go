@@ -12,12 +12,18 @@ import (
 
 	"gopkg.in/yaml.v2"
 	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
 	istio "istio.io/client-go/pkg/clientset/versioned"
 	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayapiclient "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/log"
@@ -35,6 +41,10 @@ var (
 
 type IstioClientInterface interface {
 	Istio() istio.Interface
+	// GatewayAPI returns the client for the Kubernetes Gateway API (gateway.networking.k8s.io) resources
+	GatewayAPI() gatewayapiclient.Interface
+	// Dynamic returns the client for the resources without typed client (i.e. Gateway API GRPCRoutes)
+	Dynamic() dynamic.Interface
 
 	GetProxyStatus() ([]*ProxyStatus, error)
 	GetConfigDump(namespace, podName string) (*ConfigDump, error)
@@ -42,12 +52,71 @@ type IstioClientInterface interface {
 	GetRegistryConfiguration() (*RegistryConfiguration, error)
 	GetRegistryEndpoints() ([]*RegistryEndpoint, error)
 	GetRegistryServices() ([]*RegistryService, error)
+	IsGatewayAPI() bool
+	IsGRPCRouteAPI() bool
+	IsTCPRouteAPI() bool
 }
 
 func (in *K8SClient) Istio() istio.Interface {
 	return in.istioClientset
 }
 
+func (in *K8SClient) GatewayAPI() gatewayapiclient.Interface {
+	return in.k8sGatewayAPIClientset
+}
+
+func (in *K8SClient) Dynamic() dynamic.Interface {
+	return in.dynamic
+}
+
+// IsGatewayAPI checks whether the Kubernetes Gateway API CRDs are installed in the cluster.
+// The result is memoized as the CRDs are not expected to come and go during the Kiali lifetime.
+func (in *K8SClient) IsGatewayAPI() bool {
+	if in.isGatewayAPI == nil {
+		isGatewayAPI := false
+		_, err := in.k8s.Discovery().ServerResourcesForGroupVersion(K8sNetworkingGroupVersionV1Alpha2.String())
+		if err == nil {
+			isGatewayAPI = true
+		}
+		in.isGatewayAPI = &isGatewayAPI
+	}
+	return *in.isGatewayAPI
+}
+
+// IsGRPCRouteAPI checks whether the GRPCRoute CRD of the Gateway API experimental channel is installed in the cluster.
+// The result is memoized like IsGatewayAPI.
+func (in *K8SClient) IsGRPCRouteAPI() bool {
+	if in.isGRPCRouteAPI == nil {
+		isGRPCRouteAPI := in.hasGatewayAPIResource(K8sGRPCRouteGroupVersionResource.Resource)
+		in.isGRPCRouteAPI = &isGRPCRouteAPI
+	}
+	return *in.isGRPCRouteAPI
+}
+
+// IsTCPRouteAPI checks whether the TCPRoute CRD of the Gateway API experimental channel is installed in the cluster.
+// The standard channel serves the v1alpha2 Gateways and HTTPRoutes without it. The result is memoized like IsGatewayAPI.
+func (in *K8SClient) IsTCPRouteAPI() bool {
+	if in.isTCPRouteAPI == nil {
+		isTCPRouteAPI := in.hasGatewayAPIResource("tcproutes")
+		in.isTCPRouteAPI = &isTCPRouteAPI
+	}
+	return *in.isTCPRouteAPI
+}
+
+// hasGatewayAPIResource checks whether the v1alpha2 Gateway API group version serves a resource
+func (in *K8SClient) hasGatewayAPIResource(resource string) bool {
+	resources, err := in.k8s.Discovery().ServerResourcesForGroupVersion(K8sNetworkingGroupVersionV1Alpha2.String())
+	if err != nil {
+		return false
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == resource {
+			return true
+		}
+	}
+	return false
+}
+
 func (in *K8SClient) getIstiodDebugStatus(debugPath string) (map[string][]byte, error) {
 	c := config.Get()
 	istiods, err := in.GetPods(c.IstioNamespace, labels.Set(map[string]string{
@@ -253,9 +322,18 @@ func ParseRegistryConfig(config map[string][]byte) (*RegistryConfiguration, erro
 		WorkloadEntries:  []networking_v1beta1.WorkloadEntry{},
 		WorkloadGroups:   []networking_v1beta1.WorkloadGroup{},
 
+		K8sGateways:   []k8s_networking_v1alpha2.Gateway{},
+		K8sHTTPRoutes: []k8s_networking_v1alpha2.HTTPRoute{},
+		K8sTCPRoutes:  []k8s_networking_v1alpha2.TCPRoute{},
+		K8sGRPCRoutes: []unstructured.Unstructured{},
+
 		AuthorizationPolicies:  []security_v1beta1.AuthorizationPolicy{},
 		PeerAuthentications:    []security_v1beta1.PeerAuthentication{},
 		RequestAuthentications: []security_v1beta1.RequestAuthentication{},
+
+		Telemetries: []telemetry_v1alpha1.Telemetry{},
+
+		WasmPlugins: []extensions_v1alpha1.WasmPlugin{},
 	}
 	isRegistryLoaded := false
 	for istiod, bRegistry := range config {
@@ -275,8 +353,16 @@ func ParseRegistryConfig(config map[string][]byte) (*RegistryConfiguration, erro
 			for _, iItem := range ajRegistry {
 				if mItem, ok := iItem.(map[string]interface{}); ok {
 					kind := mItem["kind"].(string)
+					// Gateway API kinds collide with Istio kinds (i.e. Gateway), they are distinguished by the apiVersion
+					if apiVersion, ok := mItem["apiVersion"].(string); ok && strings.HasPrefix(apiVersion, K8sNetworkingGroupVersionV1Alpha2.Group) {
+						if err := parseRegistryK8sGatewayAPIItem(&registry, kind, iItem); err != nil {
+							log.Errorf("Error parsing RegistryConfig results for %s: %s", istiod, err)
+							return nil, err
+						}
+						continue
+					}
 					switch kind {
-					case "DestinationRule", "EnvoyFilter", "Gateway", "ServiceEntry", "Sidecar", "VirtualService", "WorkloadEntry", "WorkloadGroup", "AuthorizationPolicy", "PeerAuthentication", "RequestAuthentication":
+					case "DestinationRule", "EnvoyFilter", "Gateway", "ServiceEntry", "Sidecar", "VirtualService", "WorkloadEntry", "WorkloadGroup", "AuthorizationPolicy", "PeerAuthentication", "RequestAuthentication", "Telemetry", "WasmPlugin":
 						bItem, err := json.Marshal(iItem)
 						rbItem := bytes.NewReader(bItem)
 						bDec := json.NewDecoder(rbItem)
@@ -362,6 +448,20 @@ func ParseRegistryConfig(config map[string][]byte) (*RegistryConfiguration, erro
 								log.Errorf("Error parsing RegistryConfig results for RequestAuthentication: %s", err)
 							}
 							registry.RequestAuthentications = append(registry.RequestAuthentications, ra)
+						case "Telemetry":
+							var tm telemetry_v1alpha1.Telemetry
+							err := bDec.Decode(&tm)
+							if err != nil {
+								log.Errorf("Error parsing RegistryConfig results for Telemetry: %s", err)
+							}
+							registry.Telemetries = append(registry.Telemetries, tm)
+						case "WasmPlugin":
+							var wp extensions_v1alpha1.WasmPlugin
+							err := bDec.Decode(&wp)
+							if err != nil {
+								log.Errorf("Error parsing RegistryConfig results for WasmPlugin: %s", err)
+							}
+							registry.WasmPlugins = append(registry.WasmPlugins, wp)
 						}
 					default:
 						// Kiali only parses the registry configuration that are needed
@@ -374,6 +474,42 @@ func ParseRegistryConfig(config map[string][]byte) (*RegistryConfiguration, erro
 	return &registry, nil
 }
 
+func parseRegistryK8sGatewayAPIItem(registry *RegistryConfiguration, kind string, iItem interface{}) error {
+	bItem, err := json.Marshal(iItem)
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case K8sActualGatewayType:
+		var gw k8s_networking_v1alpha2.Gateway
+		if err := json.Unmarshal(bItem, &gw); err != nil {
+			log.Errorf("Error parsing RegistryConfig results for K8sGateways: %s", err)
+		}
+		registry.K8sGateways = append(registry.K8sGateways, gw)
+	case K8sActualHTTPRouteType:
+		var route k8s_networking_v1alpha2.HTTPRoute
+		if err := json.Unmarshal(bItem, &route); err != nil {
+			log.Errorf("Error parsing RegistryConfig results for K8sHTTPRoutes: %s", err)
+		}
+		registry.K8sHTTPRoutes = append(registry.K8sHTTPRoutes, route)
+	case K8sActualTCPRouteType:
+		var route k8s_networking_v1alpha2.TCPRoute
+		if err := json.Unmarshal(bItem, &route); err != nil {
+			log.Errorf("Error parsing RegistryConfig results for K8sTCPRoutes: %s", err)
+		}
+		registry.K8sTCPRoutes = append(registry.K8sTCPRoutes, route)
+	case K8sActualGRPCRouteType:
+		var route unstructured.Unstructured
+		if err := route.UnmarshalJSON(bItem); err != nil {
+			log.Errorf("Error parsing RegistryConfig results for K8sGRPCRoutes: %s", err)
+		}
+		registry.K8sGRPCRoutes = append(registry.K8sGRPCRoutes, route)
+	default:
+		// Kiali only parses the registry configuration that are needed
+	}
+	return nil
+}
+
 func (in *K8SClient) GetConfigDump(namespace, podName string) (*ConfigDump, error) {
 	// Pulling an open port from the port pool
 	freePort := httputil.Pool.GetFreePort()
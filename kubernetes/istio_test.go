@@ -2,11 +2,15 @@ package kubernetes
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	api_security_v1beta1 "istio.io/api/security/v1beta1"
 	security_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	"k8s.io/client-go/rest"
 
 	"github.com/kiali/kiali/config"
 )
@@ -213,3 +217,25 @@ func TestRegistryServices(t *testing.T) {
 	assert.Equal(79, len(registry))
 	assert.Equal("*.msn.com", registry[0].Attributes.Name)
 }
+
+func TestGatewayAPIStandardChannel(t *testing.T) {
+	// The standard channel serves the v1alpha2 Gateways and HTTPRoutes without the experimental routes
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/gateway.networking.k8s.io/v1alpha2" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"APIResourceList","apiVersion":"v1","groupVersion":"gateway.networking.k8s.io/v1alpha2",` +
+			`"resources":[{"name":"gateways","namespaced":true,"kind":"Gateway","verbs":["get","list"]},` +
+			`{"name":"httproutes","namespaced":true,"kind":"HTTPRoute","verbs":["get","list"]}]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClientFromConfig(&rest.Config{Host: ts.URL})
+	require.NoError(t, err)
+
+	assert.True(t, client.IsGatewayAPI())
+	assert.False(t, client.IsTCPRouteAPI())
+	assert.False(t, client.IsGRPCRouteAPI())
+}
@@ -21,6 +21,7 @@ import (
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,6 +40,8 @@ type K8SClientInterface interface {
 	GetClusterServicesByLabels(labelsSelector string) ([]core_v1.Service, error)
 	GetConfigMap(namespace, name string) (*core_v1.ConfigMap, error)
 	GetCronJobs(namespace string) ([]batch_v1.CronJob, error)
+	GetCustomWorkload(namespace string, name string, workloadType config.CustomWorkloadType) (*unstructured.Unstructured, error)
+	GetCustomWorkloads(namespace string, workloadType config.CustomWorkloadType) ([]unstructured.Unstructured, error)
 	GetDaemonSet(namespace string, name string) (*apps_v1.DaemonSet, error)
 	GetDaemonSets(namespace string) ([]apps_v1.DaemonSet, error)
 	GetDeployment(namespace string, name string) (*apps_v1.Deployment, error)
@@ -541,7 +544,11 @@ func (in *K8SClient) UpdateWorkload(namespace string, workloadName string, workl
 	case DaemonSetType:
 		_, err = in.k8s.AppsV1().DaemonSets(namespace).Patch(in.ctx, workloadName, types.MergePatchType, bytePatch, emptyPatchOptions)
 	default:
-		err = fmt.Errorf("Workload type %s not found", workloadType)
+		if customType, ok := GetCustomWorkloadType(workloadType); ok {
+			_, err = in.dynamic.Resource(customWorkloadResource(customType)).Namespace(namespace).Patch(in.ctx, workloadName, types.MergePatchType, bytePatch, emptyPatchOptions)
+		} else {
+			err = fmt.Errorf("Workload type %s not found", workloadType)
+		}
 	}
 	return err
 }
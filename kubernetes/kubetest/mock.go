@@ -12,7 +12,9 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/version"
+	dynamic_fake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/tools/clientcmd/api"
+	gatewayapifake "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned/fake"
 
 	"github.com/kiali/kiali/kubernetes"
 )
@@ -40,7 +42,9 @@ func (o *K8SClientFactoryMock) GetClient(authInfo *api.AuthInfo) (kubernetes.Cli
 
 type K8SClientMock struct {
 	mock.Mock
-	istioClientset *istio_fake.Clientset
+	istioClientset      *istio_fake.Clientset
+	gatewayAPIClientset *gatewayapifake.Clientset
+	dynamicClient       *dynamic_fake.FakeDynamicClient
 }
 
 // Constructor
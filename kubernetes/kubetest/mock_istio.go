@@ -7,13 +7,40 @@ import (
 	istio "istio.io/client-go/pkg/clientset/versioned"
 	istio_fake "istio.io/client-go/pkg/clientset/versioned/fake"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamic_fake "k8s.io/client-go/dynamic/fake"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayapiclient "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned"
+	gatewayapifake "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned/fake"
 
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/log"
 )
 
 func (o *K8SClientMock) MockIstio(objects ...runtime.Object) {
+	// Gateway API objects are served by their own fake client, Istio fake client doesn't know their scheme.
+	// Unstructured objects (i.e. GRPCRoutes) are served by the fake dynamic client.
+	istioObjects := []runtime.Object{}
+	gatewayAPIObjects := []runtime.Object{}
+	dynamicObjects := []runtime.Object{}
+	for _, ob := range objects {
+		switch ob.(type) {
+		case *k8s_networking_v1alpha2.Gateway, *k8s_networking_v1alpha2.HTTPRoute, *k8s_networking_v1alpha2.TCPRoute:
+			gatewayAPIObjects = append(gatewayAPIObjects, ob)
+		case *unstructured.Unstructured:
+			dynamicObjects = append(dynamicObjects, ob)
+		default:
+			istioObjects = append(istioObjects, ob)
+		}
+	}
+	objects = istioObjects
+	o.gatewayAPIClientset = gatewayapifake.NewSimpleClientset(gatewayAPIObjects...)
+	o.dynamicClient = dynamic_fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		kubernetes.K8sGRPCRouteGroupVersionResource: kubernetes.K8sActualGRPCRouteType + "List",
+	}, dynamicObjects...)
 	o.istioClientset = istio_fake.NewSimpleClientset(objects...)
 	// Istio Fake client has a problem with Gateways
 	// Invoking a NewSimpleClientset() stores a wrong "gatewais" entry, that logic is not even the istio.io but
@@ -26,12 +53,44 @@ func (o *K8SClientMock) MockIstio(objects ...runtime.Object) {
 			}
 		}
 	}
+	// Same workaround for the Gateway API Gateways
+	for _, ob := range gatewayAPIObjects {
+		if gw, ok := ob.(*k8s_networking_v1alpha2.Gateway); ok {
+			_, err := o.gatewayAPIClientset.GatewayV1alpha2().Gateways(gw.Namespace).Create(context.TODO(), gw, v1.CreateOptions{})
+			if err != nil {
+				log.Errorf("Error initializing K8sGateways in MockIstio: %s", err)
+			}
+		}
+	}
 }
 
 func (o *K8SClientMock) Istio() istio.Interface {
 	return o.istioClientset
 }
 
+func (o *K8SClientMock) GatewayAPI() gatewayapiclient.Interface {
+	return o.gatewayAPIClientset
+}
+
+func (o *K8SClientMock) Dynamic() dynamic.Interface {
+	return o.dynamicClient
+}
+
+// IsGatewayAPI reports the Gateway API CRDs as present when MockIstio has initialized the fake clientset
+func (o *K8SClientMock) IsGatewayAPI() bool {
+	return o.gatewayAPIClientset != nil
+}
+
+// IsGRPCRouteAPI reports the GRPCRoute CRD as present when MockIstio has initialized the fake dynamic client
+func (o *K8SClientMock) IsGRPCRouteAPI() bool {
+	return o.dynamicClient != nil
+}
+
+// IsTCPRouteAPI reports the TCPRoute CRD as present when MockIstio has initialized the fake clientset
+func (o *K8SClientMock) IsTCPRouteAPI() bool {
+	return o.gatewayAPIClientset != nil
+}
+
 func (o *K8SClientMock) GetProxyStatus() ([]*kubernetes.ProxyStatus, error) {
 	args := o.Called()
 	return args.Get(0).([]*kubernetes.ProxyStatus), args.Error(1)
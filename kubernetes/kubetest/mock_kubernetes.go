@@ -8,7 +8,9 @@ import (
 	auth_v1 "k8s.io/api/authorization/v1"
 	batch_v1 "k8s.io/api/batch/v1"
 	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/util/httputil"
 )
 
@@ -32,6 +34,16 @@ func (o *K8SClientMock) GetCronJobs(namespace string) ([]batch_v1.CronJob, error
 	return args.Get(0).([]batch_v1.CronJob), args.Error(1)
 }
 
+func (o *K8SClientMock) GetCustomWorkload(namespace string, name string, workloadType config.CustomWorkloadType) (*unstructured.Unstructured, error) {
+	args := o.Called(namespace, name, workloadType.Kind)
+	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
+}
+
+func (o *K8SClientMock) GetCustomWorkloads(namespace string, workloadType config.CustomWorkloadType) ([]unstructured.Unstructured, error) {
+	args := o.Called(namespace, workloadType.Kind)
+	return args.Get(0).([]unstructured.Unstructured), args.Error(1)
+}
+
 func (o *K8SClientMock) GetDaemonSet(namespace string, name string) (*apps_v1.DaemonSet, error) {
 	args := o.Called(namespace, name)
 	return args.Get(0).(*apps_v1.DaemonSet), args.Error(1)
@@ -3,11 +3,14 @@ package kubernetes
 import (
 	"time"
 
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta "istio.io/client-go/pkg/apis/security/v1beta1"
-
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 const (
@@ -51,6 +54,28 @@ const (
 	WorkloadGroups    = "workloadgroups"
 	WorkloadGroupType = "WorkloadGroup"
 
+	// K8s Networking (Gateway API)
+
+	K8sGateways    = "k8sgateways"
+	K8sGatewayType = "K8sGateway"
+	// K8sActualGatewayType is the Kind of a Gateway API Gateway, K8sGatewayType is used to avoid a naming conflict with Istio Gateways
+	K8sActualGatewayType = "Gateway"
+
+	K8sHTTPRoutes    = "k8shttproutes"
+	K8sHTTPRouteType = "K8sHTTPRoute"
+	// K8sActualHTTPRouteType is the Kind of a Gateway API HTTPRoute
+	K8sActualHTTPRouteType = "HTTPRoute"
+
+	K8sTCPRoutes    = "k8stcproutes"
+	K8sTCPRouteType = "K8sTCPRoute"
+	// K8sActualTCPRouteType is the Kind of a Gateway API TCPRoute
+	K8sActualTCPRouteType = "TCPRoute"
+
+	K8sGRPCRoutes    = "k8sgrpcroutes"
+	K8sGRPCRouteType = "K8sGRPCRoute"
+	// K8sActualGRPCRouteType is the Kind of a Gateway API GRPCRoute
+	K8sActualGRPCRouteType = "GRPCRoute"
+
 	// Authorization PeerAuthentications
 	AuthorizationPolicies     = "authorizationpolicies"
 	AuthorizationPoliciesType = "AuthorizationPolicy"
@@ -62,6 +87,14 @@ const (
 	// Request Authentications
 	RequestAuthentications     = "requestauthentications"
 	RequestAuthenticationsType = "RequestAuthentication"
+
+	// Telemetry
+	Telemetries   = "telemetries"
+	TelemetryType = "Telemetry"
+
+	// Extensions
+	WasmPlugins    = "wasmplugins"
+	WasmPluginType = "WasmPlugin"
 )
 
 var (
@@ -83,6 +116,28 @@ var (
 	}
 	ApiSecurityVersion = SecurityGroupVersion.Group + "/" + SecurityGroupVersion.Version
 
+	TelemetryGroupVersionV1Alpha1 = schema.GroupVersion{
+		Group:   "telemetry.istio.io",
+		Version: "v1alpha1",
+	}
+	ApiTelemetryVersionV1Alpha1 = TelemetryGroupVersionV1Alpha1.Group + "/" + TelemetryGroupVersionV1Alpha1.Version
+
+	ExtensionsGroupVersionV1Alpha1 = schema.GroupVersion{
+		Group:   "extensions.istio.io",
+		Version: "v1alpha1",
+	}
+	ApiExtensionsVersionV1Alpha1 = ExtensionsGroupVersionV1Alpha1.Group + "/" + ExtensionsGroupVersionV1Alpha1.Version
+
+	K8sNetworkingGroupVersionV1Alpha2 = schema.GroupVersion{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1alpha2",
+	}
+	K8sApiNetworkingVersionV1Alpha2 = K8sNetworkingGroupVersionV1Alpha2.Group + "/" + K8sNetworkingGroupVersionV1Alpha2.Version
+
+	// K8sGRPCRouteGroupVersionResource identifies the GRPCRoutes of the Gateway API experimental channel.
+	// The typed Gateway API client doesn't provide them, they are managed with the dynamic client.
+	K8sGRPCRouteGroupVersionResource = K8sNetworkingGroupVersionV1Alpha2.WithResource("grpcroutes")
+
 	PluralType = map[string]string{
 		// Networking
 		Gateways:         GatewayType,
@@ -94,10 +149,22 @@ var (
 		WorkloadGroups:   WorkloadGroupType,
 		EnvoyFilters:     EnvoyFilterType,
 
+		// K8s Networking
+		K8sGateways:   K8sGatewayType,
+		K8sHTTPRoutes: K8sHTTPRouteType,
+		K8sTCPRoutes:  K8sTCPRouteType,
+		K8sGRPCRoutes: K8sGRPCRouteType,
+
 		// Security
 		AuthorizationPolicies:  AuthorizationPoliciesType,
 		PeerAuthentications:    PeerAuthenticationsType,
 		RequestAuthentications: RequestAuthenticationsType,
+
+		// Telemetry
+		Telemetries: TelemetryType,
+
+		// Extensions
+		WasmPlugins: WasmPluginType,
 	}
 
 	ResourceTypesToAPI = map[string]string{
@@ -109,9 +176,15 @@ var (
 		VirtualServices:        NetworkingGroupVersionV1Beta1.Group,
 		WorkloadEntries:        NetworkingGroupVersionV1Beta1.Group,
 		WorkloadGroups:         NetworkingGroupVersionV1Beta1.Group,
+		K8sGateways:            K8sNetworkingGroupVersionV1Alpha2.Group,
+		K8sHTTPRoutes:          K8sNetworkingGroupVersionV1Alpha2.Group,
+		K8sTCPRoutes:           K8sNetworkingGroupVersionV1Alpha2.Group,
+		K8sGRPCRoutes:          K8sNetworkingGroupVersionV1Alpha2.Group,
 		AuthorizationPolicies:  SecurityGroupVersion.Group,
 		PeerAuthentications:    SecurityGroupVersion.Group,
 		RequestAuthentications: SecurityGroupVersion.Group,
+		Telemetries:            TelemetryGroupVersionV1Alpha1.Group,
+		WasmPlugins:            ExtensionsGroupVersionV1Alpha1.Group,
 	}
 )
 
@@ -165,10 +238,19 @@ type RegistryConfiguration struct {
 	VirtualServices  []networking_v1beta1.VirtualService
 	WorkloadEntries  []networking_v1beta1.WorkloadEntry
 	WorkloadGroups   []networking_v1beta1.WorkloadGroup
+	// K8s Networking
+	K8sGateways   []k8s_networking_v1alpha2.Gateway
+	K8sHTTPRoutes []k8s_networking_v1alpha2.HTTPRoute
+	K8sTCPRoutes  []k8s_networking_v1alpha2.TCPRoute
+	K8sGRPCRoutes []unstructured.Unstructured
 	// Security
 	AuthorizationPolicies  []security_v1beta.AuthorizationPolicy
 	PeerAuthentications    []security_v1beta.PeerAuthentication
 	RequestAuthentications []security_v1beta.RequestAuthentication
+	// Telemetry
+	Telemetries []telemetry_v1alpha1.Telemetry
+	// Extensions
+	WasmPlugins []extensions_v1alpha1.WasmPlugin
 }
 
 type RegistryEndpoint struct {
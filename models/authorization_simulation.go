@@ -0,0 +1,130 @@
+package models
+
+// Decisions of an AuthorizationDecision
+const (
+	AuthorizationAllow   = "ALLOW"
+	AuthorizationDeny    = "DENY"
+	AuthorizationCustom  = "CUSTOM"
+	AuthorizationUnknown = "UNKNOWN"
+)
+
+// AuthorizationRequest is a request to a workload, evaluated against the AuthorizationPolicies and
+// PeerAuthentications that apply to the workload
+//
+// swagger:model AuthorizationRequest
+type AuthorizationRequest struct {
+	// Identity of the client
+	// required: true
+	Source AuthorizationSource `json:"source"`
+
+	// Port of the workload
+	// required: true
+	// example: 9080
+	Port int `json:"port"`
+
+	// Host of the request
+	// example: reviews.bookinfo.svc.cluster.local
+	Host string `json:"host,omitempty"`
+
+	// HTTP method of the request
+	// example: GET
+	Method string `json:"method,omitempty"`
+
+	// HTTP path of the request
+	// example: /reviews/1
+	Path string `json:"path,omitempty"`
+
+	// HTTP headers of the request
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// AuthorizationSource is the identity of the client of an AuthorizationRequest. Without namespace, service account
+// nor principal, the client is considered outside the mesh, without mTLS identity.
+type AuthorizationSource struct {
+	// Namespace of the client
+	// example: bookinfo
+	Namespace string `json:"namespace,omitempty"`
+
+	// Service account of the client, default when the namespace is given without service account
+	// example: bookinfo-productpage
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// Principal of the client, it takes precedence over the namespace and the service account
+	// example: cluster.local/ns/bookinfo/sa/bookinfo-productpage
+	Principal string `json:"principal,omitempty"`
+
+	// Principal of the request, from the JWT of the request as <issuer>/<subject>
+	// example: https://accounts.example.com/user@example.com
+	RequestPrincipal string `json:"requestPrincipal,omitempty"`
+
+	// IP of the client
+	// example: 10.0.0.12
+	IP string `json:"ip,omitempty"`
+}
+
+// AuthorizationDecision is the result of the evaluation of an AuthorizationRequest
+//
+// swagger:model AuthorizationDecision
+type AuthorizationDecision struct {
+	// ALLOW, DENY, CUSTOM when the request is allowed unless the external authorizer of a CUSTOM policy denies it, or
+	// UNKNOWN when the decision depends on a condition that can't be evaluated
+	// required: true
+	// example: DENY
+	Decision string `json:"decision"`
+
+	// Explanation of the decision
+	// required: true
+	// example: The request matches a DENY policy
+	Reason string `json:"reason"`
+
+	// mTLS mode of the workload port, from the PeerAuthentications: STRICT, PERMISSIVE or DISABLE
+	// required: true
+	// example: STRICT
+	MTLSMode string `json:"mtlsMode"`
+
+	// Principal of the client seen by the workload, empty when the connection isn't mTLS
+	// example: cluster.local/ns/bookinfo/sa/bookinfo-productpage
+	Principal string `json:"principal"`
+
+	// Policy and rule deciding the request, none when no policy matches
+	Policy *AuthorizationPolicyMatch `json:"policy,omitempty"`
+
+	// AUDIT policy matching the request, if any
+	Audit *AuthorizationPolicyMatch `json:"audit,omitempty"`
+
+	// All the AuthorizationPolicies applying to the workload, with their evaluation
+	// required: true
+	Policies []AuthorizationPolicyMatch `json:"policies"`
+}
+
+// AuthorizationPolicyMatch is the evaluation of an AuthorizationPolicy for an AuthorizationRequest
+type AuthorizationPolicyMatch struct {
+	// required: true
+	Name string `json:"name"`
+
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// Action of the policy: ALLOW, DENY, AUDIT or CUSTOM
+	// required: true
+	Action string `json:"action"`
+
+	// Extension provider of a CUSTOM policy
+	Provider string `json:"provider,omitempty"`
+
+	// True when a rule of the policy matches the request
+	// required: true
+	Matched bool `json:"matched"`
+
+	// Index of the first rule matching the request, -1 when none matches
+	// required: true
+	Rule int `json:"rule"`
+
+	// Path of the matching rule in the policy
+	// example: spec/rules[0]
+	Path string `json:"path,omitempty"`
+
+	// Key of a condition that can't be evaluated, when a rule may match the request depending on it
+	// example: request.auth.claims[groups]
+	Indeterminate string `json:"indeterminate,omitempty"`
+}
@@ -79,6 +79,9 @@ type WorkloadStatus struct {
 	CurrentReplicas   int32  `json:"currentReplicas"`
 	AvailableReplicas int32  `json:"availableReplicas"`
 	SyncedProxies     int32  `json:"syncedProxies"`
+	// PodRestarts is the highest increase of the container restarts of the pods of the workload over the rate interval.
+	// It is only fetched when the health_config of the entity has a restarts tolerance.
+	PodRestarts int32 `json:"podRestarts"`
 }
 
 // ProxyStatus gives the sync status of the sidecar proxy.
@@ -94,14 +97,47 @@ type ProxyStatus struct {
 // RequestHealth holds several stats about recent request errors
 // - Inbound//Outbound are the rates of requests by protocol and status_code.
 //   Example:   Inbound: { "http": {"200": 1.5, "400": 2.3}, "grpc": {"1": 1.2} }
+// - Latency are the request duration percentiles in milliseconds, by direction, protocol and percentile.
+//   They are only fetched when the health_config of the entity has latency tolerances.
+//   Example:   Latency: { "inbound": { "http": {"p95": 120.5, "p99": 310} } }
+// - TCPConnections are the rates of opened and failed TCP connections by direction.
+//   They are only fetched when the health_config of the entity has connection tolerances.
+//   Example:   TCPConnections: { "inbound": {"opened": 2.5, "failed": 0.1} }
 type RequestHealth struct {
-	Inbound            map[string]map[string]float64 `json:"inbound"`
-	Outbound           map[string]map[string]float64 `json:"outbound"`
-	HealthAnnotations  map[string]string             `json:"healthAnnotations"`
+	Inbound            map[string]map[string]float64            `json:"inbound"`
+	Outbound           map[string]map[string]float64            `json:"outbound"`
+	Latency            map[string]map[string]map[string]float64 `json:"latency,omitempty"`
+	TCPConnections     map[string]map[string]float64            `json:"tcpConnections,omitempty"`
+	HealthAnnotations  map[string]string                        `json:"healthAnnotations"`
 	inboundSource      map[string]map[string]float64
 	inboundDestination map[string]map[string]float64
 }
 
+// AddLatency sets a request duration percentile, in milliseconds, of a direction and protocol
+func (in *RequestHealth) AddLatency(direction, protocol, percentile string, value float64) {
+	if in.Latency == nil {
+		in.Latency = make(map[string]map[string]map[string]float64)
+	}
+	if _, ok := in.Latency[direction]; !ok {
+		in.Latency[direction] = make(map[string]map[string]float64)
+	}
+	if _, ok := in.Latency[direction][protocol]; !ok {
+		in.Latency[direction][protocol] = make(map[string]float64)
+	}
+	in.Latency[direction][protocol][percentile] = value
+}
+
+// AddTCPConnections adds a rate of opened or failed TCP connections of a direction
+func (in *RequestHealth) AddTCPConnections(direction, state string, value float64) {
+	if in.TCPConnections == nil {
+		in.TCPConnections = make(map[string]map[string]float64)
+	}
+	if _, ok := in.TCPConnections[direction]; !ok {
+		in.TCPConnections[direction] = make(map[string]float64)
+	}
+	in.TCPConnections[direction][state] += value
+}
+
 // AggregateInbound adds the provided metric sample to internal inbound counters and updates error ratios
 func (in *RequestHealth) AggregateInbound(sample *model.Sample) {
 	// Samples need to be aggregated by source or destination reporter, but not accumulated both
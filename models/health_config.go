@@ -1,15 +1,27 @@
 package models
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+)
+
 // Annotationkey is a mnemonic type name for string
 type AnnotationKey string
 
 const (
-	AllHealthAnnotation  AnnotationKey = ".*"
-	RateHealthAnnotation AnnotationKey = "health.kiali.io/rate"
+	AllHealthAnnotation        AnnotationKey = ".*"
+	RateHealthAnnotation       AnnotationKey = "health.kiali.io/rate"
+	LatencyHealthAnnotation    AnnotationKey = "health.kiali.io/latency"
+	ConnectionHealthAnnotation AnnotationKey = "health.kiali.io/connection"
+	RestartsHealthAnnotation   AnnotationKey = "health.kiali.io/restarts"
 )
 
 func GetHealthConfigAnnotation() []AnnotationKey {
-	return []AnnotationKey{RateHealthAnnotation}
+	return []AnnotationKey{RateHealthAnnotation, LatencyHealthAnnotation, ConnectionHealthAnnotation, RestartsHealthAnnotation}
 }
 
 func GetHealthAnnotation(annotations map[string]string, filters []AnnotationKey) map[string]string {
@@ -24,3 +36,161 @@ func GetHealthAnnotation(annotations map[string]string, filters []AnnotationKey)
 	}
 	return result
 }
+
+// GetRateHealthConfigWithAnnotations returns the first rate of the health_config matching the provided entity,
+// with the tolerances overridden by the health annotations of the entity:
+// - health.kiali.io/rate: "code,degraded,failure,protocol,direction;..."  i.e. "5XX,10,20,http,inbound"
+// - health.kiali.io/latency: "percentile,degraded,failure,protocol,direction;..."  i.e. "p99,200,500,http,inbound"
+// - health.kiali.io/connection: "degraded,failure,direction;..."  i.e. "5,10,inbound"
+// - health.kiali.io/restarts: "degraded,failure"  i.e. "1,5"
+// Invalid annotations are ignored. It returns nil when no rate matches and there are no valid annotations.
+func GetRateHealthConfigWithAnnotations(namespace, kind, name string, annotations map[string]string) *config.Rate {
+	rate := GetRateHealthConfig(namespace, kind, name)
+	if len(annotations) == 0 {
+		return rate
+	}
+	result := config.Rate{Namespace: namespace, Kind: kind, Name: name}
+	if rate != nil {
+		result = *rate
+	}
+	overridden := false
+	if value, ok := annotations[string(RateHealthAnnotation)]; ok {
+		if tolerances, err := parseRateAnnotation(value); err == nil {
+			result.Tolerance, overridden = tolerances, true
+		} else {
+			log.Debugf("Ignoring invalid %s annotation of %s %s.%s: %v", RateHealthAnnotation, kind, namespace, name, err)
+		}
+	}
+	if value, ok := annotations[string(LatencyHealthAnnotation)]; ok {
+		if tolerances, err := parseLatencyAnnotation(value); err == nil {
+			result.Latency, overridden = tolerances, true
+		} else {
+			log.Debugf("Ignoring invalid %s annotation of %s %s.%s: %v", LatencyHealthAnnotation, kind, namespace, name, err)
+		}
+	}
+	if value, ok := annotations[string(ConnectionHealthAnnotation)]; ok {
+		if tolerances, err := parseConnectionAnnotation(value); err == nil {
+			result.Connection, overridden = tolerances, true
+		} else {
+			log.Debugf("Ignoring invalid %s annotation of %s %s.%s: %v", ConnectionHealthAnnotation, kind, namespace, name, err)
+		}
+	}
+	if value, ok := annotations[string(RestartsHealthAnnotation)]; ok {
+		if tolerance, err := parseRestartsAnnotation(value); err == nil {
+			result.Restarts, overridden = tolerance, true
+		} else {
+			log.Debugf("Ignoring invalid %s annotation of %s %s.%s: %v", RestartsHealthAnnotation, kind, namespace, name, err)
+		}
+	}
+	if !overridden {
+		return rate
+	}
+	return &result
+}
+
+// splitHealthAnnotation splits a ";" separated list of "," separated fields, checking the number of fields
+func splitHealthAnnotation(value string, minFields, maxFields int) ([][]string, error) {
+	result := [][]string{}
+	for _, item := range strings.Split(value, ";") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		fields := strings.Split(item, ",")
+		if len(fields) < minFields || len(fields) > maxFields {
+			return nil, fmt.Errorf("expected %d to %d fields in [%s]", minFields, maxFields, item)
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		for len(fields) < maxFields {
+			fields = append(fields, "")
+		}
+		result = append(result, fields)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty annotation")
+	}
+	return result, nil
+}
+
+func parseHealthThresholds(degraded, failure string) (float32, float32, error) {
+	d, err := strconv.ParseFloat(degraded, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	f, err := strconv.ParseFloat(failure, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return float32(d), float32(f), nil
+}
+
+func parseRateAnnotation(value string) ([]config.Tolerance, error) {
+	items, err := splitHealthAnnotation(value, 3, 5)
+	if err != nil {
+		return nil, err
+	}
+	tolerances := []config.Tolerance{}
+	for _, fields := range items {
+		degraded, failure, err := parseHealthThresholds(fields[1], fields[2])
+		if err != nil {
+			return nil, err
+		}
+		tolerances = append(tolerances, config.Tolerance{Code: fields[0], Degraded: degraded, Failure: failure, Protocol: fields[3], Direction: fields[4]})
+	}
+	return tolerances, nil
+}
+
+func parseLatencyAnnotation(value string) ([]config.LatencyTolerance, error) {
+	items, err := splitHealthAnnotation(value, 3, 5)
+	if err != nil {
+		return nil, err
+	}
+	tolerances := []config.LatencyTolerance{}
+	for _, fields := range items {
+		if fields[0] != "p95" && fields[0] != "p99" {
+			return nil, fmt.Errorf("unsupported percentile [%s], expected p95 or p99", fields[0])
+		}
+		degraded, failure, err := parseHealthThresholds(fields[1], fields[2])
+		if err != nil {
+			return nil, err
+		}
+		tolerances = append(tolerances, config.LatencyTolerance{Percentile: fields[0], Degraded: degraded, Failure: failure, Protocol: fields[3], Direction: fields[4]})
+	}
+	return tolerances, nil
+}
+
+func parseConnectionAnnotation(value string) ([]config.ConnectionTolerance, error) {
+	items, err := splitHealthAnnotation(value, 2, 3)
+	if err != nil {
+		return nil, err
+	}
+	tolerances := []config.ConnectionTolerance{}
+	for _, fields := range items {
+		degraded, failure, err := parseHealthThresholds(fields[0], fields[1])
+		if err != nil {
+			return nil, err
+		}
+		tolerances = append(tolerances, config.ConnectionTolerance{Degraded: degraded, Failure: failure, Direction: fields[2]})
+	}
+	return tolerances, nil
+}
+
+func parseRestartsAnnotation(value string) (*config.RestartTolerance, error) {
+	items, err := splitHealthAnnotation(value, 2, 2)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > 1 {
+		return nil, fmt.Errorf("expected a single restarts tolerance in [%s]", value)
+	}
+	degraded, err := strconv.ParseInt(items[0][0], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	failure, err := strconv.ParseInt(items[0][1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &config.RestartTolerance{Degraded: int32(degraded), Failure: int32(failure)}, nil
+}
@@ -0,0 +1,270 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+)
+
+// HealthStatus is the overall health of an entity, as presented by the UI
+type HealthStatus string
+
+const (
+	HealthStatusHealthy  HealthStatus = "Healthy"
+	HealthStatusDegraded HealthStatus = "Degraded"
+	HealthStatusFailure  HealthStatus = "Failure"
+	HealthStatusNA       HealthStatus = "NA"
+)
+
+// healthStatusPriority allows to pick the worst of several statuses
+var healthStatusPriority = map[HealthStatus]int{
+	HealthStatusNA:       0,
+	HealthStatusHealthy:  1,
+	HealthStatusDegraded: 2,
+	HealthStatusFailure:  3,
+}
+
+// WorstHealthStatus returns the most severe of the provided statuses
+func WorstHealthStatus(statuses ...HealthStatus) HealthStatus {
+	worst := HealthStatusNA
+	for _, s := range statuses {
+		if healthStatusPriority[s] > healthStatusPriority[worst] {
+			worst = s
+		}
+	}
+	return worst
+}
+
+// Status evaluates the replicas of a workload. It returns NA for workloads scaled to zero.
+func (ws *WorkloadStatus) Status() HealthStatus {
+	if ws == nil || (ws.DesiredReplicas == 0 && ws.AvailableReplicas == 0) {
+		return HealthStatusNA
+	}
+	if ws.AvailableReplicas == 0 {
+		return HealthStatusFailure
+	}
+	if ws.AvailableReplicas < ws.DesiredReplicas || (ws.SyncedProxies >= 0 && ws.SyncedProxies < ws.AvailableReplicas) {
+		return HealthStatusDegraded
+	}
+	return HealthStatusHealthy
+}
+
+// Status evaluates the request error ratios, the request latencies and the TCP connection failures
+// of an entity against the tolerances of the first health_config rate matching the namespace, kind
+// and name of the entity, overridden by its health annotations. This is the same logic applied by
+// the UI to color nodes and list items.
+func (in RequestHealth) Status(namespace, kind, name string) HealthStatus {
+	rate := GetRateHealthConfigWithAnnotations(namespace, kind, name, in.HealthAnnotations)
+	if rate == nil {
+		return HealthStatusNA
+	}
+	return WorstHealthStatus(in.requestsStatus(rate, nil), in.latencyStatus(rate), in.connectionStatus(rate))
+}
+
+// ErrorRatios returns the percentages of requests matching the codes of the tolerances of the entity health_config,
+// by direction, protocol and tolerance code, i.e. { "inbound": { "http": {"5XX": 12.5} } }.
+// Only the tolerances matched by some request are present.
+func (in RequestHealth) ErrorRatios(namespace, kind, name string) map[string]map[string]map[string]float64 {
+	ratios := map[string]map[string]map[string]float64{}
+	if rate := GetRateHealthConfigWithAnnotations(namespace, kind, name, in.HealthAnnotations); rate != nil {
+		in.requestsStatus(rate, ratios)
+	}
+	return ratios
+}
+
+// requestsStatus evaluates the request error ratios against the tolerances of the rate. The error ratios of the
+// tolerances matched by some request are stored in ratios, when not nil.
+func (in RequestHealth) requestsStatus(rate *config.Rate, ratios map[string]map[string]map[string]float64) HealthStatus {
+	status := HealthStatusNA
+	for direction, requests := range map[string]map[string]map[string]float64{"inbound": in.Inbound, "outbound": in.Outbound} {
+		for protocol, codes := range requests {
+			total := 0.0
+			for _, v := range codes {
+				total += v
+			}
+			if total == 0 {
+				continue
+			}
+			status = WorstHealthStatus(status, HealthStatusHealthy)
+			for _, t := range rate.Tolerance {
+				if !matchHealthRegexp(t.Protocol, protocol) || !matchHealthRegexp(t.Direction, direction) {
+					continue
+				}
+				codeRegexp, err := compileHealthRegexp(strings.Replace(t.Code, "X", `\d`, -1))
+				if err != nil {
+					log.Debugf("Ignoring health tolerance with invalid code [%s]: %v", t.Code, err)
+					continue
+				}
+				errors := 0.0
+				for code, v := range codes {
+					if codeRegexp.MatchString(code) {
+						errors += v
+					}
+				}
+				if errors > 0 {
+					status = WorstHealthStatus(status, toleranceStatus(t, errors/total*100))
+					if ratios != nil {
+						if _, ok := ratios[direction]; !ok {
+							ratios[direction] = map[string]map[string]float64{}
+						}
+						if _, ok := ratios[direction][protocol]; !ok {
+							ratios[direction][protocol] = map[string]float64{}
+						}
+						ratios[direction][protocol][t.Code] = errors / total * 100
+					}
+				}
+			}
+		}
+	}
+	return status
+}
+
+// latencyStatus evaluates the request duration percentiles against the latency tolerances
+func (in RequestHealth) latencyStatus(rate *config.Rate) HealthStatus {
+	status := HealthStatusNA
+	for _, lt := range rate.Latency {
+		for direction, protocols := range in.Latency {
+			if !matchHealthRegexp(lt.Direction, direction) {
+				continue
+			}
+			for protocol, percentiles := range protocols {
+				if !matchHealthRegexp(lt.Protocol, protocol) {
+					continue
+				}
+				if value, ok := percentiles[lt.Percentile]; ok {
+					status = WorstHealthStatus(status, thresholdStatus(float64(lt.Degraded), float64(lt.Failure), value))
+				}
+			}
+		}
+	}
+	return status
+}
+
+// connectionStatus evaluates the ratio of failed TCP connections against the connection tolerances
+func (in RequestHealth) connectionStatus(rate *config.Rate) HealthStatus {
+	status := HealthStatusNA
+	for _, ct := range rate.Connection {
+		for direction, connections := range in.TCPConnections {
+			if !matchHealthRegexp(ct.Direction, direction) {
+				continue
+			}
+			opened, failed := connections["opened"], connections["failed"]
+			if opened == 0 && failed == 0 {
+				continue
+			}
+			status = WorstHealthStatus(status, HealthStatusHealthy)
+			if failed > 0 {
+				// Connections opened before the rate interval can fail within it
+				ratio := 100.0
+				if opened > failed {
+					ratio = failed / opened * 100
+				}
+				status = WorstHealthStatus(status, toleranceStatus(config.Tolerance{Degraded: ct.Degraded, Failure: ct.Failure}, ratio))
+			}
+		}
+	}
+	return status
+}
+
+// restartsStatus evaluates the container restarts of the pods of a workload against the restarts tolerance.
+// It returns NA when the rate has no restarts tolerance or there are no restarts.
+func restartsStatus(rate *config.Rate, ws *WorkloadStatus) HealthStatus {
+	if rate == nil || rate.Restarts == nil || ws == nil || ws.PodRestarts == 0 {
+		return HealthStatusNA
+	}
+	return thresholdStatus(float64(rate.Restarts.Degraded), float64(rate.Restarts.Failure), float64(ws.PodRestarts))
+}
+
+// Status returns the worst status of the workload replicas, the workload pod restarts and the workload requests
+func (wh *WorkloadHealth) Status(namespace, name string) HealthStatus {
+	rate := GetRateHealthConfigWithAnnotations(namespace, "workload", name, wh.Requests.HealthAnnotations)
+	return WorstHealthStatus(wh.WorkloadStatus.Status(), restartsStatus(rate, wh.WorkloadStatus), wh.Requests.Status(namespace, "workload", name))
+}
+
+// Status returns the worst status of the replicas and pod restarts of all the app workloads and the app requests
+func (ah *AppHealth) Status(namespace, name string) HealthStatus {
+	status := ah.Requests.Status(namespace, "app", name)
+	rate := GetRateHealthConfigWithAnnotations(namespace, "app", name, ah.Requests.HealthAnnotations)
+	for _, ws := range ah.WorkloadStatuses {
+		status = WorstHealthStatus(status, ws.Status(), restartsStatus(rate, ws))
+	}
+	return status
+}
+
+// Status returns the status of the service requests
+func (sh *ServiceHealth) Status(namespace, name string) HealthStatus {
+	return sh.Requests.Status(namespace, "service", name)
+}
+
+// GetRateHealthConfig returns the first rate of the health_config matching the provided entity,
+// or nil when no rate matches.
+func GetRateHealthConfig(namespace, kind, name string) *config.Rate {
+	rates := config.Get().HealthConfig.Rate
+	for i, r := range rates {
+		if matchHealthRegexp(r.Namespace, namespace) && matchHealthRegexp(r.Kind, kind) && matchHealthRegexp(r.Name, name) {
+			return &rates[i]
+		}
+	}
+	return nil
+}
+
+func toleranceStatus(t config.Tolerance, errorRatio float64) HealthStatus {
+	if errorRatio >= float64(t.Failure) {
+		return HealthStatusFailure
+	}
+	if errorRatio > float64(t.Degraded) {
+		return HealthStatusDegraded
+	}
+	return HealthStatusHealthy
+}
+
+// thresholdStatus evaluates a value against degraded and failure thresholds, a 0 threshold is disabled
+func thresholdStatus(degraded, failure, value float64) HealthStatus {
+	if failure > 0 && value >= failure {
+		return HealthStatusFailure
+	}
+	if degraded > 0 && value >= degraded {
+		return HealthStatusDegraded
+	}
+	return HealthStatusHealthy
+}
+
+// matchHealthRegexp checks a value against a health_config expression. Empty expressions match anything.
+func matchHealthRegexp(expr, value string) bool {
+	if expr == "" {
+		return true
+	}
+	r, err := compileHealthRegexp(expr)
+	if err != nil {
+		log.Debugf("Ignoring invalid health config expression [%s]: %v", expr, err)
+		return false
+	}
+	return r.MatchString(value)
+}
+
+var (
+	healthRegexps     = map[string]*regexp.Regexp{}
+	healthRegexpsLock sync.RWMutex
+)
+
+// compileHealthRegexp compiles a health_config or annotation expression once, health is evaluated for
+// every node of a graph with the same few expressions.
+func compileHealthRegexp(expr string) (*regexp.Regexp, error) {
+	healthRegexpsLock.RLock()
+	r, ok := healthRegexps[expr]
+	healthRegexpsLock.RUnlock()
+	if ok {
+		return r, nil
+	}
+	r, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	healthRegexpsLock.Lock()
+	healthRegexps[expr] = r
+	healthRegexpsLock.Unlock()
+	return r, nil
+}
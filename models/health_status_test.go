@@ -0,0 +1,227 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestWorkloadStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(HealthStatusNA, (&WorkloadStatus{DesiredReplicas: 0, AvailableReplicas: 0}).Status())
+	assert.Equal(HealthStatusFailure, (&WorkloadStatus{DesiredReplicas: 2, AvailableReplicas: 0}).Status())
+	assert.Equal(HealthStatusDegraded, (&WorkloadStatus{DesiredReplicas: 2, AvailableReplicas: 1, SyncedProxies: -1}).Status())
+	assert.Equal(HealthStatusDegraded, (&WorkloadStatus{DesiredReplicas: 2, AvailableReplicas: 2, SyncedProxies: 1}).Status())
+	assert.Equal(HealthStatusHealthy, (&WorkloadStatus{DesiredReplicas: 2, AvailableReplicas: 2, SyncedProxies: -1}).Status())
+}
+
+func TestRequestHealthStatusWithDefaults(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	requests := NewEmptyRequestHealth()
+	assert.Equal(HealthStatusNA, requests.Status("bookinfo", "app", "reviews"))
+
+	requests.Inbound["http"] = map[string]float64{"200": 95, "404": 5}
+	assert.Equal(HealthStatusHealthy, requests.Status("bookinfo", "app", "reviews"))
+
+	requests.Inbound["http"] = map[string]float64{"200": 85, "404": 15}
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "app", "reviews"))
+
+	requests.Inbound["http"] = map[string]float64{"200": 95, "503": 5}
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "app", "reviews"))
+
+	requests.Outbound["grpc"] = map[string]float64{"0": 80, "14": 20}
+	assert.Equal(HealthStatusFailure, requests.Status("bookinfo", "app", "reviews"))
+}
+
+func TestRequestHealthStatusUsesFirstMatchingRate(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.HealthConfig.Rate = []config.Rate{
+		{
+			Namespace: "bookinfo",
+			Kind:      "workload",
+			Name:      "reviews-.*",
+			Tolerance: []config.Tolerance{{Code: "5XX", Protocol: "http", Direction: "inbound", Degraded: 30, Failure: 50}},
+		},
+	}
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	requests := NewEmptyRequestHealth()
+	requests.Inbound["http"] = map[string]float64{"200": 80, "500": 20}
+	assert.Equal(HealthStatusHealthy, requests.Status("bookinfo", "workload", "reviews-v1"))
+	assert.Equal(HealthStatusFailure, requests.Status("bookinfo", "workload", "ratings-v1"))
+}
+
+func TestRequestHealthStatusWithLatencyAndConnections(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.HealthConfig.Rate = []config.Rate{
+		{
+			Kind:       "workload",
+			Latency:    []config.LatencyTolerance{{Percentile: "p95", Degraded: 200, Failure: 500, Protocol: "http", Direction: "inbound"}},
+			Connection: []config.ConnectionTolerance{{Degraded: 5, Failure: 20}},
+		},
+	}
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	requests := NewEmptyRequestHealth()
+	requests.AddLatency("inbound", "http", "p95", 120)
+	assert.Equal(HealthStatusHealthy, requests.Status("bookinfo", "workload", "reviews-v1"))
+
+	requests.AddLatency("inbound", "http", "p95", 250)
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "workload", "reviews-v1"))
+
+	// Latencies of other protocols, directions or percentiles are not evaluated
+	requests.AddLatency("inbound", "grpc", "p95", 900)
+	requests.AddLatency("outbound", "http", "p95", 900)
+	requests.AddLatency("inbound", "http", "p99", 900)
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "workload", "reviews-v1"))
+
+	requests.AddLatency("inbound", "http", "p95", 500)
+	assert.Equal(HealthStatusFailure, requests.Status("bookinfo", "workload", "reviews-v1"))
+
+	// Apps don't match the rate with latency tolerances
+	assert.Equal(HealthStatusNA, requests.Status("bookinfo", "app", "reviews"))
+
+	requests = NewEmptyRequestHealth()
+	requests.AddTCPConnections("outbound", "opened", 10)
+	assert.Equal(HealthStatusHealthy, requests.Status("bookinfo", "workload", "reviews-v1"))
+
+	requests.AddTCPConnections("outbound", "failed", 1)
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "workload", "reviews-v1"))
+
+	requests.AddTCPConnections("inbound", "failed", 0.5)
+	assert.Equal(HealthStatusFailure, requests.Status("bookinfo", "workload", "reviews-v1"))
+}
+
+func TestWorkloadHealthStatusWithRestarts(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.HealthConfig.Rate = []config.Rate{
+		{Kind: "workload|app", Restarts: &config.RestartTolerance{Degraded: 1, Failure: 5}},
+	}
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	wh := WorkloadHealth{
+		WorkloadStatus: &WorkloadStatus{DesiredReplicas: 1, AvailableReplicas: 1, SyncedProxies: -1},
+		Requests:       NewEmptyRequestHealth(),
+	}
+	assert.Equal(HealthStatusHealthy, wh.Status("bookinfo", "reviews-v1"))
+
+	wh.WorkloadStatus.PodRestarts = 2
+	assert.Equal(HealthStatusDegraded, wh.Status("bookinfo", "reviews-v1"))
+
+	wh.WorkloadStatus.PodRestarts = 5
+	assert.Equal(HealthStatusFailure, wh.Status("bookinfo", "reviews-v1"))
+
+	ah := AppHealth{WorkloadStatuses: []*WorkloadStatus{wh.WorkloadStatus}, Requests: NewEmptyRequestHealth()}
+	assert.Equal(HealthStatusFailure, ah.Status("bookinfo", "reviews"))
+
+	// Restarts are not evaluated without a restarts tolerance
+	conf.HealthConfig.Rate = nil
+	conf.AddHealthDefault()
+	config.Set(conf)
+	assert.Equal(HealthStatusHealthy, wh.Status("bookinfo", "reviews-v1"))
+}
+
+func TestHealthStatusWithAnnotations(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	wh := WorkloadHealth{
+		WorkloadStatus: &WorkloadStatus{DesiredReplicas: 1, AvailableReplicas: 1, SyncedProxies: -1, PodRestarts: 3},
+		Requests:       NewEmptyRequestHealth(),
+	}
+	wh.Requests.AddLatency("inbound", "http", "p99", 300)
+	assert.Equal(HealthStatusHealthy, wh.Status("bookinfo", "reviews-v1"))
+
+	wh.Requests.HealthAnnotations = map[string]string{
+		string(LatencyHealthAnnotation):  "p99,200,500,http,inbound",
+		string(RestartsHealthAnnotation): "10,20",
+	}
+	assert.Equal(HealthStatusDegraded, wh.Status("bookinfo", "reviews-v1"))
+
+	wh.Requests.HealthAnnotations[string(RestartsHealthAnnotation)] = "1,3"
+	assert.Equal(HealthStatusFailure, wh.Status("bookinfo", "reviews-v1"))
+
+	// Invalid annotations are ignored
+	wh.Requests.HealthAnnotations = map[string]string{
+		string(LatencyHealthAnnotation):    "p50,200,500",
+		string(ConnectionHealthAnnotation): "5",
+		string(RestartsHealthAnnotation):   "a,b",
+	}
+	assert.Equal(HealthStatusHealthy, wh.Status("bookinfo", "reviews-v1"))
+}
+
+func TestGetRateHealthConfigWithAnnotations(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	rate := GetRateHealthConfigWithAnnotations("bookinfo", "service", "reviews", map[string]string{
+		string(RateHealthAnnotation):       "5XX,10,20,http,inbound;4XX,30,40",
+		string(ConnectionHealthAnnotation): "5,10,inbound;1,2",
+	})
+	assert.Equal([]config.Tolerance{
+		{Code: "5XX", Degraded: 10, Failure: 20, Protocol: "http", Direction: "inbound"},
+		{Code: "4XX", Degraded: 30, Failure: 40},
+	}, rate.Tolerance)
+	assert.Equal([]config.ConnectionTolerance{{Degraded: 5, Failure: 10, Direction: "inbound"}, {Degraded: 1, Failure: 2}}, rate.Connection)
+
+	// The health_config is not modified
+	assert.Equal(conf.HealthConfig.Rate[0].Tolerance, GetRateHealthConfig("bookinfo", "service", "reviews").Tolerance)
+	assert.Same(GetRateHealthConfig("bookinfo", "service", "reviews"), GetRateHealthConfigWithAnnotations("bookinfo", "service", "reviews", map[string]string{}))
+}
+
+func TestRequestHealthErrorRatios(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	requests := NewEmptyRequestHealth()
+	assert.Empty(requests.ErrorRatios("bookinfo", "app", "reviews"))
+
+	requests.Inbound["http"] = map[string]float64{"200": 80, "404": 15, "503": 5}
+	requests.Outbound["grpc"] = map[string]float64{"0": 90, "14": 10}
+	assert.Equal(map[string]map[string]map[string]float64{
+		"inbound":  {"http": {"4XX": 15, "5XX": 5}},
+		"outbound": {"grpc": {"^[1-9]$|^1[0-6]$": 10}},
+	}, requests.ErrorRatios("bookinfo", "app", "reviews"))
+}
+
+func TestRequestHealthStatusWithRateAnnotation(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.AddHealthDefault()
+	config.Set(conf)
+
+	requests := NewEmptyRequestHealth()
+	requests.Inbound["http"] = map[string]float64{"200": 95, "500": 5}
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "service", "reviews"))
+
+	requests.HealthAnnotations = map[string]string{string(RateHealthAnnotation): "5XX,10,20,http,inbound"}
+	assert.Equal(HealthStatusHealthy, requests.Status("bookinfo", "service", "reviews"))
+
+	requests.Inbound["http"] = map[string]float64{"200": 75, "500": 25}
+	assert.Equal(HealthStatusFailure, requests.Status("bookinfo", "service", "reviews"))
+
+	// Invalid annotations are ignored
+	requests.HealthAnnotations = map[string]string{string(RateHealthAnnotation): "5XX,10"}
+	assert.Equal(HealthStatusFailure, requests.Status("bookinfo", "service", "reviews"))
+	requests.Inbound["http"] = map[string]float64{"200": 95, "500": 5}
+	assert.Equal(HealthStatusDegraded, requests.Status("bookinfo", "service", "reviews"))
+}
@@ -0,0 +1,25 @@
+package models
+
+// HealthTimeline is the request health of an app, a service or a workload evaluated at fixed steps of a time range.
+// The replicas of the workloads are not part of the timeline, they have no history.
+type HealthTimeline struct {
+	Namespace    string                `json:"namespace"`
+	Kind         string                `json:"kind"`
+	Name         string                `json:"name"`
+	RateInterval string                `json:"rateInterval"`
+	Step         int64                 `json:"step"`
+	Points       []HealthTimelinePoint `json:"points"`
+}
+
+// HealthTimelinePoint is the request health at a step of a HealthTimeline
+// - Timestamp is the unix time (seconds) of the step.
+// - ErrorRatios are the percentages of requests matching the tolerances of the health_config, by direction,
+//   protocol and tolerance code, i.e. { "inbound": { "http": {"5XX": 12.5} } }.
+// - Inbound//Outbound are the rates of requests by protocol and status_code, as in RequestHealth.
+type HealthTimelinePoint struct {
+	Timestamp   int64                                    `json:"timestamp"`
+	Status      HealthStatus                             `json:"status"`
+	ErrorRatios map[string]map[string]map[string]float64 `json:"errorRatios"`
+	Inbound     map[string]map[string]float64            `json:"inbound"`
+	Outbound    map[string]map[string]float64            `json:"outbound"`
+}
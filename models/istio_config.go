@@ -1,9 +1,13 @@
 package models
 
 import (
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1beta "istio.io/client-go/pkg/apis/security/v1beta1"
+	telemetry_v1alpha1 "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 // IstioConfigList istioConfigList
@@ -26,10 +30,19 @@ type IstioConfigList struct {
 	WorkloadEntries  []networking_v1beta1.WorkloadEntry   `json:"workloadEntries"`
 	WorkloadGroups   []networking_v1beta1.WorkloadGroup   `json:"workloadGroups"`
 
+	K8sGateways   []k8s_networking_v1alpha2.Gateway   `json:"k8sGateways"`
+	K8sHTTPRoutes []k8s_networking_v1alpha2.HTTPRoute `json:"k8sHTTPRoutes"`
+	K8sTCPRoutes  []k8s_networking_v1alpha2.TCPRoute  `json:"k8sTCPRoutes"`
+	K8sGRPCRoutes []unstructured.Unstructured         `json:"k8sGRPCRoutes"`
+
 	AuthorizationPolicies  []security_v1beta.AuthorizationPolicy   `json:"authorizationPolicies"`
 	PeerAuthentications    []security_v1beta.PeerAuthentication    `json:"peerAuthentications"`
 	RequestAuthentications []security_v1beta.RequestAuthentication `json:"requestAuthentications"`
-	IstioValidations       IstioValidations                        `json:"validations"`
+
+	Telemetries []telemetry_v1alpha1.Telemetry   `json:"telemetries"`
+	WasmPlugins []extensions_v1alpha1.WasmPlugin `json:"wasmPlugins"`
+
+	IstioValidations IstioValidations `json:"validations"`
 }
 
 type IstioConfigDetails struct {
@@ -48,6 +61,14 @@ type IstioConfigDetails struct {
 	WorkloadEntry         *networking_v1beta1.WorkloadEntry      `json:"workloadEntry"`
 	WorkloadGroup         *networking_v1beta1.WorkloadGroup      `json:"workloadGroup"`
 
+	K8sGateway   *k8s_networking_v1alpha2.Gateway   `json:"k8sGateway"`
+	K8sHTTPRoute *k8s_networking_v1alpha2.HTTPRoute `json:"k8sHTTPRoute"`
+	K8sTCPRoute  *k8s_networking_v1alpha2.TCPRoute  `json:"k8sTCPRoute"`
+	K8sGRPCRoute *unstructured.Unstructured         `json:"k8sGRPCRoute"`
+
+	Telemetry  *telemetry_v1alpha1.Telemetry   `json:"telemetry"`
+	WasmPlugin *extensions_v1alpha1.WasmPlugin `json:"wasmPlugin"`
+
 	Permissions           ResourcePermissions `json:"permissions"`
 	IstioValidation       *IstioValidation    `json:"validation"`
 	IstioReferences       *IstioReferences    `json:"references"`
@@ -93,6 +114,32 @@ var IstioConfigHelpMessages = map[string][]IstioConfigHelp{
 		{ObjectField: "spec.servers.hosts", Message: "One or more hosts exposed by this gateway. While typically applicable to HTTP services, it can also be used for TCP services using TLS with SNI."},
 		{ObjectField: "spec.servers.tls", Message: "Set of TLS related options that govern the server’s behavior. Use these options to control if all http requests should be redirected to https, and the TLS modes to use."},
 	},
+	"k8sgateways": {
+		{ObjectField: "spec.gatewayClassName", Message: "GatewayClassName used for this Gateway. This is the name of a GatewayClass resource."},
+		{ObjectField: "spec.listeners", Message: "Listeners associated with this Gateway. Listeners define logical endpoints that are bound on this Gateway's addresses. At least one Listener MUST be specified."},
+		{ObjectField: "spec.listeners.hostname", Message: "Hostname specifies the virtual hostname to match for protocol types that define this concept. When unspecified, all hostnames are matched."},
+		{ObjectField: "spec.listeners.allowedRoutes", Message: "AllowedRoutes defines the types of routes that MAY be attached to a Listener and the trusted namespaces where those Route resources MAY be present."},
+		{ObjectField: "spec.addresses", Message: "Addresses requested for this Gateway. This is optional and behavior can depend on the implementation."},
+	},
+	"k8shttproutes": {
+		{ObjectField: "spec.parentRefs", Message: "ParentRefs references the resources (usually Gateways) that a Route wants to be attached to."},
+		{ObjectField: "spec.hostnames", Message: "Hostnames defines a set of hostname that should match against the HTTP Host header to select a HTTPRoute to process the request."},
+		{ObjectField: "spec.rules", Message: "Rules are a list of HTTP matchers, filters and actions."},
+		{ObjectField: "spec.rules.matches", Message: "Matches define conditions used for matching the rule against incoming HTTP requests. Each match is independent, i.e. this rule will be matched if any one of the matches is satisfied."},
+		{ObjectField: "spec.rules.backendRefs", Message: "BackendRefs defines the backend(s) where matching requests should be sent."},
+	},
+	"k8stcproutes": {
+		{ObjectField: "spec.parentRefs", Message: "ParentRefs references the resources (usually Gateways) that a Route wants to be attached to."},
+		{ObjectField: "spec.rules", Message: "Rules are a list of TCP matchers and actions."},
+		{ObjectField: "spec.rules.backendRefs", Message: "BackendRefs defines the backend(s) where matching requests should be sent."},
+	},
+	"k8sgrpcroutes": {
+		{ObjectField: "spec.parentRefs", Message: "ParentRefs references the resources (usually Gateways) that a Route wants to be attached to."},
+		{ObjectField: "spec.hostnames", Message: "Hostnames defines a set of hostnames to match against the GRPC Host header to select a GRPCRoute to process the request."},
+		{ObjectField: "spec.rules", Message: "Rules are a list of GRPC matchers, filters and actions."},
+		{ObjectField: "spec.rules.matches", Message: "Matches define conditions used for matching the rule against incoming gRPC requests, on the method and the headers."},
+		{ObjectField: "spec.rules.backendRefs", Message: "BackendRefs defines the backend(s) where matching requests should be sent."},
+	},
 	"sidecars": {
 		{ObjectField: "spec.workloadSelector", Message: "Criteria used to select the specific set of pods/VMs on which this Sidecar configuration should be applied. If omitted, the Sidecar configuration will be applied to all workload instances in the same namespace."},
 		{ObjectField: "spec.ingress", Message: "Ingress specifies the configuration of the sidecar for processing inbound traffic to the attached workload instance."},
@@ -118,6 +165,13 @@ var IstioConfigHelpMessages = map[string][]IstioConfigHelp{
 		{ObjectField: "spec.workloadSelector", Message: "Applicable only for MESH_INTERNAL services. Only one of endpoints or workloadSelector can be specified."},
 		{ObjectField: "spec.exportTo", Message: "A list of namespaces to which this service is exported. Exporting a service allows it to be used by sidecars, gateways and virtual services defined in other namespaces. This feature provides a mechanism for service owners and mesh administrators to control the visibility of services across namespace boundaries."},
 	},
+	"telemetries": {
+		{ObjectField: "spec.selector", Message: "Optional. The selector decides where to apply the Telemetry policy. If not set, the Telemetry policy will be applied to all workloads in the same namespace as the Telemetry policy."},
+		{ObjectField: "spec.selector.matchLabels", Message: "One or more labels that indicate a specific set of pods/VMs on which a policy should be applied."},
+		{ObjectField: "spec.tracing", Message: "Optional. Tracing configures the tracing behavior for all selected workloads."},
+		{ObjectField: "spec.metrics", Message: "Optional. Metrics configures the metrics behavior for all selected workloads."},
+		{ObjectField: "spec.accessLogging", Message: "Optional. AccessLogging configures the access logging behavior for all selected workloads."},
+	},
 	"virtualservices": {
 		{ObjectField: "spec.hosts", Message: "The destination hosts to which traffic is being sent. Could be a DNS name with wildcard prefix or an IP address. Depending on the platform, short-names can also be used instead of a FQDN (i.e. has no dots in the name)."},
 		{ObjectField: "spec.gateways", Message: "The names of gateways and sidecars that should apply these routes. Gateways in other namespaces may be referred to by <gateway namespace>/<gateway name>; specifying a gateway with no namespace qualifier is the same as specifying the VirtualService’s namespace. To apply the rules to both gateways and sidecars, specify mesh as one of the gateway names."},
@@ -128,6 +182,13 @@ var IstioConfigHelpMessages = map[string][]IstioConfigHelp{
 		{ObjectField: "spec.http.route.destination.host", Message: "The name of a service from the service registry. Service names are looked up from the platform’s service registry (e.g., Kubernetes services, Consul services, etc.) and from the hosts declared by ServiceEntry."},
 		{ObjectField: "spec.http.route.destination.subset", Message: "The name of a subset within the service. Applicable only to services within the mesh. The subset must be defined in a corresponding DestinationRule."},
 	},
+	"wasmplugins": {
+		{ObjectField: "spec.selector", Message: "Criteria used to select the specific set of pods/VMs on which this plugin configuration should be applied. If omitted, this configuration will be applied to all workload instances in the same namespace."},
+		{ObjectField: "spec.url", Message: "URL of a Wasm module or OCI container. If no scheme is present, defaults to oci://, referencing an OCI image."},
+		{ObjectField: "spec.phase", Message: "Determines where in the filter chain this WasmPlugin is to be injected."},
+		{ObjectField: "spec.priority", Message: "Determines ordering of WasmPlugins in the same phase. When multiple WasmPlugins are applied to the same workload in the same phase, they will be applied by priority, in descending order."},
+		{ObjectField: "spec.pluginConfig", Message: "The configuration that will be passed on to the plugin."},
+	},
 	"workloadentries": {
 		{ObjectField: "spec.address", Message: "Address associated with the network endpoint without the port."},
 		{ObjectField: "spec.ports", Message: "Set of ports associated with the endpoint."},
@@ -217,6 +278,30 @@ func (configList IstioConfigList) FilterIstioConfigs(nss []string) *IstioConfigs
 			}
 		}
 
+		for _, gw := range configList.K8sGateways {
+			if gw.Namespace == ns {
+				filtered[ns].K8sGateways = append(filtered[ns].K8sGateways, gw)
+			}
+		}
+
+		for _, route := range configList.K8sHTTPRoutes {
+			if route.Namespace == ns {
+				filtered[ns].K8sHTTPRoutes = append(filtered[ns].K8sHTTPRoutes, route)
+			}
+		}
+
+		for _, route := range configList.K8sTCPRoutes {
+			if route.Namespace == ns {
+				filtered[ns].K8sTCPRoutes = append(filtered[ns].K8sTCPRoutes, route)
+			}
+		}
+
+		for _, route := range configList.K8sGRPCRoutes {
+			if route.GetNamespace() == ns {
+				filtered[ns].K8sGRPCRoutes = append(filtered[ns].K8sGRPCRoutes, route)
+			}
+		}
+
 		for _, ap := range configList.AuthorizationPolicies {
 			if ap.Namespace == ns {
 				filtered[ns].AuthorizationPolicies = append(filtered[ns].AuthorizationPolicies, ap)
@@ -234,6 +319,18 @@ func (configList IstioConfigList) FilterIstioConfigs(nss []string) *IstioConfigs
 				filtered[ns].RequestAuthentications = append(filtered[ns].RequestAuthentications, ra)
 			}
 		}
+
+		for _, tm := range configList.Telemetries {
+			if tm.Namespace == ns {
+				filtered[ns].Telemetries = append(filtered[ns].Telemetries, tm)
+			}
+		}
+
+		for _, wp := range configList.WasmPlugins {
+			if wp.Namespace == ns {
+				filtered[ns].WasmPlugins = append(filtered[ns].WasmPlugins, wp)
+			}
+		}
 	}
 	return &filtered
 }
@@ -0,0 +1,64 @@
+package models
+
+// Actions on the objects of an imported Istio config bundle
+const (
+	IstioConfigImportCreate = "create"
+	IstioConfigImportUpdate = "update"
+)
+
+// Statuses of the objects of an imported Istio config bundle
+const (
+	// The object passed the dry-run and the validations, but it was not applied because the import was a dry-run
+	IstioConfigImportValid = "valid"
+	// The object failed the dry-run or it would introduce validation errors
+	IstioConfigImportInvalid = "invalid"
+	// The object was not applied because of the failures of other objects
+	IstioConfigImportSkipped = "skipped"
+	IstioConfigImportApplied = "applied"
+	IstioConfigImportFailed  = "failed"
+	// The object was applied and then restored because of the failure of another object
+	IstioConfigImportRolledBack = "rolledBack"
+	// The object was applied but could not be restored after the failure of another object
+	IstioConfigImportRollbackFailed = "rollbackFailed"
+)
+
+// IstioConfigImportResult is the result of the import of a bundle of Istio objects
+//
+// swagger:model IstioConfigImportResult
+type IstioConfigImportResult struct {
+	// True if all the objects were applied
+	// required: true
+	Applied bool `json:"applied"`
+
+	// True if the import was a dry-run
+	// required: true
+	DryRun bool `json:"dryRun"`
+
+	// Results of the objects of the bundle, in the bundle order
+	Objects []IstioConfigImportObjectResult `json:"objects"`
+
+	// Validation findings the bundle would introduce, on its objects or on the objects they affect
+	NewFindings []ValidationFinding `json:"newFindings"`
+}
+
+// IstioConfigImportObjectResult is the result of the import of an object of a bundle
+type IstioConfigImportObjectResult struct {
+	Namespace  string `json:"namespace"`
+	ObjectType string `json:"objectType"`
+	Name       string `json:"name"`
+
+	// Change applied to the object: create or update
+	// example: create
+	Action string `json:"action"`
+
+	// Import status of the object: valid, invalid, skipped, applied, failed, rolledBack or rollbackFailed
+	// required: true
+	// example: applied
+	Status string `json:"status"`
+
+	// Error of the dry-run, the application or the rollback of the object
+	Error string `json:"error,omitempty"`
+
+	// Validation findings on the object, as it would be after the import
+	Findings []ValidationFinding `json:"findings"`
+}
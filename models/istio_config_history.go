@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Operations recorded in the history of an Istio object
+const (
+	IstioConfigRevisionDelete = "delete"
+	IstioConfigRevisionUpdate = "update"
+)
+
+// IstioConfigRevision is the state of an Istio object before it was updated or deleted through Kiali
+//
+// swagger:model IstioConfigRevision
+type IstioConfigRevision struct {
+	// Number of the revision, increasing with every change of the object
+	// required: true
+	// example: 3
+	Revision int `json:"revision"`
+
+	// Time of the change
+	// required: true
+	Timestamp time.Time `json:"timestamp"`
+
+	// Change performed on the object: update or delete
+	// required: true
+	// example: update
+	Operation string `json:"operation"`
+
+	Namespace  string `json:"namespace"`
+	ObjectType string `json:"objectType"`
+	Name       string `json:"name"`
+
+	// The object before the change, without the fields set by the API server. Not set when listing the revisions.
+	Object json.RawMessage `json:"object,omitempty"`
+}
@@ -0,0 +1,76 @@
+package models
+
+import "sort"
+
+// IstioConfigPreview is the result of a dry-run create or update of an Istio object: the object as it would be
+// stored, the changes on the current object and the validation findings the change would introduce or resolve.
+//
+// swagger:model IstioConfigPreview
+type IstioConfigPreview struct {
+	// The object as it would be stored
+	Object IstioConfigDetails `json:"object"`
+
+	// Changes of the object, empty on creations
+	Diff []ObjectChange `json:"diff"`
+
+	// Validation findings present after the change only, on this object or on the objects it affects
+	NewFindings []ValidationFinding `json:"newFindings"`
+
+	// Validation findings present before the change only
+	ResolvedFindings []ValidationFinding `json:"resolvedFindings"`
+
+	// Validations of the object after the change
+	Validations IstioValidations `json:"validations"`
+}
+
+// Operations of an ObjectChange, named as the JSON patch operations
+const (
+	ObjectChangeAdd     = "add"
+	ObjectChangeRemove  = "remove"
+	ObjectChangeReplace = "replace"
+)
+
+// ObjectChange is a change of a field of an object
+type ObjectChange struct {
+	// Operation of the change: add, remove or replace
+	// required: true
+	// example: replace
+	Op string `json:"op"`
+
+	// JSON pointer of the changed field
+	// required: true
+	// example: /spec/http/0/route/0/weight
+	Path string `json:"path"`
+
+	// Value of the field before the change, unset for additions
+	From interface{} `json:"from,omitempty"`
+
+	// Value of the field after the change, unset for removals
+	To interface{} `json:"to,omitempty"`
+}
+
+// ValidationFinding is a check found on an Istio object
+type ValidationFinding struct {
+	IstioValidationKey
+	IstioCheck
+}
+
+// SortValidationFindings sorts the findings by namespace, object type, name, path and code
+func SortValidationFindings(findings []ValidationFinding) {
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ObjectType != b.ObjectType {
+			return a.ObjectType < b.ObjectType
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Code < b.Code
+	})
+}
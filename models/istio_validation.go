@@ -31,6 +31,10 @@ type IstioValidationSummary struct {
 	// required: true
 	// example: 4
 	Warnings int `json:"warnings"`
+	// Number of validations suppressed, not counted as errors or warnings
+	// required: true
+	// example: 1
+	Suppressed int `json:"suppressed"`
 }
 
 // ValidationSummaries holds a map of IstioValidationSummary per namespace
@@ -85,6 +89,14 @@ type IstioCheck struct {
 	// String that describes where in the yaml file is the check located
 	// example: spec/http[0]/route
 	Path string `json:"path"`
+
+	// Indicates that the check was accepted by a suppression rule, it isn't counted as an error or a warning
+	// example: true
+	Suppressed bool `json:"suppressed,omitempty"`
+
+	// Justification of the suppression rule
+	// example: Legacy namespace, migration planned
+	SuppressionReason string `json:"suppressionReason,omitempty"`
 }
 
 type SeverityLevel string
@@ -99,6 +111,7 @@ var ObjectTypeSingular = map[string]string{
 	"gateways":               "gateway",
 	"virtualservices":        "virtualservice",
 	"destinationrules":       "destinationrule",
+	"envoyfilters":           "envoyfilter",
 	"serviceentries":         "serviceentry",
 	"rules":                  "rule",
 	"quotaspecs":             "quotaspec",
@@ -112,6 +125,14 @@ var ObjectTypeSingular = map[string]string{
 	"peerauthentications":    "peerauthentication",
 	"requestauthentications": "requestauthentication",
 	"workloads":              "workload",
+	"k8sgateways":            "k8sgateway",
+	"k8shttproutes":          "k8shttproute",
+	"k8stcproutes":           "k8stcproute",
+	"k8sgrpcroutes":          "k8sgrpcroute",
+	"telemetries":            "telemetry",
+	"wasmplugins":            "wasmplugin",
+	"workloadentries":        "workloadentry",
+	"workloadgroups":         "workloadgroup",
 }
 
 var checkDescriptors = map[string]IstioCheck{
@@ -185,6 +206,41 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "This subset has not labels",
 		Severity: WarningSeverity,
 	},
+	"envoyfilter.applyto.invalid": {
+		Code:     "KIA1501",
+		Message:  "Patch has a missing or invalid applyTo",
+		Severity: ErrorSeverity,
+	},
+	"envoyfilter.applyto.match": {
+		Code:     "KIA1502",
+		Message:  "Match object type is not valid for the applyTo of the patch",
+		Severity: ErrorSeverity,
+	},
+	"envoyfilter.proxyversion.invalid": {
+		Code:     "KIA1503",
+		Message:  "proxyVersion is not a valid regular expression",
+		Severity: ErrorSeverity,
+	},
+	"envoyfilter.proxyversion.nomatch": {
+		Code:     "KIA1504",
+		Message:  "proxyVersion doesn't match the version of the running proxies",
+		Severity: WarningSeverity,
+	},
+	"envoyfilter.context.nomatch": {
+		Code:     "KIA1505",
+		Message:  "Patch context doesn't match any proxy of the workloads selected by the filter",
+		Severity: WarningSeverity,
+	},
+	"envoyfilter.priority.conflict": {
+		Code:     "KIA1506",
+		Message:  "More than one EnvoyFilter with the same priority patches the same workload",
+		Severity: WarningSeverity,
+	},
+	"envoyfilter.global.meshwide": {
+		Code:     "KIA1507",
+		Message:  "EnvoyFilter in the root namespace without workloadSelector applies to every proxy of the mesh",
+		Severity: WarningSeverity,
+	},
 	"gateways.multimatch": {
 		Code:     "KIA0301",
 		Message:  "More than one Gateway for the same host port combination",
@@ -195,6 +251,16 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "No matching workload found for gateway selector in this namespace",
 		Severity: WarningSeverity,
 	},
+	"gateways.tlsmode.precedence": {
+		Code:     "KIA0304",
+		Message:  "Server takes precedence over a newer Gateway binding the same host and port with a different TLS mode",
+		Severity: WarningSeverity,
+	},
+	"gateways.tlsmode.shadowed": {
+		Code:     "KIA0303",
+		Message:  "Server is ignored: an older Gateway binds the same host and port with a different TLS mode",
+		Severity: ErrorSeverity,
+	},
 	"generic.exportto.namespacenotfound": {
 		Code:     "KIA0005",
 		Message:  "No matching namespace found or namespace is not accessible",
@@ -215,6 +281,31 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "No matching workload found for the selector in this namespace",
 		Severity: WarningSeverity,
 	},
+	"k8sgateways.multimatch": {
+		Code:     "KIA1301",
+		Message:  "More than one K8s Gateway listener for the same host port combination",
+		Severity: WarningSeverity,
+	},
+	"k8shttproutes.nok8sgateway": {
+		Code:     "KIA1401",
+		Message:  "HTTPRoute is pointing to a non-existent K8s gateway",
+		Severity: ErrorSeverity,
+	},
+	"k8shttproutes.nok8sgateway.notallowed": {
+		Code:     "KIA1402",
+		Message:  "HTTPRoute is not allowed by any listener of the referenced K8s gateway",
+		Severity: ErrorSeverity,
+	},
+	"k8shttproutes.nohost.namenotfound": {
+		Code:     "KIA1403",
+		Message:  "BackendRef on rule doesn't have a valid service (Service name not found)",
+		Severity: ErrorSeverity,
+	},
+	"k8shttproutes.nohost.portnotfound": {
+		Code:     "KIA1404",
+		Message:  "BackendRef on rule points to a port not exposed by the Service",
+		Severity: ErrorSeverity,
+	},
 	"peerauthentication.mtls.destinationrulemissing": {
 		Code:     "KIA0401",
 		Message:  "Mesh-wide Destination Rule enabling mTLS is missing",
@@ -305,6 +396,16 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "This host subset combination is already referenced in another route destination",
 		Severity: WarningSeverity,
 	},
+	"virtualservices.routing.precedence": {
+		Code:     "KIA1110",
+		Message:  "Routes for this host take precedence over a VirtualService from another namespace",
+		Severity: WarningSeverity,
+	},
+	"virtualservices.routing.shadowed": {
+		Code:     "KIA1109",
+		Message:  "Routes for this host are shadowed by a VirtualService from another namespace",
+		Severity: WarningSeverity,
+	},
 	"virtualservices.singlehost": {
 		Code:     "KIA1106",
 		Message:  "More than one Virtual Service for same host",
@@ -450,7 +551,9 @@ func (iv IstioValidations) SummarizeValidation(ns string) *IstioValidationSummar
 
 func (summary *IstioValidationSummary) mergeSummaries(cs []*IstioCheck) {
 	for _, c := range cs {
-		if c.Severity == ErrorSeverity {
+		if c.Suppressed {
+			summary.Suppressed += 1
+		} else if c.Severity == ErrorSeverity {
 			summary.Errors += 1
 		} else if c.Severity == WarningSeverity {
 			summary.Warnings += 1
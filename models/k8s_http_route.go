@@ -0,0 +1,62 @@
+package models
+
+import (
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// IsK8sHTTPRouteValidBackend returns true if any of the HTTPRoute rules sends traffic to the service
+func IsK8sHTTPRouteValidBackend(route *k8s_networking_v1alpha2.HTTPRoute, namespace string, serviceName string) bool {
+	if route == nil || serviceName == "" {
+		return false
+	}
+	for _, rule := range route.Spec.Rules {
+		for _, backend := range rule.BackendRefs {
+			if isK8sBackendRefService(backend.BackendRef, route.Namespace, namespace, serviceName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasK8sHTTPRouteTrafficShifting determines if the spec has http traffic shifting set.
+// As with VirtualServices, rules with multiple backends are assumed to shift traffic regardless of weights.
+func HasK8sHTTPRouteTrafficShifting(route *k8s_networking_v1alpha2.HTTPRoute) bool {
+	if route == nil {
+		return false
+	}
+	for _, rule := range route.Spec.Rules {
+		if len(rule.BackendRefs) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasK8sHTTPRouteRequestRouting determines if the spec has rules matching on the request
+func HasK8sHTTPRouteRequestRouting(route *k8s_networking_v1alpha2.HTTPRoute) bool {
+	if route == nil {
+		return false
+	}
+	for _, rule := range route.Spec.Rules {
+		if len(rule.Matches) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isK8sBackendRefService(backend k8s_networking_v1alpha2.BackendRef, routeNamespace, namespace, serviceName string) bool {
+	// Kind defaults to Service in the core group
+	if backend.Kind != nil && *backend.Kind != "Service" {
+		return false
+	}
+	if backend.Group != nil && *backend.Group != "" {
+		return false
+	}
+	backendNamespace := routeNamespace
+	if backend.Namespace != nil {
+		backendNamespace = string(*backend.Namespace)
+	}
+	return backendNamespace == namespace && string(backend.Name) == serviceName
+}
@@ -0,0 +1,55 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestK8sHTTPRouteBackends(t *testing.T) {
+	assert := assert.New(t)
+
+	routeYAML := []byte(`
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: HTTPRoute
+metadata:
+  name: reviews
+  namespace: bookinfo
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /reviews
+    backendRefs:
+    - name: reviews-v1
+      port: 9080
+      weight: 90
+    - name: reviews-v2
+      namespace: other
+      port: 9080
+      weight: 10
+`)
+	route := k8s_networking_v1alpha2.HTTPRoute{}
+	assert.NoError(yaml.Unmarshal(routeYAML, &route))
+
+	assert.True(models.IsK8sHTTPRouteValidBackend(&route, "bookinfo", "reviews-v1"))
+	assert.True(models.IsK8sHTTPRouteValidBackend(&route, "other", "reviews-v2"))
+	assert.False(models.IsK8sHTTPRouteValidBackend(&route, "bookinfo", "reviews-v2"))
+	assert.False(models.IsK8sHTTPRouteValidBackend(&route, "bookinfo", ""))
+	assert.True(models.HasK8sHTTPRouteTrafficShifting(&route))
+	assert.True(models.HasK8sHTTPRouteRequestRouting(&route))
+
+	route.Spec.Rules[0].BackendRefs = route.Spec.Rules[0].BackendRefs[:1]
+	route.Spec.Rules[0].Matches = nil
+	assert.False(models.HasK8sHTTPRouteTrafficShifting(&route))
+	assert.False(models.HasK8sHTTPRouteRequestRouting(&route))
+	assert.False(models.HasK8sHTTPRouteTrafficShifting(nil))
+}
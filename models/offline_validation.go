@@ -0,0 +1,295 @@
+package models
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// OfflineValidationReport is the result of the validation of a set of manifests, without cluster: the validated
+// objects and their findings.
+//
+// swagger:model OfflineValidationReport
+type OfflineValidationReport struct {
+	// Number of validated objects and of their errors, warnings and suppressed findings
+	// required: true
+	Summary IstioValidationSummary `json:"summary"`
+
+	// Validated objects, sorted by namespace, type and name
+	// required: true
+	Objects []OfflineValidationObject `json:"objects"`
+
+	// Findings of all the objects, sorted by namespace, type, name, path and code
+	// required: true
+	Findings []ValidationFinding `json:"findings"`
+}
+
+// OfflineValidationObject is an object of the validated manifests
+type OfflineValidationObject struct {
+	IstioValidationKey
+
+	// Manifest file of the object
+	// example: bookinfo/reviews-vs.yaml
+	Source string `json:"source"`
+
+	// Validity of the object: in case of warnings or suppressed errors, validity remains as true
+	// required: true
+	// example: false
+	Valid bool `json:"valid"`
+
+	// Objects, services and workloads related to the object
+	References *IstioReferences `json:"references,omitempty"`
+}
+
+// NewOfflineValidationReport builds the report of the validated objects, given by key with their manifest file
+func NewOfflineValidationReport(sources map[IstioValidationKey]string, validations IstioValidations, references IstioReferencesMap) OfflineValidationReport {
+	report := OfflineValidationReport{Objects: []OfflineValidationObject{}, Findings: []ValidationFinding{}}
+	for key, source := range sources {
+		object := OfflineValidationObject{IstioValidationKey: key, Source: source, Valid: true}
+		if validation, ok := validations[key]; ok {
+			object.Valid = validation.Valid
+			for _, check := range validation.Checks {
+				report.Findings = append(report.Findings, ValidationFinding{IstioValidationKey: key, IstioCheck: *check})
+			}
+			report.Summary.mergeSummaries(validation.Checks)
+		}
+		object.References = references[IstioReferenceKey{ObjectType: key.ObjectType, Name: key.Name, Namespace: key.Namespace}]
+		report.Objects = append(report.Objects, object)
+	}
+	report.Summary.ObjectCount = len(report.Objects)
+
+	sort.Slice(report.Objects, func(i, j int) bool {
+		a, b := report.Objects[i], report.Objects[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ObjectType != b.ObjectType {
+			return a.ObjectType < b.ObjectType
+		}
+		return a.Name < b.Name
+	})
+	SortValidationFindings(report.Findings)
+	return report
+}
+
+// Formats of an OfflineValidationReport
+const (
+	OfflineValidationJSON  = "json"
+	OfflineValidationJUnit = "junit"
+	OfflineValidationSARIF = "sarif"
+)
+
+// Render returns the report in the given format: json, sarif or junit
+func (r OfflineValidationReport) Render(format string) ([]byte, error) {
+	switch format {
+	case OfflineValidationJSON, "":
+		return json.MarshalIndent(r, "", "  ")
+	case OfflineValidationSARIF:
+		return r.SARIF()
+	case OfflineValidationJUnit:
+		return r.JUnit()
+	}
+	return nil, fmt.Errorf("unsupported report format: %s", format)
+}
+
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification"`
+}
+
+// SARIF returns the findings of the report as a SARIF log, with one rule per validation code
+func (r OfflineValidationReport) SARIF() ([]byte, error) {
+	sources := r.sources()
+	rules := []sarifRule{}
+	ruleIDs := map[string]bool{}
+	results := []sarifResult{}
+	for _, f := range r.Findings {
+		if !ruleIDs[f.Code] {
+			ruleIDs[f.Code] = true
+			rules = append(rules, sarifRule{ID: f.Code, ShortDescription: sarifMessage{Text: f.Message}, DefaultConfiguration: sarifConfiguration{Level: sarifLevel(f.Severity)}})
+		}
+		location := sarifLocation{LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: findingLocation(f), Kind: "resource"}}}
+		if source := sources[f.IstioValidationKey]; source != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: source}}
+		}
+		result := sarifResult{RuleID: f.Code, Level: sarifLevel(f.Severity), Message: sarifMessage{Text: f.Message}, Locations: []sarifLocation{location}}
+		if f.Suppressed {
+			result.Suppressions = []sarifSuppression{{Kind: "external", Justification: f.SuppressionReason}}
+		}
+		results = append(results, result)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	return json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: "kiali", InformationURI: "https://kiali.io", Rules: rules}},
+			Results: results,
+		}},
+	}, "", "  ")
+}
+
+func sarifLevel(severity SeverityLevel) string {
+	switch severity {
+	case ErrorSeverity:
+		return "error"
+	case WarningSeverity:
+		return "warning"
+	}
+	return "note"
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnit returns the report as a JUnit XML report, with one test suite per namespace and one test case per object.
+// An object fails when it has unsuppressed errors; the warnings and the suppressed findings are in the test output.
+func (r OfflineValidationReport) JUnit() ([]byte, error) {
+	findings := map[IstioValidationKey][]ValidationFinding{}
+	for _, f := range r.Findings {
+		findings[f.IstioValidationKey] = append(findings[f.IstioValidationKey], f)
+	}
+
+	report := junitTestSuites{Name: "kiali", TestSuites: []junitTestSuite{}}
+	for _, o := range r.Objects {
+		if len(report.TestSuites) == 0 || report.TestSuites[len(report.TestSuites)-1].Name != o.Namespace {
+			report.TestSuites = append(report.TestSuites, junitTestSuite{Name: o.Namespace})
+		}
+		suite := &report.TestSuites[len(report.TestSuites)-1]
+
+		testCase := junitTestCase{ClassName: o.Namespace + "." + o.ObjectType, Name: o.Name, File: o.Source}
+		errors, output := []string{}, []string{}
+		for _, f := range findings[o.IstioValidationKey] {
+			line := fmt.Sprintf("%s %s: %s [%s]", f.Code, f.Severity, f.Message, f.Path)
+			if f.Suppressed {
+				output = append(output, line+" suppressed: "+f.SuppressionReason)
+			} else if f.Severity == ErrorSeverity {
+				errors = append(errors, line)
+			} else {
+				output = append(output, line)
+			}
+		}
+		if len(errors) > 0 {
+			testCase.Failure = &junitFailure{Message: fmt.Sprintf("Validation errors: %d", len(errors)), Type: string(ErrorSeverity), Text: strings.Join(errors, "\n")}
+			suite.Failures++
+			report.Failures++
+		}
+		testCase.SystemOut = strings.Join(output, "\n")
+		suite.TestCases = append(suite.TestCases, testCase)
+		suite.Tests++
+		report.Tests++
+	}
+
+	out, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+func (r OfflineValidationReport) sources() map[IstioValidationKey]string {
+	sources := make(map[IstioValidationKey]string, len(r.Objects))
+	for _, o := range r.Objects {
+		sources[o.IstioValidationKey] = o.Source
+	}
+	return sources
+}
+
+func findingLocation(f ValidationFinding) string {
+	location := f.Namespace + "/" + f.ObjectType + "/" + f.Name
+	if f.Path != "" {
+		location += "/" + f.Path
+	}
+	return location
+}
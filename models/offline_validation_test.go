@@ -0,0 +1,129 @@
+package models
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func offlineValidationReport() OfflineValidationReport {
+	sources := map[IstioValidationKey]string{
+		BuildKey("virtualservice", "reviews", "bookinfo"):  "bookinfo/reviews.yaml",
+		BuildKey("destinationrule", "reviews", "bookinfo"): "bookinfo/reviews.yaml",
+		BuildKey("gateway", "ingress", "istio-system"):     "gateways.yaml",
+	}
+	validations := IstioValidations{
+		BuildKey("virtualservice", "reviews", "bookinfo"): &IstioValidation{
+			Name:       "reviews",
+			ObjectType: "virtualservice",
+			Valid:      false,
+			Checks: []*IstioCheck{
+				{Code: "KIA1107", Severity: WarningSeverity, Message: "Subset not found", Path: "spec/http[0]/route[0]/destination"},
+				{Code: "KIA1101", Severity: ErrorSeverity, Message: "Host not found", Path: "spec/http[0]/route[0]/destination/host"},
+			},
+		},
+		BuildKey("gateway", "ingress", "istio-system"): &IstioValidation{
+			Name:       "ingress",
+			ObjectType: "gateway",
+			Valid:      true,
+			Checks: []*IstioCheck{
+				{Code: "KIA0302", Severity: ErrorSeverity, Message: "No matching workload found for gateway selector", Path: "spec/selector", Suppressed: true, SuppressionReason: "Gateway deployed by another team"},
+			},
+		},
+	}
+	references := IstioReferencesMap{
+		IstioReferenceKey{ObjectType: "destinationrule", Name: "reviews", Namespace: "bookinfo"}: &IstioReferences{
+			ObjectReferences: []IstioReference{{ObjectType: "virtualservice", Name: "reviews", Namespace: "bookinfo"}},
+		},
+	}
+	return NewOfflineValidationReport(sources, validations, references)
+}
+
+func TestNewOfflineValidationReport(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	report := offlineValidationReport()
+	assert.Equal(IstioValidationSummary{Errors: 1, Warnings: 1, Suppressed: 1, ObjectCount: 3}, report.Summary)
+
+	require.Len(report.Objects, 3)
+	assert.Equal(BuildKey("destinationrule", "reviews", "bookinfo"), report.Objects[0].IstioValidationKey)
+	assert.True(report.Objects[0].Valid)
+	require.NotNil(report.Objects[0].References)
+	assert.Len(report.Objects[0].References.ObjectReferences, 1)
+	assert.Equal(BuildKey("virtualservice", "reviews", "bookinfo"), report.Objects[1].IstioValidationKey)
+	assert.False(report.Objects[1].Valid)
+	assert.Nil(report.Objects[1].References)
+	assert.Equal(BuildKey("gateway", "ingress", "istio-system"), report.Objects[2].IstioValidationKey)
+
+	require.Len(report.Findings, 3)
+	assert.Equal("KIA1107", report.Findings[0].Code)
+	assert.Equal("KIA1101", report.Findings[1].Code)
+	assert.Equal("KIA0302", report.Findings[2].Code)
+
+	_, err := report.Render("html")
+	assert.Error(err)
+}
+
+func TestOfflineValidationReportSARIF(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	out, err := offlineValidationReport().Render(OfflineValidationSARIF)
+	require.NoError(err)
+
+	var log sarifLog
+	require.NoError(json.Unmarshal(out, &log))
+	assert.Equal("2.1.0", log.Version)
+	require.Len(log.Runs, 1)
+
+	rules := log.Runs[0].Tool.Driver.Rules
+	require.Len(rules, 3)
+	assert.Equal("KIA0302", rules[0].ID)
+	assert.Equal("error", rules[0].DefaultConfiguration.Level)
+	assert.Equal("KIA1107", rules[2].ID)
+	assert.Equal("warning", rules[2].DefaultConfiguration.Level)
+
+	results := log.Runs[0].Results
+	require.Len(results, 3)
+	assert.Equal("KIA1101", results[1].RuleID)
+	assert.Equal("error", results[1].Level)
+	assert.Equal("bookinfo/reviews.yaml", results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal("bookinfo/virtualservice/reviews/spec/http[0]/route[0]/destination/host", results[1].Locations[0].LogicalLocations[0].FullyQualifiedName)
+	assert.Empty(results[1].Suppressions)
+	assert.Equal([]sarifSuppression{{Kind: "external", Justification: "Gateway deployed by another team"}}, results[2].Suppressions)
+}
+
+func TestOfflineValidationReportJUnit(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	out, err := offlineValidationReport().Render(OfflineValidationJUnit)
+	require.NoError(err)
+
+	var report junitTestSuites
+	require.NoError(xml.Unmarshal(out, &report))
+	assert.Equal(3, report.Tests)
+	assert.Equal(1, report.Failures)
+	require.Len(report.TestSuites, 2)
+
+	bookinfo := report.TestSuites[0]
+	assert.Equal("bookinfo", bookinfo.Name)
+	assert.Equal(2, bookinfo.Tests)
+	assert.Equal(1, bookinfo.Failures)
+	assert.Nil(bookinfo.TestCases[0].Failure)
+	vs := bookinfo.TestCases[1]
+	assert.Equal("bookinfo.virtualservice", vs.ClassName)
+	assert.Equal("reviews", vs.Name)
+	require.NotNil(vs.Failure)
+	assert.Equal("KIA1101 error: Host not found [spec/http[0]/route[0]/destination/host]", vs.Failure.Text)
+	assert.Equal("KIA1107 warning: Subset not found [spec/http[0]/route[0]/destination]", vs.SystemOut)
+
+	// Suppressed errors don't fail
+	gateway := report.TestSuites[1].TestCases[0]
+	assert.Nil(gateway.Failure)
+	assert.Contains(gateway.SystemOut, "suppressed: Gateway deployed by another team")
+}
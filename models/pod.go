@@ -29,6 +29,8 @@ type Pod struct {
 	Annotations         map[string]string `json:"annotations"`
 	ProxyStatus         *ProxyStatus      `json:"proxyStatus"`
 	ServiceAccountName  string            `json:"serviceAccountName"`
+	// Restarts is the number of restarts of the containers of the pod
+	Restarts int32 `json:"restarts"`
 }
 
 // Reference holds some information on the pod creator
@@ -123,6 +125,9 @@ func (pod *Pod) Parse(p *core_v1.Pod) {
 	_, pod.AppLabel = p.Labels[conf.IstioLabels.AppLabelName]
 	_, pod.VersionLabel = p.Labels[conf.IstioLabels.VersionLabelName]
 	pod.ServiceAccountName = p.Spec.ServiceAccountName
+	for _, cs := range p.Status.ContainerStatuses {
+		pod.Restarts += cs.RestartCount
+	}
 }
 
 func isIstioProxy(pod *core_v1.Pod, container *core_v1.Container, conf *config.Config) bool {
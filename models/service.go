@@ -86,6 +86,7 @@ type Service struct {
 	Ports             Ports             `json:"ports"`
 	ExternalName      string            `json:"externalName"`
 	HealthAnnotations map[string]string `json:"healthAnnotations"`
+	SLOAnnotations    map[string]string `json:"sloAnnotations"`
 	AdditionalDetails []AdditionalItem  `json:"additionalDetails"`
 }
 
@@ -122,6 +123,7 @@ func (s *Service) Parse(service *core_v1.Service) {
 		s.CreatedAt = formatTime(service.CreationTimestamp.Time)
 		s.ResourceVersion = service.ResourceVersion
 		s.HealthAnnotations = GetHealthAnnotation(service.Annotations, GetHealthConfigAnnotation())
+		s.SLOAnnotations = GetSLOAnnotation(service.Annotations)
 		s.AdditionalDetails = GetAdditionalDetails(config.Get(), service.ObjectMeta.Annotations)
 		(&s.Ports).Parse(service.Spec.Ports)
 	}
@@ -134,6 +136,7 @@ func (s *Service) ParseRegistryService(service *kubernetes.RegistryService) {
 		s.Labels = service.Attributes.Labels
 		s.Selectors = service.Attributes.LabelSelectors
 		s.HealthAnnotations = map[string]string{}
+		s.SLOAnnotations = map[string]string{}
 		// It will expect "External" or "Federation"
 		s.Type = service.Attributes.ServiceRegistry
 		s.Ports.ParseServiceRegistryPorts(service)
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+)
+
+const (
+	// SLOAnnotation declares the objectives of a service or a workload, overriding the objectives of the configuration:
+	// "type,target,window[,latencyThreshold];..."  i.e. "availability,99.9,30d;latency,99,30d,250"
+	SLOAnnotation = "slo.kiali.io/objectives"
+
+	SLOTypeAvailability = "availability"
+	SLOTypeLatency      = "latency"
+
+	SLOStatusMet      = "Met"
+	SLOStatusAtRisk   = "AtRisk"
+	SLOStatusBreached = "Breached"
+	SLOStatusNA       = "NA"
+)
+
+// SLOList holds the status of the objectives of a service or a workload
+type SLOList struct {
+	Namespace string      `json:"namespace"`
+	Kind      string      `json:"kind"`
+	Name      string      `json:"name"`
+	SLOs      []SLOStatus `json:"slos"`
+}
+
+// SLOStatus is the current compliance of an objective
+// - Compliance is the percentage of good requests over the objective window.
+// - ErrorBudgetRemaining is the percentage of the error budget not consumed over the objective window, it is negative
+//   when the objective is breached.
+// - Status is NA when there was no traffic in the objective window, Breached when the compliance is below the target,
+//   AtRisk when a burn rate alerts and Met otherwise.
+type SLOStatus struct {
+	config.SLO
+	Compliance           float64    `json:"compliance"`
+	ErrorBudgetRemaining float64    `json:"errorBudgetRemaining"`
+	BurnRates            []BurnRate `json:"burnRates"`
+	Status               string     `json:"status"`
+}
+
+// BurnRate is the rate at which the error budget is consumed in the long and short windows of a config.BurnRateWindow,
+// relative to the rate that would consume it exactly in the objective window. A burn rate is -1 without traffic.
+type BurnRate struct {
+	config.BurnRateWindow
+	LongBurnRate  float64 `json:"longBurnRate"`
+	ShortBurnRate float64 `json:"shortBurnRate"`
+	Alerting      bool    `json:"alerting"`
+}
+
+// GetSLOAnnotation filters the annotations used for the objectives
+func GetSLOAnnotation(annotations map[string]string) map[string]string {
+	filtered := make(map[string]string)
+	if slo, ok := annotations[SLOAnnotation]; ok {
+		filtered[SLOAnnotation] = slo
+	}
+	return filtered
+}
+
+// GetSLOs returns the objectives of an entity: the ones of its annotation when present and valid, or all the
+// objectives of the configuration matching its namespace, kind and name
+func GetSLOs(namespace, kind, name string, annotations map[string]string) []config.SLO {
+	if value, ok := annotations[SLOAnnotation]; ok {
+		slos, err := parseSLOAnnotation(value)
+		if err == nil {
+			return slos
+		}
+		log.Debugf("Ignoring invalid %s annotation of %s %s.%s: %v", SLOAnnotation, kind, namespace, name, err)
+	}
+	slos := []config.SLO{}
+	for _, slo := range config.Get().SLO.Objectives {
+		if matchHealthRegexp(slo.Namespace, namespace) && matchHealthRegexp(slo.Kind, kind) && matchHealthRegexp(slo.EntityName, name) {
+			if err := ValidateSLO(slo); err != nil {
+				log.Debugf("Ignoring invalid objective [%s]: %v", slo.Name, err)
+				continue
+			}
+			slos = append(slos, slo)
+		}
+	}
+	return slos
+}
+
+// ValidateSLO checks the type, target, window and latency threshold of an objective
+func ValidateSLO(slo config.SLO) error {
+	if slo.Type != SLOTypeAvailability && slo.Type != SLOTypeLatency {
+		return fmt.Errorf("unsupported type [%s], expected %s or %s", slo.Type, SLOTypeAvailability, SLOTypeLatency)
+	}
+	if slo.Target <= 0 || slo.Target >= 100 {
+		return fmt.Errorf("target [%v] must be a percentage between 0 and 100 excluded", slo.Target)
+	}
+	if _, err := model.ParseDuration(slo.Window); err != nil {
+		return fmt.Errorf("invalid window [%s]: %v", slo.Window, err)
+	}
+	if slo.Type == SLOTypeLatency {
+		if slo.LatencyThreshold <= 0 {
+			return fmt.Errorf("latency objectives require a latency threshold")
+		}
+		if !isLatencyBucket(slo.LatencyThreshold) {
+			return fmt.Errorf("latency threshold [%v] is not a bucket boundary of the istio_request_duration_milliseconds histogram %v", slo.LatencyThreshold, config.Get().SLO.LatencyBuckets)
+		}
+	}
+	return nil
+}
+
+// isLatencyBucket checks if a latency threshold is one of the configured histogram bucket boundaries, as the ratio
+// of requests slower than the threshold is read from the bucket of that boundary
+func isLatencyBucket(threshold float64) bool {
+	for _, le := range config.Get().SLO.LatencyBuckets {
+		if le == threshold {
+			return true
+		}
+	}
+	return false
+}
+
+func parseSLOAnnotation(value string) ([]config.SLO, error) {
+	items, err := splitHealthAnnotation(value, 3, 4)
+	if err != nil {
+		return nil, err
+	}
+	slos := []config.SLO{}
+	for _, fields := range items {
+		target, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		slo := config.SLO{Name: fmt.Sprintf("%s-%s", fields[0], fields[2]), Type: fields[0], Target: target, Window: fields[2]}
+		if fields[3] != "" {
+			if slo.LatencyThreshold, err = strconv.ParseFloat(fields[3], 64); err != nil {
+				return nil, err
+			}
+		}
+		if err := ValidateSLO(slo); err != nil {
+			return nil, err
+		}
+		slos = append(slos, slo)
+	}
+	return slos, nil
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestGetSLOs(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.SLO.Objectives = []config.SLO{
+		{Name: "bookinfo-availability", Namespace: "bookinfo", Type: "availability", Target: 99.9, Window: "30d"},
+		{Name: "reviews-latency", Kind: "workload", EntityName: "reviews-.*", Type: "latency", Target: 99, Window: "7d", LatencyThreshold: 250},
+		{Name: "invalid-latency", Type: "latency", Target: 99, Window: "7d"},
+		{Name: "invalid-bucket", Type: "latency", Target: 99, Window: "7d", LatencyThreshold: 300},
+		{Name: "invalid-window", Type: "availability", Target: 99, Window: "a month"},
+	}
+	config.Set(conf)
+
+	slos := GetSLOs("bookinfo", "workload", "reviews-v1", map[string]string{})
+	assert.Len(slos, 2)
+	assert.Equal("bookinfo-availability", slos[0].Name)
+	assert.Equal("reviews-latency", slos[1].Name)
+
+	slos = GetSLOs("bookinfo", "service", "reviews", map[string]string{})
+	assert.Len(slos, 1)
+	assert.Equal("bookinfo-availability", slos[0].Name)
+
+	assert.Empty(GetSLOs("travels", "service", "reviews", map[string]string{}))
+
+	// The annotation overrides the configuration
+	slos = GetSLOs("bookinfo", "service", "reviews", map[string]string{SLOAnnotation: "availability,99.5,7d;latency,99,30d,500"})
+	assert.Equal([]config.SLO{
+		{Name: "availability-7d", Type: "availability", Target: 99.5, Window: "7d"},
+		{Name: "latency-30d", Type: "latency", Target: 99, Window: "30d", LatencyThreshold: 500},
+	}, slos)
+
+	// Invalid annotations are ignored
+	for _, annotation := range []string{"latency,99,30d", "latency,99,30d,300", "availability,100,30d", "errors,99,30d", "availability,99"} {
+		slos = GetSLOs("bookinfo", "service", "reviews", map[string]string{SLOAnnotation: annotation})
+		assert.Len(slos, 1, annotation)
+		assert.Equal("bookinfo-availability", slos[0].Name)
+	}
+}
+
+func TestValidateSLOLatencyBucket(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert.NoError(ValidateSLO(config.SLO{Type: "latency", Target: 99, Window: "30d", LatencyThreshold: 250}))
+	err := ValidateSLO(config.SLO{Type: "latency", Target: 99, Window: "30d", LatencyThreshold: 300})
+	assert.Error(err)
+	assert.Contains(err.Error(), "not a bucket boundary")
+
+	// Meshes with custom histogram buckets configure them
+	conf.SLO.LatencyBuckets = []float64{100, 300, 1000}
+	config.Set(conf)
+	assert.NoError(ValidateSLO(config.SLO{Type: "latency", Target: 99, Window: "30d", LatencyThreshold: 300}))
+	assert.Error(ValidateSLO(config.SLO{Type: "latency", Target: 99, Window: "30d", LatencyThreshold: 250}))
+}
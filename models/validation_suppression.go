@@ -0,0 +1,145 @@
+package models
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+)
+
+// ValidationSuppressionAnnotation declares suppression rules on the Istio object itself, as a JSON list of
+// config.ValidationSuppression where only codes, reason and expires are used, i.e.
+// [{"codes": ["KIA1106"], "reason": "Legacy namespace, migration planned", "expires": "2023-01-01T00:00:00Z"}]
+const ValidationSuppressionAnnotation = "validation.kiali.io/suppressions"
+
+// SuppressChecks marks as suppressed the checks matching a suppression rule of the configuration, or of the annotation
+// of their object. The objects give the labels and annotations of the validated objects, by key; a validation without
+// object can only match the rules without selector. A validation becomes valid when all its errors are suppressed.
+func (iv IstioValidations) SuppressChecks(objects map[IstioValidationKey]meta_v1.Object, now time.Time) {
+	suppressions := activeSuppressions(config.Get().KialiFeatureFlags.Validations.Suppressions, now)
+
+	for key, validation := range iv {
+		object := objects[key]
+		rules := []config.ValidationSuppression{}
+		for _, s := range suppressions {
+			if matchSuppression(s, key, object) {
+				rules = append(rules, s)
+			}
+		}
+		if object != nil {
+			rules = append(rules, annotationSuppressions(key, object, now)...)
+		}
+		if len(rules) == 0 {
+			continue
+		}
+
+		hasErrors, suppressedErrors := false, false
+		for i, check := range validation.Checks {
+			if !check.Suppressed {
+				for _, rule := range rules {
+					if matchSuppressionCode(rule, check.Code) {
+						// Checks may be shared between validations, the suppression only applies to this object
+						suppressed := *check
+						suppressed.Suppressed = true
+						suppressed.SuppressionReason = rule.Reason
+						validation.Checks[i] = &suppressed
+						suppressedErrors = suppressedErrors || check.Severity == ErrorSeverity
+						log.Tracef("Suppressing validation [%s] for object [%s:%s] in namespace [%s]: %s", check.Code, key.ObjectType, key.Name, key.Namespace, rule.Reason)
+						break
+					}
+				}
+			}
+			hasErrors = hasErrors || (!validation.Checks[i].Suppressed && validation.Checks[i].Severity == ErrorSeverity)
+		}
+		if suppressedErrors && !hasErrors {
+			validation.Valid = true
+		}
+	}
+}
+
+// activeSuppressions filters out the suppressions without codes, without reason or expired
+func activeSuppressions(suppressions []config.ValidationSuppression, now time.Time) []config.ValidationSuppression {
+	active := []config.ValidationSuppression{}
+	for _, s := range suppressions {
+		if len(s.Codes) == 0 {
+			log.Debugf("Ignoring validation suppression without codes: %+v", s)
+			continue
+		}
+		if s.Reason == "" {
+			log.Debugf("Ignoring validation suppression without reason: %+v", s)
+			continue
+		}
+		if s.Expires != "" {
+			expires, err := time.Parse(time.RFC3339, s.Expires)
+			if err != nil {
+				log.Debugf("Ignoring validation suppression with invalid expiry [%s]: %v", s.Expires, err)
+				continue
+			}
+			if now.After(expires) {
+				continue
+			}
+		}
+		active = append(active, s)
+	}
+	return active
+}
+
+func annotationSuppressions(key IstioValidationKey, object meta_v1.Object, now time.Time) []config.ValidationSuppression {
+	value, ok := object.GetAnnotations()[ValidationSuppressionAnnotation]
+	if !ok {
+		return nil
+	}
+	suppressions := []config.ValidationSuppression{}
+	if err := json.Unmarshal([]byte(value), &suppressions); err != nil {
+		log.Debugf("Ignoring invalid %s annotation of %s %s.%s: %v", ValidationSuppressionAnnotation, key.ObjectType, key.Namespace, key.Name, err)
+		return nil
+	}
+	// The annotation applies to its object only
+	for i := range suppressions {
+		suppressions[i].Namespace, suppressions[i].ObjectType, suppressions[i].Name, suppressions[i].Selector = "", "", "", nil
+	}
+	return activeSuppressions(suppressions, now)
+}
+
+func matchSuppression(s config.ValidationSuppression, key IstioValidationKey, object meta_v1.Object) bool {
+	if s.ObjectType != "" && s.ObjectType != key.ObjectType {
+		return false
+	}
+	if !matchSuppressionRegexp(s.Namespace, key.Namespace) || !matchSuppressionRegexp(s.Name, key.Name) {
+		return false
+	}
+	if len(s.Selector) > 0 {
+		if object == nil {
+			return false
+		}
+		return labels.SelectorFromSet(s.Selector).Matches(labels.Set(object.GetLabels()))
+	}
+	return true
+}
+
+// matchSuppressionRegexp checks that a value fully matches an expression. Empty expressions match anything.
+func matchSuppressionRegexp(expr, value string) bool {
+	if expr == "" {
+		return true
+	}
+	r, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		log.Debugf("Ignoring invalid validation suppression expression [%s]: %v", expr, err)
+		return false
+	}
+	return r.MatchString(value)
+}
+
+func matchSuppressionCode(s config.ValidationSuppression, code string) bool {
+	for _, c := range s.Codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+)
+
+func suppressionValidations() IstioValidations {
+	return IstioValidations{
+		BuildKey("virtualservice", "reviews", "legacy"): &IstioValidation{
+			Name:       "reviews",
+			ObjectType: "virtualservice",
+			Valid:      false,
+			Checks: []*IstioCheck{
+				{Code: "KIA1101", Severity: ErrorSeverity, Message: "Message 1"},
+				{Code: "KIA1106", Severity: WarningSeverity, Message: "Message 2"},
+			},
+		},
+		BuildKey("virtualservice", "reviews", "bookinfo"): &IstioValidation{
+			Name:       "reviews",
+			ObjectType: "virtualservice",
+			Valid:      true,
+			Checks: []*IstioCheck{
+				{Code: "KIA1106", Severity: WarningSeverity, Message: "Message 2"},
+			},
+		},
+		BuildKey("destinationrule", "reviews", "legacy"): &IstioValidation{
+			Name:       "reviews",
+			ObjectType: "destinationrule",
+			Valid:      true,
+			Checks: []*IstioCheck{
+				{Code: "KIA0203", Severity: WarningSeverity, Message: "Message 3"},
+			},
+		},
+	}
+}
+
+func TestSuppressChecksFromConfig(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	conf := config.NewConfig()
+	conf.KialiFeatureFlags.Validations.Suppressions = []config.ValidationSuppression{
+		{Codes: []string{"KIA1106"}, Namespace: "legacy", Reason: "Legacy namespace"},
+		{Codes: []string{"KIA1101"}, Namespace: "legacy", ObjectType: "virtualservice", Name: "rev.*", Reason: "Known issue"},
+		// Expired, without reason, without codes or not matching
+		{Codes: []string{"KIA0203"}, Reason: "Expired", Expires: "2022-05-01T00:00:00Z"},
+		{Codes: []string{"KIA0203"}},
+		{Namespace: "legacy", Reason: "Everything"},
+		{Codes: []string{"KIA0203"}, Namespace: "legacy", ObjectType: "virtualservice", Reason: "Other type"},
+		{Codes: []string{"KIA0203"}, Namespace: "legacy", Name: "rev", Reason: "Partial name"},
+	}
+	config.Set(conf)
+
+	validations := suppressionValidations()
+	validations.SuppressChecks(nil, now)
+
+	legacyVS := validations[BuildKey("virtualservice", "reviews", "legacy")]
+	assert.True(legacyVS.Valid)
+	assert.True(legacyVS.Checks[0].Suppressed)
+	assert.Equal("Known issue", legacyVS.Checks[0].SuppressionReason)
+	assert.True(legacyVS.Checks[1].Suppressed)
+	assert.Equal("Legacy namespace", legacyVS.Checks[1].SuppressionReason)
+
+	assert.False(validations[BuildKey("virtualservice", "reviews", "bookinfo")].Checks[0].Suppressed)
+	assert.False(validations[BuildKey("destinationrule", "reviews", "legacy")].Checks[0].Suppressed)
+
+	summary := validations.SummarizeValidation("legacy")
+	assert.Equal(0, summary.Errors)
+	assert.Equal(1, summary.Warnings)
+	assert.Equal(2, summary.Suppressed)
+	assert.Equal(2, summary.ObjectCount)
+}
+
+func TestSuppressChecksFromSelectorAndAnnotation(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	conf := config.NewConfig()
+	conf.KialiFeatureFlags.Validations.Suppressions = []config.ValidationSuppression{
+		{Codes: []string{"KIA1106"}, Selector: map[string]string{"team": "legacy"}, Reason: "Legacy team"},
+	}
+	config.Set(conf)
+
+	objects := map[IstioValidationKey]meta_v1.Object{
+		BuildKey("virtualservice", "reviews", "legacy"): &meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "legacy",
+			Labels:    map[string]string{"team": "legacy"},
+			Annotations: map[string]string{
+				ValidationSuppressionAnnotation: `[{"codes": ["KIA1101"], "reason": "Accepted", "expires": "2022-12-31T00:00:00Z"}]`,
+			},
+		},
+		BuildKey("virtualservice", "reviews", "bookinfo"): &meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "bookinfo",
+			Annotations: map[string]string{
+				// The scope of an annotation is its object, and codes and a reason are required
+				ValidationSuppressionAnnotation: `[{"codes": ["KIA1106"], "namespace": "legacy"}, {"reason": "Everything"}]`,
+			},
+		},
+		BuildKey("destinationrule", "reviews", "legacy"): &meta_v1.ObjectMeta{
+			Name:      "reviews",
+			Namespace: "legacy",
+			Annotations: map[string]string{
+				ValidationSuppressionAnnotation: `not json`,
+			},
+		},
+	}
+
+	validations := suppressionValidations()
+	validations.SuppressChecks(objects, now)
+
+	legacyVS := validations[BuildKey("virtualservice", "reviews", "legacy")]
+	assert.True(legacyVS.Valid)
+	assert.Equal("Accepted", legacyVS.Checks[0].SuppressionReason)
+	assert.Equal("Legacy team", legacyVS.Checks[1].SuppressionReason)
+	assert.False(validations[BuildKey("virtualservice", "reviews", "bookinfo")].Checks[0].Suppressed)
+	assert.False(validations[BuildKey("destinationrule", "reviews", "legacy")].Checks[0].Suppressed)
+
+	// The annotation expired
+	validations = suppressionValidations()
+	validations.SuppressChecks(objects, now.AddDate(1, 0, 0))
+
+	legacyVS = validations[BuildKey("virtualservice", "reviews", "legacy")]
+	assert.False(legacyVS.Valid)
+	assert.False(legacyVS.Checks[0].Suppressed)
+	assert.True(legacyVS.Checks[1].Suppressed)
+}
@@ -8,9 +8,11 @@ import (
 	batch_v1 "k8s.io/api/batch/v1"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/status"
 )
 
@@ -134,6 +136,9 @@ type Workload struct {
 	// Additional details to display, such as configured annotations
 	AdditionalDetails []AdditionalItem `json:"additionalDetails"`
 
+	// Annotations declaring the Service Level Objectives of the workload
+	SLOAnnotations map[string]string `json:"sloAnnotations"`
+
 	Validations IstioValidations `json:"validations"`
 
 	// Health
@@ -208,6 +213,7 @@ func (workload *Workload) parseObjectMeta(meta *meta_v1.ObjectMeta, tplMeta *met
 	workload.AdditionalDetailSample = GetFirstAdditionalIcon(conf, annotations)
 	workload.DashboardAnnotations = GetDashboardAnnotation(annotations)
 	workload.HealthAnnotations = GetHealthAnnotation(annotations, GetHealthConfigAnnotation())
+	workload.SLOAnnotations = GetSLOAnnotation(annotations)
 }
 
 func (workload *Workload) ParseDeployment(d *apps_v1.Deployment) {
@@ -272,6 +278,22 @@ func (workload *Workload) ParseStatefulSet(s *apps_v1.StatefulSet) {
 	workload.AvailableReplicas = s.Status.ReadyReplicas
 }
 
+// ParseCustomWorkload parses a custom controller of one of the types registered in the configuration, i.e. an Argo Rollout
+func (workload *Workload) ParseCustomWorkload(cw *unstructured.Unstructured, workloadType config.CustomWorkloadType) {
+	workload.Type = workloadType.Kind
+	meta := meta_v1.ObjectMeta{
+		Name:              cw.GetName(),
+		Annotations:       cw.GetAnnotations(),
+		CreationTimestamp: cw.GetCreationTimestamp(),
+		ResourceVersion:   cw.GetResourceVersion(),
+	}
+	tplMeta := kubernetes.GetCustomWorkloadTemplate(cw, workloadType)
+	workload.parseObjectMeta(&meta, &tplMeta)
+	workload.DesiredReplicas = kubernetes.GetCustomWorkloadReplicas(cw, workloadType.DesiredReplicasPath)
+	workload.CurrentReplicas = kubernetes.GetCustomWorkloadReplicas(cw, workloadType.CurrentReplicasPath)
+	workload.AvailableReplicas = kubernetes.GetCustomWorkloadReplicas(cw, workloadType.AvailableReplicasPath)
+}
+
 func (workload *Workload) ParsePod(pod *core_v1.Pod) {
 	workload.Type = "Pod"
 	workload.parseObjectMeta(&pod.ObjectMeta, &pod.ObjectMeta)
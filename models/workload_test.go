@@ -9,6 +9,7 @@ import (
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kiali/kiali/config"
 )
@@ -130,6 +131,64 @@ func TestParseDeploymentConfigToWorkload(t *testing.T) {
 	assert.Equal("value-annot", w.AdditionalDetails[0].Value)
 }
 
+func TestParseCustomWorkloadToWorkload(t *testing.T) {
+	assert := assert.New(t)
+	cfg := config.NewConfig()
+	config.Set(cfg)
+
+	rollout := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]interface{}{
+			"name":            "reviews",
+			"resourceVersion": "2709198702082918",
+			"labels":          map[string]interface{}{"owner": "team"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "reviews", "version": "v1"},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(3), "availableReplicas": int64(2)},
+	}}
+	rolloutType := config.CustomWorkloadType{
+		Group:                 "argoproj.io",
+		Version:               "v1alpha1",
+		Kind:                  "Rollout",
+		Resource:              "rollouts",
+		TemplatePath:          "spec.template",
+		DesiredReplicasPath:   "spec.replicas",
+		CurrentReplicasPath:   "status.replicas",
+		AvailableReplicasPath: "status.availableReplicas",
+	}
+
+	w := Workload{}
+	w.ParseCustomWorkload(rollout, rolloutType)
+
+	assert.Equal("reviews", w.Name)
+	assert.Equal("Rollout", w.Type)
+	assert.Equal(map[string]string{"app": "reviews", "version": "v1"}, w.Labels)
+	assert.True(w.AppLabel)
+	assert.True(w.VersionLabel)
+	assert.Equal("2709198702082918", w.ResourceVersion)
+	assert.Equal(int32(3), w.DesiredReplicas)
+	assert.Equal(int32(3), w.CurrentReplicas)
+	assert.Equal(int32(2), w.AvailableReplicas)
+
+	// Without template path, the labels of the controller are used
+	rolloutType.TemplatePath = ""
+	rolloutType.AvailableReplicasPath = ""
+	w = Workload{}
+	w.ParseCustomWorkload(rollout, rolloutType)
+
+	assert.Equal(map[string]string{"owner": "team"}, w.Labels)
+	assert.False(w.AppLabel)
+	assert.Equal(int32(0), w.AvailableReplicas)
+}
+
 func TestParsePodToWorkload(t *testing.T) {
 	assert := assert.New(t)
 	cfg := config.NewConfig()
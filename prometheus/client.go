@@ -36,7 +36,11 @@ type ClientInterface interface {
 	GetConfiguration() (prom_v1.ConfigResult, error)
 	GetFlags() (prom_v1.FlagsResult, error)
 	GetNamespaceServicesRequestRates(namespace, ratesInterval string, queryTime time.Time) (model.Vector, error)
+	GetPodRestarts(namespace, ratesInterval string, queryTime time.Time) (model.Vector, error)
+	GetRequestRatesRange(labels, ratesInterval string, bounds prom_v1.Range) (model.Matrix, error)
 	GetServiceRequestRates(namespace, service, ratesInterval string, queryTime time.Time) (model.Vector, error)
+	GetSLIErrorRatio(labels string, latencyThreshold float64, ratesInterval string, queryTime time.Time) (model.Vector, error)
+	GetTCPConnectionRates(labels, grouping, ratesInterval string, queryTime time.Time) (model.Vector, model.Vector, error)
 	GetWorkloadRequestRates(namespace, workload, ratesInterval string, queryTime time.Time) (model.Vector, model.Vector, error)
 	GetMetricsForLabels(metricNames []string, labels string) ([]string, error)
 }
@@ -230,6 +234,45 @@ func (in *Client) GetWorkloadRequestRates(namespace, workload, ratesInterval str
 	return inResult, outResult, nil
 }
 
+// GetRequestRatesRange queries Prometheus to fetch request counters rates over a time range, for the given labels
+// (i.e. `destination_workload_namespace="bookinfo",destination_workload="reviews-v1"`), grouped by the labels used
+// by the request health: reporter, request_protocol, response_code and grpc_response_status
+func (in *Client) GetRequestRatesRange(labels, ratesInterval string, bounds prom_v1.Range) (model.Matrix, error) {
+	log.Tracef("GetRequestRatesRange [labels: %s] [ratesInterval: %s] [bounds: %v]", labels, ratesInterval, bounds)
+	query := fmt.Sprintf("sum(rate(istio_requests_total{%s}[%s])) by (reporter,request_protocol,response_code,grpc_response_status) > 0", labels, ratesInterval)
+	result := fetchRange(in.ctx, in.api, query, bounds)
+	if result.Err != nil {
+		return model.Matrix{}, errors.NewServiceUnavailable(result.Err.Error())
+	}
+	return result.Matrix, nil
+}
+
+// GetSLIErrorRatio queries Prometheus to fetch the ratio of bad requests over a time interval, for the given labels
+// (i.e. `reporter="destination",destination_service_name="reviews"`). Bad requests are the 5xx responses when
+// latencyThreshold is 0, or the requests slower than latencyThreshold milliseconds, which must be a bucket boundary
+// of the istio_request_duration_milliseconds histogram.
+// The result is empty when there were no requests.
+func (in *Client) GetSLIErrorRatio(labels string, latencyThreshold float64, ratesInterval string, queryTime time.Time) (model.Vector, error) {
+	log.Tracef("GetSLIErrorRatio [labels: %s] [latencyThreshold: %v] [ratesInterval: %s] [queryTime: %s]", labels, latencyThreshold, ratesInterval, queryTime.String())
+	return getSLIErrorRatio(in.ctx, in.api, labels, latencyThreshold, queryTime, ratesInterval)
+}
+
+// GetPodRestarts queries Prometheus to fetch the increase of the container restarts of the pods of a namespace over
+// a time interval, by pod. Pods without restarts are not returned.
+func (in *Client) GetPodRestarts(namespace, ratesInterval string, queryTime time.Time) (model.Vector, error) {
+	log.Tracef("GetPodRestarts [namespace: %s] [ratesInterval: %s] [queryTime: %s]", namespace, ratesInterval, queryTime.String())
+	return getPodRestarts(in.ctx, in.api, namespace, queryTime, ratesInterval)
+}
+
+// GetTCPConnectionRates queries Prometheus to fetch the rates of opened TCP connections and of TCP connections
+// closed with response flags (failed) over a time interval, for the given labels (i.e. `{reporter="destination"}`)
+// and grouping.
+// Returns (opened, failed, error)
+func (in *Client) GetTCPConnectionRates(labels, grouping, ratesInterval string, queryTime time.Time) (model.Vector, model.Vector, error) {
+	log.Tracef("GetTCPConnectionRates [labels: %s] [grouping: %s] [ratesInterval: %s] [queryTime: %s]", labels, grouping, ratesInterval, queryTime.String())
+	return getTCPConnectionRates(in.ctx, in.api, labels, grouping, queryTime, ratesInterval)
+}
+
 // FetchRange fetches a simple metric (gauge or counter) in given range
 func (in *Client) FetchRange(metricName, labels, grouping, aggregator string, q *RangeQuery) Metric {
 	query := fmt.Sprintf("%s(%s%s)", aggregator, metricName, labels)
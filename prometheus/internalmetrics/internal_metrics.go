@@ -31,6 +31,8 @@ type MetricsType struct {
 	GraphGenerationTime            *prometheus.HistogramVec
 	GraphAppenderTime              *prometheus.HistogramVec
 	GraphMarshalTime               *prometheus.HistogramVec
+	GraphCacheHits                 *prometheus.CounterVec
+	GraphCacheMisses               *prometheus.CounterVec
 	APIProcessingTime              *prometheus.HistogramVec
 	PrometheusProcessingTime       *prometheus.HistogramVec
 	KubernetesClients              *prometheus.GaugeVec
@@ -72,6 +74,20 @@ var Metrics = MetricsType{
 		},
 		[]string{labelGraphKind, labelGraphType, labelWithServiceNodes},
 	),
+	GraphCacheHits: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kiali_graph_cache_hits_total",
+			Help: "Counts the total number of graphs served from the graph cache.",
+		},
+		[]string{labelGraphKind, labelGraphType, labelWithServiceNodes},
+	),
+	GraphCacheMisses: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kiali_graph_cache_misses_total",
+			Help: "Counts the total number of graphs not found in the graph cache.",
+		},
+		[]string{labelGraphKind, labelGraphType, labelWithServiceNodes},
+	),
 	APIProcessingTime: prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: "kiali_api_processing_duration_seconds",
@@ -173,6 +189,8 @@ func RegisterInternalMetrics() {
 		Metrics.GraphGenerationTime,
 		Metrics.GraphAppenderTime,
 		Metrics.GraphMarshalTime,
+		Metrics.GraphCacheHits,
+		Metrics.GraphCacheMisses,
 		Metrics.APIProcessingTime,
 		Metrics.PrometheusProcessingTime,
 		Metrics.KubernetesClients,
@@ -240,6 +258,24 @@ func GetGraphMarshalTimePrometheusTimer(graphKind string, graphType string, with
 	return timer
 }
 
+// GetGraphCacheHitsMetric returns the counter of graphs served from the graph cache
+func GetGraphCacheHitsMetric(graphKind string, graphType string, withServiceNodes bool) prometheus.Counter {
+	return Metrics.GraphCacheHits.With(prometheus.Labels{
+		labelGraphKind:        graphKind,
+		labelGraphType:        graphType,
+		labelWithServiceNodes: strconv.FormatBool(withServiceNodes),
+	})
+}
+
+// GetGraphCacheMissesMetric returns the counter of graphs not found in the graph cache
+func GetGraphCacheMissesMetric(graphKind string, graphType string, withServiceNodes bool) prometheus.Counter {
+	return Metrics.GraphCacheMisses.With(prometheus.Labels{
+		labelGraphKind:        graphKind,
+		labelGraphType:        graphType,
+		labelWithServiceNodes: strconv.FormatBool(withServiceNodes),
+	})
+}
+
 // GetAPIProcessingTimePrometheusTimer returns a timer that can be used to store
 // a value for the API processing time metric. The timer is ticking immediately
 // when this function returns.
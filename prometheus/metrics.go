@@ -3,6 +3,7 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -188,6 +189,79 @@ func getRequestRatesForLabel(ctx context.Context, api prom_v1.API, time time.Tim
 	return result.(model.Vector), nil
 }
 
+// getSLIErrorRatio retrieves the ratio of 5xx responses, or of requests slower than a latency threshold
+func getSLIErrorRatio(ctx context.Context, api prom_v1.API, labels string, latencyThreshold float64, queryTime time.Time, ratesInterval string) (model.Vector, error) {
+	var query string
+	if latencyThreshold > 0 {
+		le := strconv.FormatFloat(latencyThreshold, 'f', -1, 64)
+		query = fmt.Sprintf(`1 - (sum(rate(istio_request_duration_milliseconds_bucket{%s,le="%s"}[%s])) / sum(rate(istio_request_duration_milliseconds_count{%s}[%s])))`,
+			labels, le, ratesInterval, labels, ratesInterval)
+	} else {
+		query = fmt.Sprintf(`(sum(rate(istio_requests_total{%s,response_code=~"5.."}[%s])) or vector(0)) / sum(rate(istio_requests_total{%s}[%s]))`,
+			labels, ratesInterval, labels, ratesInterval)
+	}
+	log.Tracef("[Prom] getSLIErrorRatio: %s", query)
+	promtimer := internalmetrics.GetPrometheusProcessingTimePrometheusTimer("Metrics-GetSLIErrorRatio")
+	result, warnings, err := api.Query(ctx, query, queryTime)
+	if warnings != nil && len(warnings) > 0 {
+		log.Warningf("getSLIErrorRatio. Prometheus Warnings: [%s]", strings.Join(warnings, ","))
+	}
+	if err != nil {
+		return model.Vector{}, errors.NewServiceUnavailable(err.Error())
+	}
+	promtimer.ObserveDuration() // notice we only collect metrics for successful prom queries
+	return result.(model.Vector), nil
+}
+
+// getTCPConnectionRates retrieves the rates of opened TCP connections and of TCP connections closed with
+// response flags, which are connection failures
+func getTCPConnectionRates(ctx context.Context, api prom_v1.API, labels, grouping string, queryTime time.Time, ratesInterval string) (model.Vector, model.Vector, error) {
+	failedLabels := `{response_flags!="-"}`
+	if labels != "" {
+		failedLabels = strings.TrimSuffix(labels, "}") + `,response_flags!="-"}`
+	}
+	opened, err := getTCPRatesForQuery(ctx, api, queryTime, fmt.Sprintf("sum(rate(istio_tcp_connections_opened_total%s[%s])) by (%s) > 0", labels, ratesInterval, grouping))
+	if err != nil {
+		return model.Vector{}, model.Vector{}, err
+	}
+	failed, err := getTCPRatesForQuery(ctx, api, queryTime, fmt.Sprintf("sum(rate(istio_tcp_connections_closed_total%s[%s])) by (%s) > 0", failedLabels, ratesInterval, grouping))
+	if err != nil {
+		return model.Vector{}, model.Vector{}, err
+	}
+	return opened, failed, nil
+}
+
+// getPodRestarts retrieves the increase of the container restarts of the pods of a namespace over the rates interval,
+// as reported by kube-state-metrics
+func getPodRestarts(ctx context.Context, api prom_v1.API, namespace string, queryTime time.Time, ratesInterval string) (model.Vector, error) {
+	query := fmt.Sprintf(`sum(increase(kube_pod_container_status_restarts_total{namespace="%s"}[%s])) by (pod) > 0`, namespace, ratesInterval)
+	log.Tracef("[Prom] getPodRestarts: %s", query)
+	promtimer := internalmetrics.GetPrometheusProcessingTimePrometheusTimer("Metrics-GetPodRestarts")
+	result, warnings, err := api.Query(ctx, query, queryTime)
+	if warnings != nil && len(warnings) > 0 {
+		log.Warningf("getPodRestarts. Prometheus Warnings: [%s]", strings.Join(warnings, ","))
+	}
+	if err != nil {
+		return model.Vector{}, errors.NewServiceUnavailable(err.Error())
+	}
+	promtimer.ObserveDuration() // notice we only collect metrics for successful prom queries
+	return result.(model.Vector), nil
+}
+
+func getTCPRatesForQuery(ctx context.Context, api prom_v1.API, time time.Time, query string) (model.Vector, error) {
+	log.Tracef("[Prom] getTCPConnectionRates: %s", query)
+	promtimer := internalmetrics.GetPrometheusProcessingTimePrometheusTimer("Metrics-GetTCPConnectionRates")
+	result, warnings, err := api.Query(ctx, query, time)
+	if warnings != nil && len(warnings) > 0 {
+		log.Warningf("getTCPConnectionRates. Prometheus Warnings: [%s]", strings.Join(warnings, ","))
+	}
+	if err != nil {
+		return model.Vector{}, errors.NewServiceUnavailable(err.Error())
+	}
+	promtimer.ObserveDuration() // notice we only collect metrics for successful prom queries
+	return result.(model.Vector), nil
+}
+
 // roundSignificant will output promQL that performs rounding only if the resulting value is significant, that is, higher than the requested precision
 func roundSignificant(innerQuery string, precision float64) string {
 	return fmt.Sprintf("round(%s, %f) > %f or %s", innerQuery, precision, precision, innerQuery)
@@ -321,6 +321,26 @@ func (o *PromClientMock) GetWorkloadRequestRates(namespace, workload, ratesInter
 	return args.Get(0).(model.Vector), args.Get(1).(model.Vector), args.Error(2)
 }
 
+func (o *PromClientMock) GetPodRestarts(namespace, ratesInterval string, queryTime time.Time) (model.Vector, error) {
+	args := o.Called(namespace, ratesInterval, queryTime)
+	return args.Get(0).(model.Vector), args.Error(1)
+}
+
+func (o *PromClientMock) GetRequestRatesRange(labels, ratesInterval string, bounds prom_v1.Range) (model.Matrix, error) {
+	args := o.Called(labels, ratesInterval, bounds)
+	return args.Get(0).(model.Matrix), args.Error(1)
+}
+
+func (o *PromClientMock) GetSLIErrorRatio(labels string, latencyThreshold float64, ratesInterval string, queryTime time.Time) (model.Vector, error) {
+	args := o.Called(labels, latencyThreshold, ratesInterval, queryTime)
+	return args.Get(0).(model.Vector), args.Error(1)
+}
+
+func (o *PromClientMock) GetTCPConnectionRates(labels, grouping, ratesInterval string, queryTime time.Time) (model.Vector, model.Vector, error) {
+	args := o.Called(labels, grouping, ratesInterval, queryTime)
+	return args.Get(0).(model.Vector), args.Get(1).(model.Vector), args.Error(2)
+}
+
 func (o *PromClientMock) FetchRange(metricName, labels, grouping, aggregator string, q *prometheus.RangeQuery) prometheus.Metric {
 	args := o.Called(metricName, labels, grouping, aggregator, q)
 	return args.Get(0).(prometheus.Metric)
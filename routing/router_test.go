@@ -170,3 +170,21 @@ func TestMetricHandlerAPIFailures(t *testing.T) {
 		}
 	}
 }
+
+func TestMetricHandlerFlush(t *testing.T) {
+	req, err := http.NewRequest("GET", "/stream", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	handler := metricHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		assert.True(t, ok)
+		flusher.Flush()
+	}), Route{Name: "stream"})
+
+	handler.ServeHTTP(rr, req)
+
+	assert.True(t, rr.Flushed)
+}
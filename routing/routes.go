@@ -141,6 +141,47 @@ func NewRoutes() (r *Routes) {
 			handlers.AuthenticationInfo,
 			false,
 		},
+		// swagger:route GET /auth/sessions auth listSessions
+		// ---
+		// Endpoint to list the active user sessions when sessions are kept in a server-side store.
+		// Only users configured as session admins are allowed.
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: sessionsResponse
+		{
+			"ListSessions",
+			"GET",
+			"/api/auth/sessions",
+			handlers.ListSessions,
+			true,
+		},
+		// swagger:route DELETE /auth/sessions/{session} auth revokeSession
+		// ---
+		// Endpoint to revoke an active user session when sessions are kept in a server-side store.
+		// Only users configured as session admins are allowed.
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      204: noContent
+		{
+			"RevokeSession",
+			"DELETE",
+			"/api/auth/sessions/{session}",
+			handlers.Audit(handlers.RevokeSession),
+			true,
+		},
 		// swagger:route GET /status status getStatus
 		// ---
 		// Endpoint to get the status of Kiali
@@ -278,12 +319,13 @@ func NewRoutes() (r *Routes) {
 			"IstioConfigDelete",
 			"DELETE",
 			"/api/namespaces/{namespace}/istio/{object_type}/{object}",
-			handlers.IstioConfigDelete,
+			handlers.Audit(handlers.IstioConfigDelete),
 			true,
 		},
 		// swagger:route PATCH /namespaces/{namespace}/istio/{object_type}/{object} config istioConfigUpdate
 		// ---
 		// Endpoint to update the Istio Config of an Istio object used for templates and adapters using Json Merge Patch strategy.
+		// With dryRun, the update is not persisted and the response is an IstioConfigPreview.
 		//
 		//     Consumes:
 		//	   - application/json
@@ -303,12 +345,13 @@ func NewRoutes() (r *Routes) {
 			"IstioConfigUpdate",
 			"PATCH",
 			"/api/namespaces/{namespace}/istio/{object_type}/{object}",
-			handlers.IstioConfigUpdate,
+			handlers.Audit(handlers.IstioConfigUpdate),
 			true,
 		},
 		// swagger:route POST /namespaces/{namespace}/istio/{object_type} config istioConfigCreate
 		// ---
 		// Endpoint to create an Istio object by using an Istio Config item
+		// With dryRun, the creation is not persisted and the response is an IstioConfigPreview.
 		//
 		//     Produces:
 		//     - application/json
@@ -326,7 +369,155 @@ func NewRoutes() (r *Routes) {
 			"IstioConfigCreate",
 			"POST",
 			"/api/namespaces/{namespace}/istio/{object_type}",
-			handlers.IstioConfigCreate,
+			handlers.Audit(handlers.IstioConfigCreate),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/istio/{object_type}/{object}/history config istioConfigHistory
+		// ---
+		// Endpoint to list the revisions kept for an Istio object updated or deleted through Kiali, from the newest to the oldest
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: istioConfigRevisionsResponse
+		//
+		{
+			"IstioConfigHistory",
+			"GET",
+			"/api/namespaces/{namespace}/istio/{object_type}/{object}/history",
+			handlers.IstioConfigHistory,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/istio/{object_type}/{object}/history/{revision} config istioConfigRevision
+		// ---
+		// Endpoint to get a revision of an Istio object, with the object as it was before the change
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: istioConfigRevisionResponse
+		//
+		{
+			"IstioConfigRevision",
+			"GET",
+			"/api/namespaces/{namespace}/istio/{object_type}/{object}/history/{revision}",
+			handlers.IstioConfigRevision,
+			true,
+		},
+		// swagger:route POST /namespaces/{namespace}/istio/{object_type}/{object}/history/{revision}/rollback config istioConfigRollback
+		// ---
+		// Endpoint to re-apply a revision of an Istio object. The rollback is validated with a dry-run first, and rejected if it would introduce validation errors unless forced.
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: istioConfigDetailsResponse
+		//
+		{
+			"IstioConfigRollback",
+			"POST",
+			"/api/namespaces/{namespace}/istio/{object_type}/{object}/history/{revision}/rollback",
+			handlers.Audit(handlers.IstioConfigRollback),
+			true,
+		},
+		// swagger:route POST /istio/import config istioConfigImport
+		// ---
+		// Endpoint to create or replace the Istio objects of a multi-document YAML bundle, or of a tar archive of manifests.
+		// The objects are submitted with a dry-run and validated as a set first. The bundle is rejected if any object fails or
+		// if it would introduce validation errors unless forced. On failure while applying, the objects already applied are restored.
+		// Bundles exceeding the server max_bundle_size, compressed or not, are rejected.
+		//
+		//     Consumes:
+		//     - application/yaml
+		//     - application/x-tar
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      413: requestEntityTooLargeError
+		//      422: istioConfigImportResponse
+		//      500: internalError
+		//      200: istioConfigImportResponse
+		//
+		{
+			"IstioConfigImport",
+			"POST",
+			"/api/istio/import",
+			handlers.Audit(handlers.IstioConfigImport),
+			true,
+		},
+		// swagger:route GET /istio/export config istioConfigExport
+		// ---
+		// Endpoint to export the Istio objects of the accessible namespaces as an apply-ready multi-document YAML, without status nor server-set metadata
+		//
+		//     Produces:
+		//     - application/yaml
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: istioConfigExportResponse
+		//
+		{
+			"IstioConfigExport",
+			"GET",
+			"/api/istio/export",
+			handlers.IstioConfigExport,
+			true,
+		},
+		// swagger:route POST /istio/validations/offline config istioConfigOfflineValidations
+		// ---
+		// Endpoint to validate the Services, Deployments and Istio objects of a multi-document YAML bundle, or of a tar archive of
+		// manifests, with all the checkers and without looking up the cluster. The other objects are ignored.
+		// Bundles exceeding the server max_bundle_size, compressed or not, are rejected.
+		//
+		//     Consumes:
+		//     - application/yaml
+		//     - application/x-tar
+		//
+		//     Produces:
+		//     - application/json
+		//     - application/sarif+json
+		//     - application/xml
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      413: requestEntityTooLargeError
+		//      500: internalError
+		//      200: istioConfigOfflineValidationsResponse
+		//
+		{
+			"IstioConfigOfflineValidations",
+			"POST",
+			"/api/istio/validations/offline",
+			handlers.IstioConfigOfflineValidations,
 			true,
 		},
 		// swagger:route GET /namespaces/{namespace}/services services serviceList
@@ -392,7 +583,30 @@ func NewRoutes() (r *Routes) {
 			"ServiceUpdate",
 			"PATCH",
 			"/api/namespaces/{namespace}/services/{service}",
-			handlers.ServiceUpdate,
+			handlers.Audit(handlers.ServiceUpdate),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/slos services serviceSLOs
+		// ---
+		// Endpoint to get the compliance, the remaining error budget and the burn rates of the Service Level Objectives of a service
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      503: serviceUnavailableError
+		//      200: sloListResponse
+		//
+		{
+			"ServiceSLOs",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/slos",
+			handlers.ServiceSLOs,
 			true,
 		},
 		// swagger:route GET /namespaces/{namespace}/apps/{app}/spans traces appSpans
@@ -620,7 +834,78 @@ func NewRoutes() (r *Routes) {
 			"WorkloadUpdate",
 			"PATCH",
 			"/api/namespaces/{namespace}/workloads/{workload}",
-			handlers.WorkloadUpdate,
+			handlers.Audit(handlers.WorkloadUpdate),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/slos workloads workloadSLOs
+		// ---
+		// Endpoint to get the compliance, the remaining error budget and the burn rates of the Service Level Objectives of a workload
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      503: serviceUnavailableError
+		//      200: sloListResponse
+		//
+		{
+			"WorkloadSLOs",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/slos",
+			handlers.WorkloadSLOs,
+			true,
+		},
+		// swagger:route POST /namespaces/{namespace}/workloads/{workload}/authorization workloads workloadAuthorizationSimulation
+		// ---
+		// Endpoint to evaluate whether a source identity can send a request to the workload, given the AuthorizationPolicies and the PeerAuthentications that apply to it
+		//
+		//     Consumes:
+		//     - application/json
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: authorizationDecisionResponse
+		//
+		{
+			"WorkloadAuthorizationSimulation",
+			"POST",
+			"/api/namespaces/{namespace}/workloads/{workload}/authorization",
+			handlers.WorkloadAuthorizationSimulation,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/logs workloads workloadAccessLogs
+		// ---
+		// Endpoint to search the access logs of all the pods of a workload, merged by timestamp, with their summary
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: accessLogsResponse
+		//
+		{
+			"WorkloadAccessLogs",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/logs",
+			handlers.WorkloadAccessLogs,
 			true,
 		},
 		// swagger:route GET /namespaces/{namespace}/apps apps appList
@@ -664,6 +949,29 @@ func NewRoutes() (r *Routes) {
 			handlers.AppDetails,
 			true,
 		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/logs apps appAccessLogs
+		// ---
+		// Endpoint to search the access logs of all the pods of the workloads of an app, merged by timestamp, with their summary
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: accessLogsResponse
+		//
+		{
+			"AppAccessLogs",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/logs",
+			handlers.AppAccessLogs,
+			true,
+		},
 		// swagger:route GET /namespaces namespaces namespaceList
 		// ---
 		// Endpoint to get the list of the available namespaces
@@ -706,7 +1014,7 @@ func NewRoutes() (r *Routes) {
 			"NamespaceUpdate",
 			"PATCH",
 			"/api/namespaces/{namespace}",
-			handlers.NamespaceUpdate,
+			handlers.Audit(handlers.NamespaceUpdate),
 			true,
 		},
 		// swagger:route GET /namespaces/{namespace}/services/{service}/metrics services serviceMetrics
@@ -919,6 +1227,75 @@ func NewRoutes() (r *Routes) {
 			handlers.NamespaceHealth,
 			true,
 		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/health/timeline apps appHealthTimeline
+		// ---
+		// Endpoint to get the request health of an app evaluated at every step of a time range
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      503: serviceUnavailableError
+		//      200: healthTimelineResponse
+		//
+		{
+			"AppHealthTimeline",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/health/timeline",
+			handlers.AppHealthTimeline,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/health/timeline services serviceHealthTimeline
+		// ---
+		// Endpoint to get the request health of a service evaluated at every step of a time range
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      503: serviceUnavailableError
+		//      200: healthTimelineResponse
+		//
+		{
+			"ServiceHealthTimeline",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/health/timeline",
+			handlers.ServiceHealthTimeline,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/health/timeline workloads workloadHealthTimeline
+		// ---
+		// Endpoint to get the request health of a workload evaluated at every step of a time range
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      503: serviceUnavailableError
+		//      200: healthTimelineResponse
+		//
+		{
+			"WorkloadHealthTimeline",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/health/timeline",
+			handlers.WorkloadHealthTimeline,
+			true,
+		},
 		// swagger:route GET /namespaces/{namespace}/validations namespaces namespaceValidations
 		// ---
 		// Get validation summary for all objects in the given namespace
@@ -1065,6 +1442,93 @@ func NewRoutes() (r *Routes) {
 			handlers.GraphNamespaces,
 			true,
 		},
+		// swagger:route GET /namespaces/graph/stream graphs graphNamespacesStream
+		// ---
+		// A namespaces graph streamed as server-sent events. The first event (graph) holds the full graph, the
+		// following events (delta) hold the node and edge changes of every refresh.
+		//
+		//     Produces:
+		//     - text/event-stream
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: graphResponse
+		//
+		{
+			"GraphNamespacesStream",
+			"GET",
+			"/api/namespaces/graph/stream",
+			handlers.GraphNamespacesStream,
+			true,
+		},
+		// swagger:route GET /namespaces/graph/dependencies graphs graphDependencies
+		// ---
+		// The upstream or downstream dependencies of a node of a namespaces graph.
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: graphDependenciesResponse
+		//
+		{
+			"GraphDependencies",
+			"GET",
+			"/api/namespaces/graph/dependencies",
+			handlers.GraphDependencies,
+			true,
+		},
+		// swagger:route GET /namespaces/graph/path graphs graphPath
+		// ---
+		// The shortest traffic path between two nodes of a namespaces graph.
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: graphDependenciesResponse
+		//
+		{
+			"GraphPath",
+			"GET",
+			"/api/namespaces/graph/path",
+			handlers.GraphPath,
+			true,
+		},
+		// swagger:route GET /namespaces/graph/cycles graphs graphCycles
+		// ---
+		// The traffic cycles of a namespaces graph.
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: graphCyclesResponse
+		//
+		{
+			"GraphCycles",
+			"GET",
+			"/api/namespaces/graph/cycles",
+			handlers.GraphCycles,
+			true,
+		},
 		// swagger:route GET /namespaces/{namespace}/aggregates/{aggregate}/{aggregateValue}/graph graphs graphAggregate
 		// ---
 		// The backing JSON for an aggregate node detail graph. (supported graphTypes: app | versionedApp | workload)
@@ -1279,6 +1743,30 @@ func NewRoutes() (r *Routes) {
 			handlers.PodLogs,
 			true,
 		},
+		// swagger:route GET /namespaces/{namespace}/pods/{pod}/logs/stream pods podLogsStream
+		// ---
+		// Endpoint to follow pod logs, streamed as server-sent events. Each log event holds a new line of one of the
+		// containers. The stream ends with an idle event when no line is logged for idleTimeout.
+		//
+		//     Produces:
+		//     - text/event-stream
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: podLogsStreamResponse
+		//
+		{
+			"PodLogsStream",
+			"GET",
+			"/api/namespaces/{namespace}/pods/{pod}/logs/stream",
+			handlers.PodLogsStream,
+			true,
+		},
 		// swagger:route GET /namespaces/{namespace}/pods/{pod}/config_dump pods podProxyDump
 		// ---
 		// Endpoint to get pod proxy dump
@@ -1340,10 +1828,32 @@ func NewRoutes() (r *Routes) {
 			"PodProxyLogging",
 			"POST",
 			"/api/namespaces/{namespace}/pods/{pod}/logging",
-			handlers.LoggingUpdate,
+			handlers.Audit(handlers.LoggingUpdate),
 			true,
 		},
 
+		// swagger:route GET /audit audit auditLog
+		// ---
+		// Endpoint to search the audit log of the write operations
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      404: notFoundError
+		//      400: badRequestError
+		//      200: auditResponse
+		//
+		{
+			"AuditLog",
+			"GET",
+			"/api/audit",
+			handlers.AuditLog,
+			true,
+		},
 		// swagger:route POST /stats/metrics stats metricsStats
 		// ---
 		// Produces metrics statistics
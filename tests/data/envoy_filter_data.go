@@ -0,0 +1,38 @@
+package data
+
+import (
+	api_networking_v1alpha3 "istio.io/api/networking/v1alpha3"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+)
+
+func CreateEnvoyFilter(name string, namespace string) *networking_v1alpha3.EnvoyFilter {
+	ef := networking_v1alpha3.EnvoyFilter{}
+	ef.Name = name
+	ef.Namespace = namespace
+	ef.ClusterName = "svc.cluster.local"
+	return &ef
+}
+
+func AddSelectorToEnvoyFilter(selector map[string]string, ef *networking_v1alpha3.EnvoyFilter) *networking_v1alpha3.EnvoyFilter {
+	ef.Spec.WorkloadSelector = &api_networking_v1alpha3.WorkloadSelector{
+		Labels: selector,
+	}
+	return ef
+}
+
+func AddPatchToEnvoyFilter(patch *api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch, ef *networking_v1alpha3.EnvoyFilter) *networking_v1alpha3.EnvoyFilter {
+	ef.Spec.ConfigPatches = append(ef.Spec.ConfigPatches, patch)
+	return ef
+}
+
+func CreateEnvoyFilterPatch(applyTo api_networking_v1alpha3.EnvoyFilter_ApplyTo, context api_networking_v1alpha3.EnvoyFilter_PatchContext) *api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch {
+	return &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch{
+		ApplyTo: applyTo,
+		Match: &api_networking_v1alpha3.EnvoyFilter_EnvoyConfigObjectMatch{
+			Context: context,
+		},
+		Patch: &api_networking_v1alpha3.EnvoyFilter_Patch{
+			Operation: api_networking_v1alpha3.EnvoyFilter_Patch_MERGE,
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func CreateEmptyK8sGateway(name, namespace string) *k8s_networking_v1alpha2.Gateway {
+	gw := k8s_networking_v1alpha2.Gateway{}
+	gw.Name = name
+	gw.Namespace = namespace
+	gw.Spec.GatewayClassName = "istio"
+	return &gw
+}
+
+func CreateK8sListener(name, hostname string, port int32, protocol string) k8s_networking_v1alpha2.Listener {
+	listener := k8s_networking_v1alpha2.Listener{
+		Name:     k8s_networking_v1alpha2.SectionName(name),
+		Port:     k8s_networking_v1alpha2.PortNumber(port),
+		Protocol: k8s_networking_v1alpha2.ProtocolType(protocol),
+	}
+	if hostname != "" {
+		h := k8s_networking_v1alpha2.Hostname(hostname)
+		listener.Hostname = &h
+	}
+	return listener
+}
+
+func AddListenerToK8sGateway(listener k8s_networking_v1alpha2.Listener, gw *k8s_networking_v1alpha2.Gateway) *k8s_networking_v1alpha2.Gateway {
+	gw.Spec.Listeners = append(gw.Spec.Listeners, listener)
+	return gw
+}
+
+func AddAllowedRoutesToK8sListener(from string, selector map[string]string, listener k8s_networking_v1alpha2.Listener) k8s_networking_v1alpha2.Listener {
+	fromNamespaces := k8s_networking_v1alpha2.FromNamespaces(from)
+	listener.AllowedRoutes = &k8s_networking_v1alpha2.AllowedRoutes{
+		Namespaces: &k8s_networking_v1alpha2.RouteNamespaces{
+			From: &fromNamespaces,
+		},
+	}
+	if selector != nil {
+		listener.AllowedRoutes.Namespaces.Selector = &meta_v1.LabelSelector{MatchLabels: selector}
+	}
+	return listener
+}
+
+func CreateHTTPRoute(name, namespace, gwName string, hostnames []string) *k8s_networking_v1alpha2.HTTPRoute {
+	route := k8s_networking_v1alpha2.HTTPRoute{}
+	route.Name = name
+	route.Namespace = namespace
+	if gwName != "" {
+		route.Spec.ParentRefs = append(route.Spec.ParentRefs, k8s_networking_v1alpha2.ParentRef{
+			Name: k8s_networking_v1alpha2.ObjectName(gwName),
+		})
+	}
+	for _, h := range hostnames {
+		route.Spec.Hostnames = append(route.Spec.Hostnames, k8s_networking_v1alpha2.Hostname(h))
+	}
+	return &route
+}
+
+func AddParentRefToHTTPRoute(name, namespace string, route *k8s_networking_v1alpha2.HTTPRoute) *k8s_networking_v1alpha2.HTTPRoute {
+	parentRef := k8s_networking_v1alpha2.ParentRef{
+		Name: k8s_networking_v1alpha2.ObjectName(name),
+	}
+	if namespace != "" {
+		ns := k8s_networking_v1alpha2.Namespace(namespace)
+		parentRef.Namespace = &ns
+	}
+	route.Spec.ParentRefs = append(route.Spec.ParentRefs, parentRef)
+	return route
+}
+
+func AddBackendRefToHTTPRoute(name, namespace string, port int32, route *k8s_networking_v1alpha2.HTTPRoute) *k8s_networking_v1alpha2.HTTPRoute {
+	backendRef := k8s_networking_v1alpha2.HTTPBackendRef{
+		BackendRef: k8s_networking_v1alpha2.BackendRef{
+			BackendObjectReference: k8s_networking_v1alpha2.BackendObjectReference{
+				Name: k8s_networking_v1alpha2.ObjectName(name),
+			},
+		},
+	}
+	if namespace != "" {
+		ns := k8s_networking_v1alpha2.Namespace(namespace)
+		backendRef.Namespace = &ns
+	}
+	if port != 0 {
+		p := k8s_networking_v1alpha2.PortNumber(port)
+		backendRef.Port = &p
+	}
+	route.Spec.Rules = append(route.Spec.Rules, k8s_networking_v1alpha2.HTTPRouteRule{
+		BackendRefs: []k8s_networking_v1alpha2.HTTPBackendRef{backendRef},
+	})
+	return route
+}